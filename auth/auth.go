@@ -0,0 +1,124 @@
+// Copyright 2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+// auth contains the identity of authenticated users as well as helpers for
+// OpenID Connect.
+package auth
+
+import (
+	"context"
+	"errors"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// ErrMissingSubject is returned if a token does not contain the claim that
+// identifies the user.
+var ErrMissingSubject = errors.New("token does not contain a subject")
+
+// User is an authenticated user.
+type User struct {
+	// Subject uniquely identifies the user at the issuer.
+	Subject string
+
+	Name  string
+	Email string
+
+	// Roles are the roles of the user, which grant its permissions.
+	Roles []Role
+
+	// Scopes restricts the permissions of the user, e.g., if it authenticated
+	// using an access token. If it is nil, the user has all permissions that
+	// are granted by its roles.
+	Scopes []Permission
+}
+
+type userKeyType struct{}
+
+// userKey is the key for the user in the context.
+var userKey userKeyType
+
+// NewContext returns a new context that carries the user.
+func NewContext(ctx context.Context, u *User) context.Context {
+	return context.WithValue(ctx, userKey, u)
+}
+
+// FromContext extracts the user from the context, if there is one.
+func FromContext(ctx context.Context) (u *User, ok bool) {
+	u, ok = ctx.Value(userKey).(*User)
+	return
+}
+
+// ClaimMapping specifies which claims of a token contain the information of a
+// [User]. Empty fields fall back to [DefaultClaimMapping].
+type ClaimMapping struct {
+	Subject string
+	Name    string
+	Email   string
+	Roles   string
+
+	// DefaultRole is assigned to users whose token does not contain any
+	// roles. If it is empty, such users do not have any permissions.
+	DefaultRole Role
+}
+
+// DefaultClaimMapping contains the standard OpenID Connect claims.
+var DefaultClaimMapping = ClaimMapping{
+	Subject: "sub",
+	Name:    "preferred_username",
+	Email:   "email",
+	Roles:   "roles",
+}
+
+// User maps the claims of a token to a [User].
+func (m ClaimMapping) User(claims jwt.MapClaims) (u *User, err error) {
+	u = &User{
+		Subject: claim(claims, m.Subject, DefaultClaimMapping.Subject),
+		Name:    claim(claims, m.Name, DefaultClaimMapping.Name),
+		Email:   claim(claims, m.Email, DefaultClaimMapping.Email),
+	}
+
+	if u.Subject == "" {
+		return nil, ErrMissingSubject
+	}
+
+	// Not every token contains a name, e.g., in a client credentials flow
+	if u.Name == "" {
+		u.Name = u.Subject
+	}
+
+	if m.Roles == "" {
+		m.Roles = DefaultClaimMapping.Roles
+	}
+
+	u.Roles = roles(claims, m.Roles)
+	if len(u.Roles) == 0 && m.DefaultRole != "" {
+		u.Roles = []Role{m.DefaultRole}
+	}
+
+	return u, nil
+}
+
+// claim returns the string value of the claim name, or of def if name is
+// empty.
+func claim(claims jwt.MapClaims, name string, def string) string {
+	if name == "" {
+		name = def
+	}
+
+	s, _ := claims[name].(string)
+	return s
+}
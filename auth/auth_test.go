@@ -0,0 +1,118 @@
+// Copyright 2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/oxisto/assert"
+)
+
+func TestClaimMapping_User(t *testing.T) {
+	tests := []struct {
+		name    string
+		m       ClaimMapping
+		claims  jwt.MapClaims
+		want    *User
+		wantErr error
+	}{
+		{
+			name: "default mapping",
+			claims: jwt.MapClaims{
+				"sub":                "f81d4fae",
+				"preferred_username": "money",
+				"email":              "money@example.com",
+			},
+			want: &User{Subject: "f81d4fae", Name: "money", Email: "money@example.com"},
+		},
+		{
+			name: "custom mapping",
+			m:    ClaimMapping{Subject: "oid", Name: "name"},
+			claims: jwt.MapClaims{
+				"sub":  "ignored",
+				"oid":  "f81d4fae",
+				"name": "Money Gopher",
+			},
+			want: &User{Subject: "f81d4fae", Name: "Money Gopher"},
+		},
+		{
+			name:   "name falls back to subject",
+			claims: jwt.MapClaims{"sub": "cli"},
+			want:   &User{Subject: "cli", Name: "cli"},
+		},
+		{
+			name: "roles",
+			claims: jwt.MapClaims{
+				"sub":   "money",
+				"roles": []any{"editor", "viewer"},
+			},
+			want: &User{Subject: "money", Name: "money", Roles: []Role{RoleEditor, RoleViewer}},
+		},
+		{
+			name: "nested roles",
+			m:    ClaimMapping{Roles: "realm_access.roles"},
+			claims: jwt.MapClaims{
+				"sub":          "money",
+				"realm_access": map[string]any{"roles": []any{"admin"}},
+			},
+			want: &User{Subject: "money", Name: "money", Roles: []Role{RoleAdmin}},
+		},
+		{
+			name: "space-separated roles",
+			claims: jwt.MapClaims{
+				"sub":   "money",
+				"roles": "viewer editor",
+			},
+			want: &User{Subject: "money", Name: "money", Roles: []Role{RoleViewer, RoleEditor}},
+		},
+		{
+			name:   "default role",
+			m:      ClaimMapping{DefaultRole: RoleViewer},
+			claims: jwt.MapClaims{"sub": "money"},
+			want:   &User{Subject: "money", Name: "money", Roles: []Role{RoleViewer}},
+		},
+		{
+			name:    "missing subject",
+			claims:  jwt.MapClaims{"preferred_username": "money"},
+			wantErr: ErrMissingSubject,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.m.User(tt.claims)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, tt.wantErr, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equals(t, tt.want, got)
+		})
+	}
+}
+
+func TestFromContext(t *testing.T) {
+	_, ok := FromContext(context.Background())
+	assert.Equals(t, false, ok)
+
+	u := &User{Subject: "money"}
+	got, ok := FromContext(NewContext(context.Background(), u))
+	assert.Equals(t, true, ok)
+	assert.Equals(t, u, got)
+}
@@ -0,0 +1,81 @@
+// Copyright 2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// ErrIssuerMismatch is returned if the discovered metadata belongs to a
+// different issuer than the one requested.
+var ErrIssuerMismatch = errors.New("issuer in provider metadata does not match")
+
+// ProviderMetadata contains the parts of the OpenID Connect provider metadata
+// that we are interested in.
+//
+// See https://openid.net/specs/openid-connect-discovery-1_0.html#ProviderMetadata.
+type ProviderMetadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// Discover retrieves the provider metadata of the issuer using OpenID Connect
+// discovery.
+func Discover(ctx context.Context, client *http.Client, issuer string) (md *ProviderMetadata, err error) {
+	var (
+		req *http.Request
+		res *http.Response
+	)
+
+	issuer = strings.TrimSuffix(issuer, "/")
+
+	req, err = http.NewRequestWithContext(ctx, http.MethodGet, issuer+"/.well-known/openid-configuration", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err = client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve provider metadata: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not retrieve provider metadata: %s", res.Status)
+	}
+
+	md = new(ProviderMetadata)
+	err = json.NewDecoder(res.Body).Decode(md)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse provider metadata: %w", err)
+	}
+
+	// The issuer must be identical to the one we requested, otherwise someone
+	// could impersonate another issuer
+	if strings.TrimSuffix(md.Issuer, "/") != issuer {
+		return nil, fmt.Errorf("%w: %s", ErrIssuerMismatch, md.Issuer)
+	}
+
+	return md, nil
+}
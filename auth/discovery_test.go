@@ -0,0 +1,74 @@
+// Copyright 2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/oxisto/assert"
+)
+
+func newProvider(t *testing.T, issuer func(url string) string) *httptest.Server {
+	var srv *httptest.Server
+
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/realms/money/.well-known/openid-configuration" {
+			http.NotFound(w, r)
+			return
+		}
+
+		base := srv.URL + "/realms/money"
+		_ = json.NewEncoder(w).Encode(&ProviderMetadata{
+			Issuer:                issuer(base),
+			AuthorizationEndpoint: base + "/protocol/openid-connect/auth",
+			TokenEndpoint:         base + "/protocol/openid-connect/token",
+			JWKSURI:               base + "/protocol/openid-connect/certs",
+		})
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func TestDiscover(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		srv := newProvider(t, func(url string) string { return url })
+
+		md, err := Discover(context.Background(), srv.Client(), srv.URL+"/realms/money/")
+		assert.NoError(t, err)
+		assert.Equals(t, srv.URL+"/realms/money/protocol/openid-connect/certs", md.JWKSURI)
+		assert.Equals(t, srv.URL+"/realms/money/protocol/openid-connect/token", md.TokenEndpoint)
+	})
+
+	t.Run("issuer mismatch", func(t *testing.T) {
+		srv := newProvider(t, func(string) string { return "https://evil.example.com" })
+
+		_, err := Discover(context.Background(), srv.Client(), srv.URL+"/realms/money")
+		assert.ErrorIs(t, ErrIssuerMismatch, err)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		srv := newProvider(t, func(url string) string { return url })
+
+		_, err := Discover(context.Background(), srv.Client(), srv.URL+"/realms/other")
+		assert.NotNil(t, err)
+	})
+}
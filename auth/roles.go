@@ -0,0 +1,157 @@
+// Copyright 2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// ErrUnknownRole is returned by [ParseRole] if the role is not known.
+var ErrUnknownRole = errors.New("unknown role")
+
+// Role is a role of a [User], which grants a set of [Permission]s.
+type Role string
+
+const (
+	// RoleViewer can view portfolios and securities. Like all other roles, it
+	// can manage its own access tokens.
+	RoleViewer Role = "viewer"
+
+	// RoleEditor can additionally modify portfolios, bank accounts and
+	// securities as well as trigger quote updates.
+	RoleEditor Role = "editor"
+
+	// RoleAdmin can additionally administrate the server, e.g., create
+	// backups and restore dumps, and read the audit log.
+	RoleAdmin Role = "admin"
+)
+
+// Permission is required to call a certain procedure.
+type Permission string
+
+const (
+	PermissionReadPortfolios  Permission = "portfolios:read"
+	PermissionWritePortfolios Permission = "portfolios:write"
+	PermissionReadSecurities  Permission = "securities:read"
+	PermissionWriteSecurities Permission = "securities:write"
+	PermissionUpdateQuotes    Permission = "securities:update-quotes"
+	PermissionManageTokens    Permission = "tokens:manage"
+	PermissionReadAuditLog    Permission = "audit:read"
+	PermissionAdministrate    Permission = "admin"
+)
+
+// AllPermissions contains all known permissions.
+var AllPermissions = []Permission{
+	PermissionReadPortfolios,
+	PermissionWritePortfolios,
+	PermissionReadSecurities,
+	PermissionWriteSecurities,
+	PermissionUpdateQuotes,
+	PermissionManageTokens,
+	PermissionReadAuditLog,
+	PermissionAdministrate,
+}
+
+// RolePermissions contains the permissions that are granted by each role.
+var RolePermissions = map[Role][]Permission{
+	RoleViewer: {
+		PermissionReadPortfolios,
+		PermissionReadSecurities,
+		PermissionManageTokens,
+	},
+	RoleEditor: {
+		PermissionReadPortfolios,
+		PermissionWritePortfolios,
+		PermissionReadSecurities,
+		PermissionWriteSecurities,
+		PermissionUpdateQuotes,
+		PermissionManageTokens,
+	},
+	RoleAdmin: {
+		PermissionReadPortfolios,
+		PermissionWritePortfolios,
+		PermissionReadSecurities,
+		PermissionWriteSecurities,
+		PermissionUpdateQuotes,
+		PermissionManageTokens,
+		PermissionReadAuditLog,
+		PermissionAdministrate,
+	},
+}
+
+// ParseRole parses the name of a known role.
+func ParseRole(s string) (r Role, err error) {
+	r = Role(s)
+	if _, ok := RolePermissions[r]; !ok {
+		return "", fmt.Errorf("%w: %s", ErrUnknownRole, s)
+	}
+
+	return r, nil
+}
+
+// HasPermission checks, whether one of the roles of the user grants the
+// permission. If the user has scopes, the permission must additionally be one
+// of them.
+func (u *User) HasPermission(p Permission) bool {
+	if u.Scopes != nil && !slices.Contains(u.Scopes, p) {
+		return false
+	}
+
+	for _, r := range u.Roles {
+		if slices.Contains(RolePermissions[r], p) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// roles returns the roles contained in the claim name. Nested claims, such as
+// Keycloak's "realm_access.roles", are separated by a dot. The claim can
+// either be a list of strings or a single string with space-separated roles.
+func roles(claims jwt.MapClaims, name string) (roles []Role) {
+	var v any = map[string]any(claims)
+
+	for _, key := range strings.Split(name, ".") {
+		m, ok := v.(map[string]any)
+		if !ok {
+			return nil
+		}
+
+		v = m[key]
+	}
+
+	switch v := v.(type) {
+	case string:
+		for _, s := range strings.Fields(v) {
+			roles = append(roles, Role(s))
+		}
+	case []any:
+		for _, s := range v {
+			if s, ok := s.(string); ok {
+				roles = append(roles, Role(s))
+			}
+		}
+	}
+
+	return
+}
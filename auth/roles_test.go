@@ -0,0 +1,122 @@
+// Copyright 2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package auth
+
+import (
+	"testing"
+
+	"github.com/oxisto/assert"
+)
+
+func TestParseRole(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    Role
+		wantErr error
+	}{
+		{
+			name: "known role",
+			s:    "editor",
+			want: RoleEditor,
+		},
+		{
+			name:    "unknown role",
+			s:       "owner",
+			wantErr: ErrUnknownRole,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseRole(tt.s)
+			assert.ErrorIs(t, tt.wantErr, err)
+			assert.Equals(t, tt.want, got)
+		})
+	}
+}
+
+func TestUser_HasPermission(t *testing.T) {
+	tests := []struct {
+		name   string
+		roles  []Role
+		scopes []Permission
+		p      Permission
+		want   bool
+	}{
+		{
+			name:  "viewer can read",
+			roles: []Role{RoleViewer},
+			p:     PermissionReadPortfolios,
+			want:  true,
+		},
+		{
+			name:  "viewer cannot write",
+			roles: []Role{RoleViewer},
+			p:     PermissionWritePortfolios,
+			want:  false,
+		},
+		{
+			name:  "editor cannot administrate",
+			roles: []Role{RoleEditor},
+			p:     PermissionAdministrate,
+			want:  false,
+		},
+		{
+			name:  "any role grants the permission",
+			roles: []Role{RoleViewer, RoleAdmin},
+			p:     PermissionAdministrate,
+			want:  true,
+		},
+		{
+			name: "no roles",
+			p:    PermissionReadPortfolios,
+			want: false,
+		},
+		{
+			name:   "scopes restrict roles",
+			roles:  []Role{RoleAdmin},
+			scopes: []Permission{PermissionReadPortfolios},
+			p:      PermissionWritePortfolios,
+			want:   false,
+		},
+		{
+			name:   "roles restrict scopes",
+			roles:  []Role{RoleViewer},
+			scopes: []Permission{PermissionWritePortfolios},
+			p:      PermissionWritePortfolios,
+			want:   false,
+		},
+		{
+			name:   "scopes without roles",
+			scopes: []Permission{PermissionReadPortfolios},
+			p:      PermissionReadPortfolios,
+			want:   false,
+		},
+		{
+			name:  "unknown role",
+			roles: []Role{"owner"},
+			p:     PermissionReadPortfolios,
+			want:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := &User{Subject: "money", Roles: tt.roles, Scopes: tt.scopes}
+			assert.Equals(t, tt.want, u.HasPermission(tt.p))
+		})
+	}
+}
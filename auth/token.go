@@ -0,0 +1,57 @@
+// Copyright 2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+)
+
+// AccessTokenPrefix is the prefix of all access tokens. It distinguishes them
+// from JWTs and makes them easy to spot, e.g., by secret scanners.
+const AccessTokenPrefix = "mgo_"
+
+// NewAccessToken generates a new random access token and returns it together
+// with its hash. Only the hash should be stored.
+func NewAccessToken() (token string, hash string, err error) {
+	var b = make([]byte, 32)
+
+	_, err = rand.Read(b)
+	if err != nil {
+		return "", "", err
+	}
+
+	token = AccessTokenPrefix + base64.RawURLEncoding.EncodeToString(b)
+
+	return token, HashAccessToken(token), nil
+}
+
+// HashAccessToken returns the hex-encoded SHA-256 hash of the token. Since
+// tokens are long random values, a salt or a slow hash function is not needed.
+func HashAccessToken(token string) string {
+	h := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(h[:])
+}
+
+// IsAccessToken checks, whether the token is an access token rather than a
+// JWT.
+func IsAccessToken(token string) bool {
+	return strings.HasPrefix(token, AccessTokenPrefix)
+}
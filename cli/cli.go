@@ -38,10 +38,16 @@ type sessionKeyType struct{}
 // SessionKey is the key for the session in the context.
 var SessionKey sessionKeyType
 
+// SessionFile is the file in which the session is saved.
+var SessionFile = "session.json"
+
 // Session holds all necessary information about the current CLI session.
 type Session struct {
 	PortfolioClient  portfoliov1connect.PortfolioServiceClient  `json:"-"`
 	SecuritiesClient portfoliov1connect.SecuritiesServiceClient `json:"-"`
+	TokenClient      portfoliov1connect.TokenServiceClient      `json:"-"`
+	AuditClient      portfoliov1connect.AuditServiceClient      `json:"-"`
+	AlertClient      portfoliov1connect.AlertServiceClient      `json:"-"`
 
 	opts *SessionOptions
 }
@@ -94,13 +100,24 @@ func NewSession(opts *SessionOptions) (s *Session) {
 	return s
 }
 
+// NewAccessTokenSession creates a new session that authenticates using a
+// personal access token instead of an OAuth 2.0 token. Since access tokens do
+// not expire in the OAuth 2.0 sense, they are never refreshed.
+func NewAccessTokenSession(token string, baseURL string) (s *Session) {
+	return NewSession(&SessionOptions{
+		OAuth2Config: &oauth2.Config{},
+		Token:        &oauth2.Token{AccessToken: token},
+		BaseURL:      baseURL,
+	})
+}
+
 // ContinueSession continues a session from a file.
 func ContinueSession() (s *Session, err error) {
 	var (
 		file *os.File
 	)
 
-	file, err = os.OpenFile("session.json", os.O_RDONLY, 0600)
+	file, err = os.OpenFile(SessionFile, os.O_RDONLY, 0600)
 	if err != nil {
 		return
 	}
@@ -122,7 +139,7 @@ func (s *Session) Save() (err error) {
 		file *os.File
 	)
 
-	file, err = os.OpenFile("session.json", os.O_TRUNC|os.O_CREATE|os.O_WRONLY, 0600)
+	file, err = os.OpenFile(SessionFile, os.O_TRUNC|os.O_CREATE|os.O_WRONLY, 0600)
 	if err != nil {
 		return
 	}
@@ -138,22 +155,7 @@ func (s *Session) Save() (err error) {
 
 // initClients initializes the clients for the session.
 func (s *Session) initClients() {
-	interceptor := func(next connect.UnaryFunc) connect.UnaryFunc {
-		return connect.UnaryFunc(func(
-			ctx context.Context,
-			req connect.AnyRequest,
-		) (connect.AnyResponse, error) {
-			if req.Spec().IsClient {
-				var t, err = s.opts.OAuth2Config.TokenSource(context.Background(), s.opts.Token).Token()
-				if err != nil {
-					slog.Error("Could not retrieve token", tint.Err(err))
-				} else {
-					req.Header().Set("Authorization", "Bearer "+t.AccessToken)
-				}
-			}
-			return next(ctx, req)
-		})
-	}
+	interceptor := &authInterceptor{s}
 
 	if s.opts.HttpClient == nil {
 		s.opts.HttpClient = http.DefaultClient
@@ -162,25 +164,90 @@ func (s *Session) initClients() {
 	s.PortfolioClient = portfoliov1connect.NewPortfolioServiceClient(
 		s.opts.HttpClient, s.opts.BaseURL,
 		connect.WithHTTPGet(),
-		connect.WithInterceptors(connect.UnaryInterceptorFunc(interceptor)),
+		connect.WithInterceptors(interceptor),
 	)
 
 	s.SecuritiesClient = portfoliov1connect.NewSecuritiesServiceClient(
 		s.opts.HttpClient, s.opts.BaseURL,
 		connect.WithHTTPGet(),
-		connect.WithInterceptors(connect.UnaryInterceptorFunc(interceptor)),
+		connect.WithInterceptors(interceptor),
+	)
+
+	s.TokenClient = portfoliov1connect.NewTokenServiceClient(
+		s.opts.HttpClient, s.opts.BaseURL,
+		connect.WithHTTPGet(),
+		connect.WithInterceptors(interceptor),
+	)
+
+	s.AuditClient = portfoliov1connect.NewAuditServiceClient(
+		s.opts.HttpClient, s.opts.BaseURL,
+		connect.WithHTTPGet(),
+		connect.WithInterceptors(interceptor),
+	)
+
+	s.AlertClient = portfoliov1connect.NewAlertServiceClient(
+		s.opts.HttpClient, s.opts.BaseURL,
+		connect.WithHTTPGet(),
+		connect.WithInterceptors(interceptor),
 	)
 }
 
+// authInterceptor adds the token of the session to all outgoing requests.
+type authInterceptor struct {
+	s *Session
+}
+
+func (i *authInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return connect.UnaryFunc(func(
+		ctx context.Context,
+		req connect.AnyRequest,
+	) (connect.AnyResponse, error) {
+		if req.Spec().IsClient {
+			i.authorize(req.Header())
+		}
+		return next(ctx, req)
+	})
+}
+
+func (i *authInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return connect.StreamingClientFunc(func(
+		ctx context.Context,
+		spec connect.Spec,
+	) connect.StreamingClientConn {
+		conn := next(ctx, spec)
+		i.authorize(conn.RequestHeader())
+		return conn
+	})
+}
+
+func (*authInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return next
+}
+
+// authorize sets the Authorization header to the (refreshed) token of the
+// session.
+func (i *authInterceptor) authorize(header http.Header) {
+	var t, err = i.s.opts.OAuth2Config.TokenSource(context.Background(), i.s.opts.Token).Token()
+	if err != nil {
+		slog.Error("Could not retrieve token", tint.Err(err))
+	} else {
+		header.Set("Authorization", "Bearer "+t.AccessToken)
+	}
+}
+
 // FromContext extracts the session from the context.
 func FromContext(ctx context.Context) (s *Session) {
 	s = ctx.Value(SessionKey).(*Session)
 	return
 }
 
-// InjectSession is a pre-hook that injects the session into the context.
+// InjectSession is a pre-hook that injects the session into the context. If the
+// environment variable MGO_TOKEN contains a personal access token, it is used
+// (together with the base URL in MGO_URL) instead of the session file.
 func InjectSession(ctx context.Context, cmd *cli.Command) (newCtx context.Context, err error) {
-	if cmd.NArg() != 0 {
+	if token := os.Getenv("MGO_TOKEN"); cmd.NArg() != 0 && token != "" {
+		newCtx = context.WithValue(ctx, SessionKey, NewAccessTokenSession(token, os.Getenv("MGO_URL")))
+	} else if cmd.NArg() != 0 {
 		s, err := ContinueSession()
 		if err != nil {
 			fmt.Println("Could not continue with existing session or session is missing. Please use `mgo login`.")
@@ -19,6 +19,7 @@ package commands
 import (
 	"context"
 	"fmt"
+	"time"
 
 	mcli "github.com/oxisto/money-gopher/cli"
 	portfoliov1 "github.com/oxisto/money-gopher/gen"
@@ -38,8 +39,24 @@ var BankAccountCmd = &cli.Command{
 			Usage:  "Creates a new bank account",
 			Action: CreateBankAccount,
 			Flags: []cli.Flag{
-				&cli.StringFlag{Name: "id", Usage: "The identifier of the portfolio, e.g. mybank-myportfolio", Required: true},
-				&cli.StringFlag{Name: "display-name", Usage: "The display name of the portfolio"},
+				&cli.StringFlag{Name: "id", Usage: "The identifier of the bank account, e.g. mybank-mycash", Required: true},
+				&cli.StringFlag{Name: "display-name", Usage: "The display name of the bank account"},
+				&cli.StringFlag{Name: "currency", Usage: "The currency of the bank account", DefaultText: "EUR"},
+				&cli.StringFlag{Name: "iban", Usage: "The IBAN of the bank account"},
+				&cli.StringFlag{Name: "institution", Usage: "The name of the bank that holds the bank account"},
+			},
+		},
+		{
+			Name:   "list",
+			Usage:  "Lists all bank accounts and their balance",
+			Action: ListBankAccounts,
+		},
+		{
+			Name:   "ledger",
+			Usage:  "Shows the cash ledger of a bank account",
+			Action: ShowCashLedger,
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "bank-account-id", Usage: "The identifier of the bank account, e.g. mybank-mycash", Required: true},
 			},
 		},
 	},
@@ -54,6 +71,9 @@ func CreateBankAccount(ctx context.Context, cmd *cli.Command) error {
 			BankAccount: &portfoliov1.BankAccount{
 				Id:          cmd.String("id"),
 				DisplayName: cmd.String("display-name"),
+				Currency:    cmd.String("currency"),
+				Iban:        cmd.String("iban"),
+				Institution: cmd.String("institution"),
 			},
 		}),
 	)
@@ -64,3 +84,50 @@ func CreateBankAccount(ctx context.Context, cmd *cli.Command) error {
 	fmt.Fprint(cmd.Writer, res.Msg)
 	return nil
 }
+
+// ListBankAccounts lists all bank accounts.
+func ListBankAccounts(ctx context.Context, cmd *cli.Command) error {
+	s := mcli.FromContext(ctx)
+	res, err := s.PortfolioClient.ListBankAccounts(
+		context.Background(),
+		connect.NewRequest(&portfoliov1.ListBankAccountsRequest{}),
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, acc := range res.Msg.BankAccounts {
+		fmt.Fprintf(cmd.Writer, "%s %q %s %s\n", acc.Id, acc.DisplayName, acc.Iban, acc.Balance.Pretty())
+	}
+
+	return nil
+}
+
+// ShowCashLedger shows the cash ledger of a bank account.
+func ShowCashLedger(ctx context.Context, cmd *cli.Command) error {
+	s := mcli.FromContext(ctx)
+	res, err := s.PortfolioClient.GetCashLedger(
+		context.Background(),
+		connect.NewRequest(&portfoliov1.GetCashLedgerRequest{
+			BankAccountId: cmd.String("bank-account-id"),
+		}),
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, e := range res.Msg.Entries {
+		fmt.Fprintf(cmd.Writer, "%s %s %s/%s %s %s\n",
+			e.Time.AsTime().Format(time.DateOnly),
+			e.Type,
+			e.PortfolioId,
+			e.TransactionId,
+			e.Amount.Pretty(),
+			e.Balance.Pretty(),
+		)
+	}
+
+	fmt.Fprintf(cmd.Writer, "Balance: %s\n", res.Msg.Balance.Pretty())
+
+	return nil
+}
@@ -0,0 +1,138 @@
+// Copyright 2023 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package commands
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	portfoliov1 "github.com/oxisto/money-gopher/gen"
+	"github.com/oxisto/money-gopher/internal"
+	"github.com/oxisto/money-gopher/internal/testing/clitest"
+	"github.com/oxisto/money-gopher/internal/testing/servertest"
+	"github.com/oxisto/money-gopher/persistence"
+
+	"github.com/oxisto/assert"
+	"github.com/urfave/cli/v3"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// withBankAccount creates a bank account that is owned by our test user and a
+// portfolio with a deposit that settles against it.
+func withBankAccount(id string, displayName string) func(db *persistence.DB) {
+	return func(db *persistence.DB) {
+		_ = persistence.Ops[*portfoliov1.BankAccount](db).Replace(context.Background(), &portfoliov1.BankAccount{
+			Id:          id,
+			DisplayName: displayName,
+			Owner:       internal.TestUser,
+			Currency:    "EUR",
+			Iban:        "DE89370400440532013000",
+		})
+		_ = persistence.Ops[*portfoliov1.Portfolio](db).Replace(context.Background(), &portfoliov1.Portfolio{
+			Id:            "myportfolio",
+			DisplayName:   "My Portfolio",
+			Owner:         internal.TestUser,
+			BankAccountId: id,
+		})
+		_ = persistence.Ops[*portfoliov1.PortfolioEvent](db).Replace(context.Background(), &portfoliov1.PortfolioEvent{
+			Id:          "deposit",
+			Type:        portfoliov1.PortfolioEventType_PORTFOLIO_EVENT_TYPE_DEPOSIT_CASH,
+			PortfolioId: "myportfolio",
+			Price:       portfoliov1.Value(100000),
+			Time:        timestamppb.New(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)),
+		})
+	}
+}
+
+func TestListBankAccounts(t *testing.T) {
+	srv := servertest.NewServer(internal.NewTestDB(t, withBankAccount("mycash", "My Cash")))
+	defer srv.Close()
+
+	type args struct {
+		ctx context.Context
+		cmd *cli.Command
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantRec assert.Want[*clitest.CommandRecorder]
+		wantErr bool
+	}{
+		{
+			name: "happy path",
+			args: args{
+				ctx: clitest.NewSessionContext(t, srv),
+				cmd: clitest.MockCommand(t, BankAccountCmd.Command("list").Flags),
+			},
+			wantRec: func(t *testing.T, r *clitest.CommandRecorder) bool {
+				return assert.Equals(t, "mycash \"My Cash\" DE89370400440532013000 1000 EUR\n", r.String())
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := clitest.Record(tt.args.cmd)
+			if err := ListBankAccounts(tt.args.ctx, tt.args.cmd); (err != nil) != tt.wantErr {
+				t.Errorf("ListBankAccounts() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantRec != nil {
+				tt.wantRec(t, rec)
+			}
+		})
+	}
+}
+
+func TestShowCashLedger(t *testing.T) {
+	srv := servertest.NewServer(internal.NewTestDB(t, withBankAccount("mycash", "My Cash")))
+	defer srv.Close()
+
+	type args struct {
+		ctx context.Context
+		cmd *cli.Command
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantRec assert.Want[*clitest.CommandRecorder]
+		wantErr bool
+	}{
+		{
+			name: "happy path",
+			args: args{
+				ctx: clitest.NewSessionContext(t, srv),
+				cmd: clitest.MockCommand(t, BankAccountCmd.Command("ledger").Flags, "--bank-account-id", "mycash"),
+			},
+			wantRec: func(t *testing.T, r *clitest.CommandRecorder) bool {
+				return assert.Equals(t, "2020-01-01 PORTFOLIO_EVENT_TYPE_DEPOSIT_CASH myportfolio/deposit 1000 EUR 1000 EUR\nBalance: 1000 EUR\n", r.String())
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := clitest.Record(tt.args.cmd)
+			if err := ShowCashLedger(tt.args.ctx, tt.args.cmd); (err != nil) != tt.wantErr {
+				t.Errorf("ShowCashLedger() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantRec != nil {
+				tt.wantRec(t, rec)
+			}
+		})
+	}
+}
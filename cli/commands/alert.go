@@ -0,0 +1,193 @@
+// Copyright 2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	mcli "github.com/oxisto/money-gopher/cli"
+	portfoliov1 "github.com/oxisto/money-gopher/gen"
+
+	"connectrpc.com/connect"
+	"github.com/urfave/cli/v3"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// AlertCmd is the command for alert related commands.
+var AlertCmd = &cli.Command{
+	Name:   "alert",
+	Usage:  "Manage alert rules and list triggered alerts",
+	Before: mcli.InjectSession,
+	Commands: []*cli.Command{
+		{
+			Name:   "create",
+			Usage:  "Creates a new alert rule",
+			Action: CreateAlertRule,
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "id", Usage: "The identifier of the alert rule, e.g. apple-drop", Required: true},
+				&cli.StringFlag{Name: "display-name", Usage: "The display name of the alert rule"},
+				&cli.StringFlag{Name: "type", Usage: "The condition of the alert rule, i.e. position-drop, stale-quote, portfolio-value-above or portfolio-value-below", Required: true},
+				&cli.StringFlag{Name: "portfolio-id", Usage: "The identifier of the portfolio whose positions are checked", Required: true},
+				&cli.StringFlag{Name: "security-id", Usage: "Only check the position of this security"},
+				&cli.FloatFlag{Name: "percentage", Usage: "The loss in percent of the purchase value (not the previous quote) for position-drop, e.g. 10"},
+				&cli.FloatFlag{Name: "threshold", Usage: "The total portfolio value for portfolio-value-above and portfolio-value-below"},
+				&cli.DurationFlag{Name: "max-quote-age", Usage: "The age after which a quote is stale for stale-quote, e.g. 24h"},
+				&cli.StringSliceFlag{Name: "channel", Usage: "A notification channel that is configured on the server, i.e. webhook, smtp or command. Can be specified multiple times"},
+			},
+		},
+		{
+			Name:   "list",
+			Usage:  "Lists all alert rules",
+			Action: ListAlertRules,
+		},
+		{
+			Name:   "delete",
+			Usage:  "Deletes an alert rule together with its triggered alerts",
+			Action: DeleteAlertRule,
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "id", Usage: "The identifier of the alert rule", Required: true},
+			},
+		},
+		{
+			Name:   "triggered",
+			Usage:  "Lists the most recently triggered alerts",
+			Action: ListTriggeredAlerts,
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "rule-id", Usage: "Only list alerts of the alert rule with this identifier"},
+				&cli.IntFlag{Name: "limit", Usage: "The maximum number of alerts", Value: 100},
+			},
+		},
+	},
+}
+
+// CreateAlertRule creates a new alert rule.
+func CreateAlertRule(ctx context.Context, cmd *cli.Command) error {
+	var (
+		s    = mcli.FromContext(ctx)
+		rule = &portfoliov1.AlertRule{
+			Id:          cmd.String("id"),
+			DisplayName: cmd.String("display-name"),
+			PortfolioId: cmd.String("portfolio-id"),
+			SecurityId:  cmd.String("security-id"),
+			Percentage:  cmd.Float("percentage") / 100,
+			Channels:    cmd.StringSlice("channel"),
+		}
+	)
+
+	typ, ok := portfoliov1.AlertRuleType_value["ALERT_RULE_TYPE_"+strings.ToUpper(strings.ReplaceAll(cmd.String("type"), "-", "_"))]
+	if !ok {
+		return errors.New("unknown alert rule type")
+	}
+
+	rule.Type = portfoliov1.AlertRuleType(typ)
+
+	if cmd.IsSet("threshold") {
+		rule.Threshold = portfoliov1.Value(int32(cmd.Float("threshold") * 100))
+	}
+
+	if cmd.IsSet("max-quote-age") {
+		rule.MaxQuoteAge = durationpb.New(cmd.Duration("max-quote-age"))
+	}
+
+	res, err := s.AlertClient.CreateAlertRule(
+		context.Background(),
+		connect.NewRequest(&portfoliov1.CreateAlertRuleRequest{
+			AlertRule: rule,
+		}),
+	)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(cmd.Writer, "Alert rule %s created.\n", res.Msg.Id)
+	return nil
+}
+
+// ListAlertRules lists all alert rules of the current user.
+func ListAlertRules(ctx context.Context, cmd *cli.Command) error {
+	s := mcli.FromContext(ctx)
+	res, err := s.AlertClient.ListAlertRules(
+		context.Background(),
+		connect.NewRequest(&portfoliov1.ListAlertRulesRequest{}),
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, r := range res.Msg.AlertRules {
+		fmt.Fprintf(cmd.Writer, "%s %q %s %s",
+			r.Id,
+			r.DisplayName,
+			strings.ReplaceAll(strings.ToLower(strings.TrimPrefix(r.Type.String(), "ALERT_RULE_TYPE_")), "_", "-"),
+			r.PortfolioId,
+		)
+
+		if r.Active {
+			fmt.Fprint(cmd.Writer, " (active)")
+		}
+
+		fmt.Fprintln(cmd.Writer)
+	}
+
+	return nil
+}
+
+// DeleteAlertRule deletes an alert rule.
+func DeleteAlertRule(ctx context.Context, cmd *cli.Command) error {
+	s := mcli.FromContext(ctx)
+	_, err := s.AlertClient.DeleteAlertRule(
+		context.Background(),
+		connect.NewRequest(&portfoliov1.DeleteAlertRuleRequest{
+			Id: cmd.String("id"),
+		}),
+	)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(cmd.Writer, "Alert rule %s deleted.\n", cmd.String("id"))
+	return nil
+}
+
+// ListTriggeredAlerts lists the most recently triggered alerts.
+func ListTriggeredAlerts(ctx context.Context, cmd *cli.Command) error {
+	s := mcli.FromContext(ctx)
+	res, err := s.AlertClient.ListTriggeredAlerts(
+		context.Background(),
+		connect.NewRequest(&portfoliov1.ListTriggeredAlertsRequest{
+			RuleId:   cmd.String("rule-id"),
+			PageSize: int32(cmd.Int("limit")),
+		}),
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, a := range res.Msg.TriggeredAlerts {
+		fmt.Fprintf(cmd.Writer, "%s %s %s\n",
+			a.Time.AsTime().Local().Format(time.DateTime),
+			a.RuleId,
+			strings.ReplaceAll(a.Message, "\n", "; "),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,187 @@
+// Copyright 2023 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"os"
+	"path/filepath"
+
+	mcli "github.com/oxisto/money-gopher/cli"
+	portfoliov1 "github.com/oxisto/money-gopher/gen"
+
+	"connectrpc.com/connect"
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v3"
+)
+
+// attachmentChunkSize is the size of the chunks in which a file is uploaded.
+const attachmentChunkSize = 64 << 10
+
+// AttachFile uploads a file as an attachment of a transaction.
+func AttachFile(ctx context.Context, cmd *cli.Command) (err error) {
+	var (
+		s    = mcli.FromContext(ctx)
+		path = cmd.String("file")
+		typ  = cmd.String("content-type")
+		buf  = make([]byte, attachmentChunkSize)
+		n    int
+	)
+
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if typ == "" {
+		typ = mime.TypeByExtension(filepath.Ext(path))
+	}
+
+	stream := s.PortfolioClient.UploadAttachment(ctx)
+
+	err = stream.Send(&portfoliov1.UploadAttachmentRequest{
+		Data: &portfoliov1.UploadAttachmentRequest_Attachment{
+			Attachment: &portfoliov1.Attachment{
+				TransactionId: cmd.String("id"),
+				FileName:      filepath.Base(path),
+				ContentType:   typ,
+			},
+		},
+	})
+
+	for err == nil {
+		n, err = f.Read(buf)
+		if n > 0 {
+			err = errors.Join(err, stream.Send(&portfoliov1.UploadAttachmentRequest{
+				Data: &portfoliov1.UploadAttachmentRequest_Chunk{Chunk: buf[:n]},
+			}))
+		}
+	}
+
+	// If sending failed, the actual error is returned by CloseAndReceive
+	if err != nil && !errors.Is(err, io.EOF) {
+		if _, serr := stream.CloseAndReceive(); serr != nil {
+			return serr
+		}
+
+		return err
+	}
+
+	res, err := stream.CloseAndReceive()
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(cmd.Writer, "Successfully attached %s (%s) to transaction %s.\n",
+		color.CyanString(res.Msg.FileName),
+		color.GreenString(res.Msg.Id),
+		color.CyanString(res.Msg.TransactionId),
+	)
+
+	return nil
+}
+
+// ListAttachments lists the attachments of a transaction.
+func ListAttachments(ctx context.Context, cmd *cli.Command) error {
+	s := mcli.FromContext(ctx)
+	res, err := s.PortfolioClient.ListAttachments(
+		context.Background(),
+		connect.NewRequest(&portfoliov1.ListAttachmentsRequest{
+			TransactionId: cmd.String("id"),
+		}),
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, a := range res.Msg.Attachments {
+		fmt.Fprintf(cmd.Writer, "| %-*s | %-*s | %-*s | %*d bytes |\n",
+			16, a.Id,
+			30, a.FileName,
+			20, a.ContentType,
+			10, a.Size,
+		)
+	}
+
+	return nil
+}
+
+// DownloadAttachment downloads an attachment of a transaction into a file.
+func DownloadAttachment(ctx context.Context, cmd *cli.Command) (err error) {
+	var (
+		s    = mcli.FromContext(ctx)
+		f    *os.File
+		path = cmd.String("output")
+	)
+
+	stream, err := s.PortfolioClient.DownloadAttachment(
+		ctx,
+		connect.NewRequest(&portfoliov1.DownloadAttachmentRequest{
+			Id: cmd.String("attachment-id"),
+		}),
+	)
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+
+	for stream.Receive() {
+		switch data := stream.Msg().Data.(type) {
+		case *portfoliov1.DownloadAttachmentResponse_Attachment:
+			// Only use the base name, so that we do not write outside of
+			// the current directory
+			if path == "" {
+				path = filepath.Base(data.Attachment.FileName)
+			}
+
+			f, err = os.Create(path)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+		case *portfoliov1.DownloadAttachmentResponse_Chunk:
+			if f == nil {
+				return errors.New("received content before the attachment")
+			}
+
+			_, err = f.Write(data.Chunk)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if err = stream.Err(); err != nil {
+		return err
+	} else if f == nil {
+		return errors.New("received no attachment")
+	}
+
+	err = f.Close()
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(cmd.Writer, "Successfully downloaded %s.\n", color.CyanString(path))
+
+	return nil
+}
@@ -0,0 +1,84 @@
+// Copyright 2023 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package commands
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/oxisto/assert"
+	"github.com/oxisto/money-gopher/internal"
+	"github.com/oxisto/money-gopher/internal/testing/clitest"
+	"github.com/oxisto/money-gopher/internal/testing/servertest"
+)
+
+func TestAttachments(t *testing.T) {
+	var (
+		dir  = t.TempDir()
+		file = filepath.Join(dir, "contract-note.pdf")
+		out  = filepath.Join(dir, "downloaded.pdf")
+	)
+
+	db := internal.NewTestDB(t,
+		withPortfolio("myportfolio", "My Portfolio"),
+		withTransaction("buy", "myportfolio", "mysecurity", time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)),
+	)
+	srv := servertest.NewServer(db)
+	defer srv.Close()
+
+	ctx := clitest.NewSessionContext(t, srv)
+
+	err := os.WriteFile(file, []byte("%PDF-1.4 contract note"), 0600)
+	assert.NoError(t, err)
+
+	// Attach the file
+	cmd := clitest.MockCommand(t,
+		PortfolioCmd.Command("transactions").Command("attach").Flags,
+		"--id", "buy",
+		"--file", file,
+	)
+	rec := clitest.Record(cmd)
+	assert.NoError(t, AttachFile(ctx, cmd))
+	assert.Equals(t, true, strings.Contains(rec.String(), "Successfully attached"))
+
+	// List it, including its media type
+	cmd = clitest.MockCommand(t,
+		PortfolioCmd.Command("transactions").Command("attachments").Flags,
+		"--id", "buy",
+	)
+	rec = clitest.Record(cmd)
+	assert.NoError(t, ListAttachments(ctx, cmd))
+	assert.Equals(t, true, strings.Contains(rec.String(), "contract-note.pdf"))
+	assert.Equals(t, true, strings.Contains(rec.String(), "application/pdf"))
+
+	// And download it again
+	id := strings.Fields(rec.String())[1]
+	cmd = clitest.MockCommand(t,
+		PortfolioCmd.Command("transactions").Command("download").Flags,
+		"--attachment-id", id,
+		"--output", out,
+	)
+	clitest.Record(cmd)
+	assert.NoError(t, DownloadAttachment(ctx, cmd))
+
+	content, err := os.ReadFile(out)
+	assert.NoError(t, err)
+	assert.Equals(t, "%PDF-1.4 contract note", string(content))
+}
@@ -0,0 +1,114 @@
+// Copyright 2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	mcli "github.com/oxisto/money-gopher/cli"
+	portfoliov1 "github.com/oxisto/money-gopher/gen"
+
+	"connectrpc.com/connect"
+	"github.com/urfave/cli/v3"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// AuditCmd is the command for audit log related commands.
+var AuditCmd = &cli.Command{
+	Name:   "audit",
+	Usage:  "Inspect the audit log, which records who changed what and when",
+	Before: mcli.InjectSession,
+	Commands: []*cli.Command{
+		{
+			Name:   "list",
+			Usage:  "Lists the most recent changes",
+			Action: ListAuditEvents,
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "subject", Usage: "Only list changes of the user with this subject"},
+				&cli.StringFlag{Name: "resource-type", Usage: "Only list changes of this resource type, e.g. PortfolioEvent"},
+				&cli.StringFlag{Name: "resource-id", Usage: "Only list changes of the resource with this identifier"},
+				&cli.StringFlag{Name: "action", Usage: "Only list changes of this kind, i.e. create, update, delete, import or restore"},
+				&cli.DurationFlag{Name: "since", Usage: "Only list changes within this duration, e.g. 24h"},
+				&cli.IntFlag{Name: "limit", Usage: "The maximum number of changes", Value: 100},
+				&cli.BoolFlag{Name: "values", Usage: "Also print the resources before and after each change"},
+			},
+		},
+	},
+}
+
+// ListAuditEvents lists the most recent audit events, one per line.
+func ListAuditEvents(ctx context.Context, cmd *cli.Command) error {
+	var (
+		s      = mcli.FromContext(ctx)
+		filter = &portfoliov1.ListAuditEventsRequest_Filter{
+			Subject:      cmd.String("subject"),
+			ResourceType: cmd.String("resource-type"),
+			ResourceId:   cmd.String("resource-id"),
+		}
+	)
+
+	if cmd.IsSet("action") {
+		action, ok := portfoliov1.AuditAction_value["AUDIT_ACTION_"+strings.ToUpper(cmd.String("action"))]
+		if !ok {
+			return fmt.Errorf("unknown action %q", cmd.String("action"))
+		}
+
+		filter.Action = portfoliov1.AuditAction(action)
+	}
+
+	if cmd.IsSet("since") {
+		filter.StartTime = timestamppb.New(time.Now().Add(-cmd.Duration("since")))
+	}
+
+	res, err := s.AuditClient.ListAuditEvents(
+		context.Background(),
+		connect.NewRequest(&portfoliov1.ListAuditEventsRequest{
+			Filter:   filter,
+			PageSize: int32(cmd.Int("limit")),
+		}),
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, e := range res.Msg.AuditEvents {
+		fmt.Fprintf(cmd.Writer, "%s %s %s %s/%s",
+			e.Time.AsTime().Local().Format(time.DateTime),
+			e.Subject,
+			strings.ToLower(strings.TrimPrefix(e.Action.String(), "AUDIT_ACTION_")),
+			e.ResourceType,
+			e.ResourceId,
+		)
+
+		if paths := e.UpdateMask.GetPaths(); len(paths) > 0 {
+			fmt.Fprintf(cmd.Writer, " [%s]", strings.Join(paths, ","))
+		}
+
+		fmt.Fprintf(cmd.Writer, " via %s\n", e.Procedure)
+
+		if cmd.Bool("values") {
+			fmt.Fprintf(cmd.Writer, "  before: %s\n  after:  %s\n",
+				protojson.Format(e.Before), protojson.Format(e.After))
+		}
+	}
+
+	return nil
+}
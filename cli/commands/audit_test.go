@@ -0,0 +1,86 @@
+// Copyright 2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package commands
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	mcli "github.com/oxisto/money-gopher/cli"
+	portfoliov1 "github.com/oxisto/money-gopher/gen"
+	"github.com/oxisto/money-gopher/internal"
+	"github.com/oxisto/money-gopher/internal/testing/clitest"
+	"github.com/oxisto/money-gopher/internal/testing/servertest"
+
+	"connectrpc.com/connect"
+	"github.com/oxisto/assert"
+	"github.com/urfave/cli/v3"
+)
+
+func TestListAuditEvents(t *testing.T) {
+	srv := servertest.NewServer(internal.NewTestDB(t))
+	defer srv.Close()
+
+	ctx := clitest.NewSessionContext(t, srv)
+
+	// Changes made through the API are recorded
+	_, err := mcli.FromContext(ctx).PortfolioClient.CreatePortfolio(context.Background(), connect.NewRequest(&portfoliov1.CreatePortfolioRequest{
+		Portfolio: &portfoliov1.Portfolio{Id: "mybank-myportfolio", DisplayName: "My Portfolio"},
+	}))
+	assert.NoError(t, err)
+
+	type args struct {
+		ctx context.Context
+		cmd *cli.Command
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantRec assert.Want[*clitest.CommandRecorder]
+		wantErr bool
+	}{
+		{
+			name: "happy path",
+			args: args{
+				ctx: ctx,
+				cmd: clitest.MockCommand(t,
+					AuditCmd.Command("list").Flags,
+					"--action", "create",
+					"--since", "1h",
+					"--values",
+				),
+			},
+			wantRec: func(t *testing.T, r *clitest.CommandRecorder) bool {
+				return assert.Equals(t, true, strings.Contains(r.String(), "money create Portfolio/mybank-myportfolio via /mgo.portfolio.v1.PortfolioService/CreatePortfolio")) &&
+					assert.Equals(t, true, strings.Contains(r.String(), "My Portfolio"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := clitest.Record(tt.args.cmd)
+			if err := ListAuditEvents(tt.args.ctx, tt.args.cmd); (err != nil) != tt.wantErr {
+				t.Errorf("ListAuditEvents() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantRec != nil {
+				tt.wantRec(t, rec)
+			}
+		})
+	}
+}
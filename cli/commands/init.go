@@ -32,6 +32,12 @@ var CLICmd = &cli.Command{
 		PortfolioCmd,
 		SecuritiesCmd,
 		BankAccountCmd,
+		WatchlistCmd,
+		SavingsPlanCmd,
+		AlertCmd,
+		TokenCmd,
+		AuditCmd,
+		TrashCmd,
 		LoginCmd,
 	},
 }
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/oxisto/money-gopher/auth"
 	mcli "github.com/oxisto/money-gopher/cli"
 	oauth2 "github.com/oxisto/oauth2go"
 
@@ -19,9 +20,11 @@ var LoginCmd = &cli.Command{
 	Usage:  "Login to the Money Gopher server",
 	Action: Login,
 	Flags: []cli.Flag{
+		&cli.StringFlag{Name: "issuer", Usage: "The issuer whose endpoints are discovered using OpenID Connect discovery", Value: "http://localhost:8000"},
 		&cli.StringFlag{Name: "client-id", Usage: "The client ID to use for the OAuth 2.0 flow", Value: "cli"},
-		&cli.StringFlag{Name: "auth-url", Usage: "The authorization URL for the OAuth 2.0 flow", Value: "http://localhost:8000/authorize"},
-		&cli.StringFlag{Name: "token-url", Usage: "The token URL for the OAuth 2.0 flow", Value: "http://localhost:8000/token"},
+		&cli.StringFlag{Name: "auth-url", Usage: "The authorization URL for the OAuth 2.0 flow. Overrides the discovered one"},
+		&cli.StringFlag{Name: "token-url", Usage: "The token URL for the OAuth 2.0 flow. Overrides the discovered one"},
+		&cli.StringSliceFlag{Name: "scope", Usage: "The scopes to request, e.g., openid"},
 		&cli.StringFlag{Name: "callback", Usage: "The callback URL for the OAuth 2.0 flow", Value: "http://localhost:10000/callback"},
 	},
 }
@@ -50,9 +53,11 @@ func Login(ctx context.Context, cmd *cli.Command) error {
 		sock    net.Listener
 		code    string
 		config  *oauth2.Config
+		md      *auth.ProviderMetadata
 	)
 
-	// Create an OAuth 2 config. TODO: Use oauth2 metadata discovery instead
+	// Create an OAuth 2 config. We only need to discover the endpoints, if
+	// they are not both specified
 	config = &oauth2.Config{
 		ClientID: cmd.String("client-id"),
 		Endpoint: oauth2.Endpoint{
@@ -60,6 +65,22 @@ func Login(ctx context.Context, cmd *cli.Command) error {
 			TokenURL: cmd.String("token-url"),
 		},
 		RedirectURL: cmd.String("callback"),
+		Scopes:      cmd.StringSlice("scope"),
+	}
+
+	if config.Endpoint.AuthURL == "" || config.Endpoint.TokenURL == "" {
+		md, err = auth.Discover(ctx, http.DefaultClient, cmd.String("issuer"))
+		if err != nil {
+			return err
+		}
+
+		if config.Endpoint.AuthURL == "" {
+			config.Endpoint.AuthURL = md.AuthorizationEndpoint
+		}
+
+		if config.Endpoint.TokenURL == "" {
+			config.Endpoint.TokenURL = md.TokenEndpoint
+		}
 	}
 
 	srv := newCallbackServer(config)
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -21,59 +22,77 @@ func TestLoginAction(t *testing.T) {
 		verifier string
 		authSrv  *oauth2.AuthorizationServer
 		port     uint16
-		timeout  <-chan time.Time
 	)
 
 	authSrv, port, err = startAuthServer()
 	assert.NoError(t, err)
 
+	// The session contains real tokens, so it must not end up in the repository
+	mcli.SessionFile = filepath.Join(t.TempDir(), "session.json")
+
 	var (
 		clientID = "cli"
+		issuer   = fmt.Sprintf("http://localhost:%d", port)
 		authURL  = fmt.Sprintf("http://localhost:%d/authorize", port)
 		tokenURL = fmt.Sprintf("http://localhost:%d/token", port)
 		callback = "http://localhost:10000/callback"
 	)
-	cmd := LoginCmd
 
 	verifier = "012345678901234567890123456789"
 	VerifierGenerator = func() string {
 		return verifier
 	}
 
-	code := authSrv.IssueCode(oauth2.GenerateCodeChallenge(verifier))
-
-	// Simulate a callback with a timeout of 5 seconds
-	timeout = time.After(5 * time.Second)
-	done := make(chan bool)
-	go func() {
-		go func() {
-			<-callbackServerReady
-			_, err = http.Get(fmt.Sprintf("%s?code=%s", callback, code))
-			if err != nil {
+	tests := []struct {
+		name string
+		args []string
+	}{
+		{
+			name: "discovery",
+			args: []string{"--issuer", issuer},
+		},
+		{
+			name: "explicit endpoints",
+			args: []string{"--auth-url", authURL, "--token-url", tokenURL},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := LoginCmd
+			code := authSrv.IssueCode(oauth2.GenerateCodeChallenge(verifier))
+
+			// Simulate a callback with a timeout of 5 seconds
+			timeout := time.After(5 * time.Second)
+			done := make(chan bool)
+			go func() {
+				go func() {
+					<-callbackServerReady
+					_, err := http.Get(fmt.Sprintf("%s?code=%s", callback, code))
+					if err != nil {
+						assert.NoError(t, err)
+					}
+				}()
+
+				err := cmd.Run(context.Background(), append([]string{
+					"login",
+					"--client-id", clientID,
+					"--callback", callback,
+				}, tt.args...))
 				assert.NoError(t, err)
-			}
-		}()
-
-		err = cmd.Run(context.Background(), []string{
-			"login",
-			"--client-id", clientID,
-			"--auth-url", authURL,
-			"--token-url", tokenURL,
-			"--callback", callback,
-		})
-		assert.NoError(t, err)
 
-		// Resume the session
-		_, err := mcli.ContinueSession()
-		assert.NoError(t, err)
+				// Resume the session
+				_, err = mcli.ContinueSession()
+				assert.NoError(t, err)
 
-		done <- true
-	}()
+				done <- true
+			}()
 
-	select {
-	case <-timeout:
-		t.Fatal("Did not finish in time")
-	case <-done:
+			select {
+			case <-timeout:
+				t.Fatal("Did not finish in time")
+			case <-done:
+			}
+		})
 	}
 }
 
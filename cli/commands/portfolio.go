@@ -18,6 +18,7 @@ package commands
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -30,6 +31,8 @@ import (
 	"connectrpc.com/connect"
 	"github.com/fatih/color"
 	"github.com/urfave/cli/v3"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -46,20 +49,28 @@ var PortfolioCmd = &cli.Command{
 			Flags: []cli.Flag{
 				&cli.StringFlag{Name: "id", Usage: "The identifier of the portfolio, e.g. mybank-myportfolio", Required: true},
 				&cli.StringFlag{Name: "display-name", Usage: "The display name of the portfolio"},
+				&cli.StringFlag{Name: "bank-account-id", Usage: "The identifier of the bank account that transactions settle against"},
+				&cli.StringFlag{Name: "note", Usage: "A note about the portfolio, e.g. its strategy"},
+				&cli.StringSliceFlag{Name: "tag", Usage: "A tag of the portfolio. Can be specified multiple times"},
 			},
 		},
 		{
 			Name:   "list",
 			Usage:  "Lists all portfolios",
 			Action: ListPortfolio,
-			Flags:  []cli.Flag{},
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "tag", Usage: "Only lists portfolios with this tag"},
+			},
 		},
 		{
 			Name:   "show",
-			Usage:  "Shows details about one portfolio",
+			Usage:  "Shows details about one portfolio or a consolidated view of several portfolios",
 			Action: ShowPortfolio,
 			Flags: []cli.Flag{
-				&cli.StringFlag{Name: "portfolio-id", Usage: "The identifier of the portfolio, e.g. mybank-myportfolio", Required: true},
+				&cli.StringSliceFlag{Name: "portfolio-id", Usage: "The identifier of the portfolio, e.g. mybank-myportfolio. Can be specified multiple times to consolidate several portfolios", Required: true},
+				&cli.BoolFlag{Name: "watch", Usage: "Keeps running and shows a new snapshot whenever the portfolio changes"},
+				&cli.StringFlag{Name: "tag", Usage: "Only includes transactions with this tag and transactions of securities with this tag"},
+				&cli.TimestampFlag{Name: "project-until", Usage: "Projects the portfolio to this date, including future executions of its savings plans, e.g. 2030-12-31", Config: cli.TimestampConfig{Layouts: []string{time.DateOnly}}},
 			},
 		},
 		{
@@ -79,6 +90,8 @@ var PortfolioCmd = &cli.Command{
 						&cli.FloatFlag{Name: "fees", Usage: "Any fees that applied to the transaction"},
 						&cli.FloatFlag{Name: "taxes", Usage: "Any taxes that applied to the transaction"},
 						&cli.StringFlag{Name: "time", Usage: "The time of the transaction. Defaults to 'now'", DefaultText: "now"},
+						&cli.StringFlag{Name: "note", Usage: "A note about the transaction, e.g. why the security was bought"},
+						&cli.StringSliceFlag{Name: "tag", Usage: "A tag of the transaction, e.g. its strategy. Can be specified multiple times"},
 					},
 				},
 				{
@@ -90,6 +103,80 @@ var PortfolioCmd = &cli.Command{
 						&cli.StringFlag{Name: "csv-file", Usage: "The path to the CSV file to import", Required: true},
 					},
 				},
+				{
+					Name:   "update",
+					Usage:  "Updates all transactions with the given IDs or matching the filter at once",
+					Action: UpdateTransactions,
+					Flags: append(transactionSelectionFlags(),
+						&cli.StringFlag{Name: "set-portfolio-id", Usage: "Moves the transactions to this portfolio"},
+						&cli.StringFlag{Name: "set-security-id", Usage: "Sets the ID of the security (its ISIN)"},
+						&cli.StringFlag{Name: "set-type", Usage: "Sets the type of the transactions"},
+						&cli.FloatFlag{Name: "set-fees", Usage: "Sets the fees that applied to the transactions"},
+						&cli.FloatFlag{Name: "set-taxes", Usage: "Sets the taxes that applied to the transactions"},
+						&cli.StringFlag{Name: "set-note", Usage: "Sets the note of the transactions"},
+						&cli.StringSliceFlag{Name: "set-tag", Usage: "Sets the tags of the transactions. Can be specified multiple times"},
+					),
+				},
+				{
+					Name:   "confirm",
+					Usage:  "Confirms a pending transaction of a savings plan, optionally adjusting it to the actual execution",
+					Action: ConfirmTransaction,
+					Flags: []cli.Flag{
+						&cli.StringFlag{Name: "id", Usage: "The ID of the pending transaction", Required: true},
+						&cli.FloatFlag{Name: "amount", Usage: "The actual amount of securities that were bought"},
+						&cli.FloatFlag{Name: "price", Usage: "The actual price without fees or taxes"},
+						&cli.FloatFlag{Name: "fees", Usage: "The actual fees that applied to the transaction"},
+						&cli.FloatFlag{Name: "taxes", Usage: "The actual taxes that applied to the transaction"},
+						&cli.TimestampFlag{Name: "time", Usage: "The actual date of the execution, e.g. 2024-01-16", Config: cli.TimestampConfig{Layouts: []string{time.DateOnly}}},
+					},
+				},
+				{
+					Name:   "delete",
+					Usage:  "Deletes all transactions with the given IDs or matching the filter at once",
+					Action: DeleteTransactions,
+					Flags:  transactionSelectionFlags(),
+				},
+				{
+					Name:   "transfer",
+					Usage:  "Transfers shares or cash from one portfolio to another",
+					Action: CreateTransfer,
+					Flags: []cli.Flag{
+						&cli.StringFlag{Name: "from-portfolio-id", Usage: "The identifier of the portfolio the shares or cash are taken from", Required: true},
+						&cli.StringFlag{Name: "to-portfolio-id", Usage: "The identifier of the portfolio that receives the shares or cash", Required: true},
+						&cli.StringFlag{Name: "security-id", Usage: "The ID of the security to transfer (its ISIN). If it is not set, cash is transferred"},
+						&cli.FloatFlag{Name: "amount", Usage: "The amount of shares to transfer"},
+						&cli.FloatFlag{Name: "cash", Usage: "The amount of cash to transfer"},
+						&cli.FloatFlag{Name: "fees", Usage: "Any fees that the source portfolio pays for the transfer"},
+						&cli.TimestampFlag{Name: "time", Usage: "The date of the transfer, e.g. 2023-01-01. Defaults to 'now'", Config: cli.TimestampConfig{Layouts: []string{time.DateOnly}}},
+					},
+				},
+				{
+					Name:   "attach",
+					Usage:  "Attaches a document, e.g. the contract note of the broker, to a transaction",
+					Action: AttachFile,
+					Flags: []cli.Flag{
+						&cli.StringFlag{Name: "id", Usage: "The ID of the transaction", Required: true},
+						&cli.StringFlag{Name: "file", Usage: "The path to the file to attach", Required: true},
+						&cli.StringFlag{Name: "content-type", Usage: "The media type of the file. Defaults to the one of its extension"},
+					},
+				},
+				{
+					Name:   "attachments",
+					Usage:  "Lists the attachments of a transaction",
+					Action: ListAttachments,
+					Flags: []cli.Flag{
+						&cli.StringFlag{Name: "id", Usage: "The ID of the transaction", Required: true},
+					},
+				},
+				{
+					Name:   "download",
+					Usage:  "Downloads an attachment of a transaction",
+					Action: DownloadAttachment,
+					Flags: []cli.Flag{
+						&cli.StringFlag{Name: "attachment-id", Usage: "The ID of the attachment", Required: true},
+						&cli.StringFlag{Name: "output", Usage: "The path of the downloaded file. Defaults to the file name of the attachment"},
+					},
+				},
 			},
 		},
 	},
@@ -100,7 +187,9 @@ func ListPortfolio(ctx context.Context, cmd *cli.Command) error {
 	s := mcli.FromContext(ctx)
 	res, err := s.PortfolioClient.ListPortfolios(
 		context.Background(),
-		connect.NewRequest(&portfoliov1.ListPortfoliosRequest{}),
+		connect.NewRequest(&portfoliov1.ListPortfoliosRequest{
+			Tag: cmd.String("tag"),
+		}),
 	)
 	if err != nil {
 		return err
@@ -149,8 +238,11 @@ func CreatePortfolio(ctx context.Context, cmd *cli.Command) error {
 		context.Background(),
 		connect.NewRequest(&portfoliov1.CreatePortfolioRequest{
 			Portfolio: &portfoliov1.Portfolio{
-				Id:          cmd.String("id"),
-				DisplayName: cmd.String("display-name"),
+				Id:            cmd.String("id"),
+				DisplayName:   cmd.String("display-name"),
+				BankAccountId: cmd.String("bank-account-id"),
+				Note:          cmd.String("note"),
+				Tags:          cmd.StringSlice("tag"),
 			},
 		}),
 	)
@@ -165,12 +257,44 @@ func CreatePortfolio(ctx context.Context, cmd *cli.Command) error {
 // ShowPortfolio shows details about a portfolio.
 func ShowPortfolio(ctx context.Context, cmd *cli.Command) error {
 	s := mcli.FromContext(ctx)
+	ids := cmd.StringSlice("portfolio-id")
+
+	if len(ids) > 1 {
+		if cmd.Bool("watch") {
+			return errors.New("--watch only supports a single portfolio")
+		} else if cmd.IsSet("tag") {
+			return errors.New("--tag only supports a single portfolio")
+		} else if cmd.IsSet("project-until") {
+			return errors.New("--project-until only supports a single portfolio")
+		}
+
+		return showConsolidated(ctx, s, cmd, ids)
+	}
+
+	if cmd.Bool("watch") {
+		if cmd.IsSet("tag") {
+			return errors.New("--tag cannot be combined with --watch")
+		} else if cmd.IsSet("project-until") {
+			return errors.New("--project-until cannot be combined with --watch")
+		}
+
+		return watchPortfolio(ctx, s, cmd, ids[0])
+	}
+
+	req := &portfoliov1.GetPortfolioSnapshotRequest{
+		PortfolioId: ids[0],
+		Time:        timestamppb.Now(),
+		Tag:         cmd.String("tag"),
+	}
+
+	if cmd.IsSet("project-until") {
+		req.Time = timestamppb.New(cmd.Timestamp("project-until"))
+		req.ProjectSavingsPlans = true
+	}
+
 	res, err := s.PortfolioClient.GetPortfolioSnapshot(
 		context.Background(),
-		connect.NewRequest(&portfoliov1.GetPortfolioSnapshotRequest{
-			PortfolioId: cmd.String("portfolio-id"),
-			Time:        timestamppb.Now(),
-		}),
+		connect.NewRequest(req),
 	)
 	if err != nil {
 		return err
@@ -180,6 +304,54 @@ func ShowPortfolio(ctx context.Context, cmd *cli.Command) error {
 	return nil
 }
 
+// showConsolidated shows a consolidated snapshot of several portfolios,
+// followed by a breakdown per portfolio.
+func showConsolidated(ctx context.Context, s *mcli.Session, cmd *cli.Command, ids []string) error {
+	res, err := s.PortfolioClient.GetConsolidatedSnapshot(
+		ctx,
+		connect.NewRequest(&portfoliov1.GetConsolidatedSnapshotRequest{
+			PortfolioIds: ids,
+		}),
+	)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(cmd.Writer, res.Msg.Total)
+
+	for _, id := range ids {
+		snap := res.Msg.Portfolios[id]
+		fmt.Fprintf(cmd.Writer, "| %-*s | %*s | %*s |\n",
+			30, id,
+			15, snap.TotalMarketValue.Pretty(),
+			15, snap.Cash.Pretty(),
+		)
+	}
+
+	return nil
+}
+
+// watchPortfolio shows a new snapshot of the portfolio whenever it changes,
+// until ctx is done.
+func watchPortfolio(ctx context.Context, s *mcli.Session, cmd *cli.Command, id string) error {
+	stream, err := s.PortfolioClient.WatchPortfolioSnapshot(
+		ctx,
+		connect.NewRequest(&portfoliov1.WatchPortfolioSnapshotRequest{
+			PortfolioId: id,
+		}),
+	)
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+
+	for stream.Receive() {
+		fmt.Fprintln(cmd.Writer, stream.Msg())
+	}
+
+	return stream.Err()
+}
+
 func greenOrRed(f float64) string {
 	if f < 0 {
 		return color.RedString("%.02f", f)
@@ -201,6 +373,8 @@ func CreateTransaction(ctx context.Context, cmd *cli.Command) error {
 			Price:       portfoliov1.Value(int32(cmd.Float("price") * 100)),
 			Fees:        portfoliov1.Value(int32(cmd.Float("fees") * 100)),
 			Taxes:       portfoliov1.Value(int32(cmd.Float("taxes") * 100)),
+			Note:        cmd.String("note"),
+			Tags:        cmd.StringSlice("tag"),
 		},
 	})
 
@@ -271,6 +445,236 @@ func ImportTransactions(ctx context.Context, cmd *cli.Command) error {
 	return nil
 }
 
+// transactionSelectionFlags returns the flags that select the transactions of
+// a batch operation, either by their IDs or by a filter on a portfolio.
+func transactionSelectionFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringSliceFlag{Name: "id", Usage: "The ID of a transaction. Can be specified multiple times"},
+		&cli.StringFlag{Name: "portfolio-id", Usage: "Selects the transactions of this portfolio that match the filter"},
+		&cli.TimestampFlag{Name: "after", Usage: "Only selects transactions at or after this date, e.g. 2023-01-01", Config: cli.TimestampConfig{Layouts: []string{time.DateOnly}}},
+		&cli.TimestampFlag{Name: "before", Usage: "Only selects transactions before this date, e.g. 2024-01-01", Config: cli.TimestampConfig{Layouts: []string{time.DateOnly}}},
+		&cli.StringSliceFlag{Name: "type", Usage: "Only selects transactions of this type. Can be specified multiple times"},
+		&cli.StringFlag{Name: "security-id", Usage: "Only selects transactions of this security"},
+		&cli.StringFlag{Name: "tag", Usage: "Only selects transactions with this tag"},
+	}
+}
+
+// selectTransactions returns the IDs of the transactions selected by the
+// flags of [transactionSelectionFlags].
+func selectTransactions(ctx context.Context, s *mcli.Session, cmd *cli.Command) (ids []string, err error) {
+	if ids = cmd.StringSlice("id"); len(ids) > 0 {
+		return ids, nil
+	} else if !cmd.IsSet("portfolio-id") {
+		return nil, errors.New("either --id or --portfolio-id is required")
+	}
+
+	filter := &portfoliov1.ListPortfolioTransactionsRequest_Filter{
+		SecurityId: cmd.String("security-id"),
+		Tag:        cmd.String("tag"),
+	}
+
+	if cmd.IsSet("after") {
+		filter.StartTime = timestamppb.New(cmd.Timestamp("after"))
+	}
+
+	if cmd.IsSet("before") {
+		filter.EndTime = timestamppb.New(cmd.Timestamp("before"))
+	}
+
+	for _, typ := range cmd.StringSlice("type") {
+		filter.Types = append(filter.Types, eventTypeFrom(typ))
+	}
+
+	res, err := s.PortfolioClient.ListPortfolioTransactions(
+		ctx,
+		connect.NewRequest(&portfoliov1.ListPortfolioTransactionsRequest{
+			PortfolioId: cmd.String("portfolio-id"),
+			Filter:      filter,
+		}),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tx := range res.Msg.Transactions {
+		ids = append(ids, tx.Id)
+	}
+
+	return ids, nil
+}
+
+// UpdateTransactions updates several transactions at once.
+func UpdateTransactions(ctx context.Context, cmd *cli.Command) error {
+	var (
+		s    = mcli.FromContext(ctx)
+		in   = &portfoliov1.PortfolioEvent{}
+		mask = &fieldmaskpb.FieldMask{}
+	)
+
+	if cmd.IsSet("set-portfolio-id") {
+		in.PortfolioId = cmd.String("set-portfolio-id")
+		mask.Paths = append(mask.Paths, "portfolio_id")
+	}
+
+	if cmd.IsSet("set-security-id") {
+		in.SecurityId = cmd.String("set-security-id")
+		mask.Paths = append(mask.Paths, "security_id")
+	}
+
+	if cmd.IsSet("set-type") {
+		in.Type = eventTypeFrom(cmd.String("set-type"))
+		mask.Paths = append(mask.Paths, "type")
+	}
+
+	if cmd.IsSet("set-fees") {
+		in.Fees = portfoliov1.Value(int32(cmd.Float("set-fees") * 100))
+		mask.Paths = append(mask.Paths, "fees")
+	}
+
+	if cmd.IsSet("set-taxes") {
+		in.Taxes = portfoliov1.Value(int32(cmd.Float("set-taxes") * 100))
+		mask.Paths = append(mask.Paths, "taxes")
+	}
+
+	if cmd.IsSet("set-note") {
+		in.Note = cmd.String("set-note")
+		mask.Paths = append(mask.Paths, "note")
+	}
+
+	if cmd.IsSet("set-tag") {
+		in.Tags = cmd.StringSlice("set-tag")
+		mask.Paths = append(mask.Paths, "tags")
+	}
+
+	if len(mask.Paths) == 0 {
+		return errors.New("nothing to update")
+	}
+
+	ids, err := selectTransactions(ctx, s, cmd)
+	if err != nil {
+		return err
+	}
+
+	req := &portfoliov1.BatchUpdatePortfolioTransactionsRequest{}
+	for _, id := range ids {
+		tx := proto.Clone(in).(*portfoliov1.PortfolioEvent)
+		tx.Id = id
+
+		req.Requests = append(req.Requests, &portfoliov1.UpdatePortfolioTransactionRequest{
+			Transaction: tx,
+			UpdateMask:  mask,
+		})
+	}
+
+	res, err := s.PortfolioClient.BatchUpdatePortfolioTransactions(ctx, connect.NewRequest(req))
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(cmd.Writer, "Successfully updated %s transaction(s).\n", color.GreenString("%d", len(res.Msg.Transactions)))
+
+	return nil
+}
+
+// ConfirmTransaction confirms a pending transaction of a savings plan. Flags
+// that are not set keep the expected value.
+func ConfirmTransaction(ctx context.Context, cmd *cli.Command) error {
+	var (
+		s   = mcli.FromContext(ctx)
+		req = &portfoliov1.ConfirmPortfolioTransactionRequest{Id: cmd.String("id")}
+	)
+
+	if cmd.IsSet("amount") {
+		amount := cmd.Float("amount")
+		req.Amount = &amount
+	}
+
+	if cmd.IsSet("price") {
+		req.Price = portfoliov1.Value(int32(cmd.Float("price") * 100))
+	}
+
+	if cmd.IsSet("fees") {
+		req.Fees = portfoliov1.Value(int32(cmd.Float("fees") * 100))
+	}
+
+	if cmd.IsSet("taxes") {
+		req.Taxes = portfoliov1.Value(int32(cmd.Float("taxes") * 100))
+	}
+
+	if cmd.IsSet("time") {
+		req.Time = timestamppb.New(cmd.Timestamp("time"))
+	}
+
+	res, err := s.PortfolioClient.ConfirmPortfolioTransaction(ctx, connect.NewRequest(req))
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(cmd.Writer, "Successfully confirmed transaction %s: %g shares for %s.\n",
+		color.GreenString(res.Msg.Id),
+		res.Msg.Amount,
+		color.CyanString(res.Msg.Price.Pretty()),
+	)
+
+	return nil
+}
+
+// DeleteTransactions deletes several transactions at once.
+func DeleteTransactions(ctx context.Context, cmd *cli.Command) error {
+	s := mcli.FromContext(ctx)
+
+	ids, err := selectTransactions(ctx, s, cmd)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.PortfolioClient.BatchDeletePortfolioTransactions(
+		ctx,
+		connect.NewRequest(&portfoliov1.BatchDeletePortfolioTransactionsRequest{
+			Ids: ids,
+		}),
+	)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(cmd.Writer, "Successfully deleted %s transaction(s).\n", color.GreenString("%d", len(ids)))
+
+	return nil
+}
+
+// CreateTransfer transfers shares or cash between two portfolios.
+func CreateTransfer(ctx context.Context, cmd *cli.Command) error {
+	s := mcli.FromContext(ctx)
+
+	res, err := s.PortfolioClient.CreatePortfolioTransfer(
+		ctx,
+		connect.NewRequest(&portfoliov1.CreatePortfolioTransferRequest{
+			Transfer: &portfoliov1.PortfolioTransfer{
+				SourcePortfolioId: cmd.String("from-portfolio-id"),
+				TargetPortfolioId: cmd.String("to-portfolio-id"),
+				SecurityId:        cmd.String("security-id"),
+				Amount:            cmd.Float("amount"),
+				Cash:              portfoliov1.Value(int32(cmd.Float("cash") * 100)),
+				Fees:              portfoliov1.Value(int32(cmd.Float("fees") * 100)),
+				Time:              timeOrNow(cmd.Timestamp("time")),
+			},
+		}),
+	)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(cmd.Writer, "Successfully created transfer %s from %s to %s with %s transaction(s).\n",
+		color.GreenString(res.Msg.Id),
+		color.CyanString(res.Msg.SourcePortfolioId),
+		color.CyanString(res.Msg.TargetPortfolioId),
+		color.GreenString("%d", len(res.Msg.Transactions)),
+	)
+
+	return nil
+}
+
 // PredictPortfolios predicts the portfolios for shell completion.
 func PredictPortfolios(ctx context.Context, cmd *cli.Command) {
 	s := mcli.FromContext(ctx)
@@ -18,15 +18,32 @@ package commands
 
 import (
 	"context"
+	"strings"
 	"testing"
+	"time"
 
+	"connectrpc.com/connect"
 	"github.com/oxisto/assert"
+	portfoliov1 "github.com/oxisto/money-gopher/gen"
 	"github.com/oxisto/money-gopher/internal"
 	"github.com/oxisto/money-gopher/internal/testing/clitest"
 	"github.com/oxisto/money-gopher/internal/testing/servertest"
+	"github.com/oxisto/money-gopher/persistence"
 	"github.com/urfave/cli/v3"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// withPortfolio creates a portfolio that is owned by our test user.
+func withPortfolio(id string, displayName string) func(db *persistence.DB) {
+	return func(db *persistence.DB) {
+		_ = persistence.Ops[*portfoliov1.Portfolio](db).Replace(context.Background(), &portfoliov1.Portfolio{
+			Id:          id,
+			DisplayName: displayName,
+			Owner:       internal.TestUser,
+		})
+	}
+}
+
 func TestListPortfolio(t *testing.T) {
 	srv := servertest.NewServer(internal.NewTestDB(t))
 	defer srv.Close()
@@ -90,7 +107,7 @@ func TestCreatePortfolio(t *testing.T) {
 }
 
 func TestShowPortfolio(t *testing.T) {
-	srv := servertest.NewServer(internal.NewTestDB(t))
+	srv := servertest.NewServer(internal.NewTestDB(t, withPortfolio("myportfolio", "My Portfolio")))
 	defer srv.Close()
 
 	type args struct {
@@ -130,8 +147,84 @@ func TestShowPortfolio(t *testing.T) {
 	}
 }
 
+func TestShowPortfolio_watch(t *testing.T) {
+	srv := servertest.NewServer(internal.NewTestDB(t, withPortfolio("myportfolio", "My Portfolio")))
+	defer srv.Close()
+
+	// The snapshot is watched until the context is done
+	ctx, cancel := context.WithTimeout(clitest.NewSessionContext(t, srv), 500*time.Millisecond)
+	defer cancel()
+
+	// The flags of the show command keep their values between tests, so we
+	// use our own
+	cmd := clitest.MockCommand(t,
+		[]cli.Flag{
+			&cli.StringSliceFlag{Name: "portfolio-id"},
+			&cli.BoolFlag{Name: "watch"},
+		},
+		"--portfolio-id", "myportfolio",
+		"--watch",
+	)
+
+	rec := clitest.NewCommandRecorder()
+	cmd.Writer = rec
+
+	err := ShowPortfolio(ctx, cmd)
+	assert.Equals(t, connect.CodeDeadlineExceeded, connect.CodeOf(err))
+	assert.Equals(t, true, strings.Contains(rec.String(), "total_market_value"))
+}
+
+func TestShowPortfolio_consolidated(t *testing.T) {
+	srv := servertest.NewServer(internal.NewTestDB(t,
+		withPortfolio("myportfolio", "My Portfolio"),
+		withPortfolio("otherportfolio", "Other Portfolio"),
+		withTransaction("buy1", "myportfolio", "mysecurity", time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)),
+		withTransaction("buy2", "otherportfolio", "mysecurity", time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)),
+	))
+	defer srv.Close()
+
+	type args struct {
+		ctx context.Context
+		cmd *cli.Command
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+		wantRec assert.Want[*clitest.CommandRecorder]
+	}{
+		{
+			name: "two portfolios",
+			args: args{
+				ctx: clitest.NewSessionContext(t, srv),
+				cmd: clitest.MockCommand(t,
+					PortfolioCmd.Command("show").Flags,
+					"--portfolio-id", "myportfolio",
+					"--portfolio-id", "otherportfolio",
+				),
+			},
+			wantRec: func(t *testing.T, r *clitest.CommandRecorder) bool {
+				return assert.Equals(t, true, strings.Contains(r.String(), "| otherportfolio                 |          10 EUR |         -10 EUR |\n"))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := clitest.Record(tt.args.cmd)
+			if err := ShowPortfolio(tt.args.ctx, tt.args.cmd); (err != nil) != tt.wantErr {
+				t.Errorf("ShowPortfolio() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantRec != nil {
+				tt.wantRec(t, rec)
+			}
+		})
+	}
+}
+
 func TestCreateTransaction(t *testing.T) {
-	srv := servertest.NewServer(internal.NewTestDB(t))
+	srv := servertest.NewServer(internal.NewTestDB(t, withPortfolio("myportfolio", "My Portfolio")))
 	defer srv.Close()
 
 	type args struct {
@@ -172,7 +265,7 @@ func TestCreateTransaction(t *testing.T) {
 }
 
 func TestImportTransactions(t *testing.T) {
-	srv := servertest.NewServer(internal.NewTestDB(t))
+	srv := servertest.NewServer(internal.NewTestDB(t, withPortfolio("myportfolio", "My Portfolio")))
 	defer srv.Close()
 
 	type args struct {
@@ -206,8 +299,182 @@ func TestImportTransactions(t *testing.T) {
 	}
 }
 
+// withTransaction creates a buy transaction in the portfolio with the given ID.
+func withTransaction(id string, portfolioID string, securityID string, t time.Time) func(db *persistence.DB) {
+	return func(db *persistence.DB) {
+		_ = persistence.Ops[*portfoliov1.PortfolioEvent](db).Replace(context.Background(), &portfoliov1.PortfolioEvent{
+			Id:          id,
+			Type:        portfoliov1.PortfolioEventType_PORTFOLIO_EVENT_TYPE_BUY,
+			PortfolioId: portfolioID,
+			SecurityId:  securityID,
+			Amount:      1,
+			Price:       portfoliov1.Value(1000),
+			Time:        timestamppb.New(t),
+		})
+	}
+}
+
+func TestUpdateTransactions(t *testing.T) {
+	db := internal.NewTestDB(t,
+		withPortfolio("myportfolio", "My Portfolio"),
+		withTransaction("buy1", "myportfolio", "mysecurity", time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)),
+		withTransaction("buy2", "myportfolio", "mysecurity", time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)),
+	)
+	srv := servertest.NewServer(db)
+	defer srv.Close()
+
+	type args struct {
+		ctx context.Context
+		cmd *cli.Command
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantRec assert.Want[*clitest.CommandRecorder]
+		wantErr bool
+	}{
+		{
+			name: "by filter",
+			args: args{
+				ctx: clitest.NewSessionContext(t, srv),
+				cmd: clitest.MockCommand(t,
+					PortfolioCmd.Command("transactions").Command("update").Flags,
+					"--portfolio-id", "myportfolio",
+					"--after", "2022-06-01",
+					"--set-security-id", "othersecurity",
+				),
+			},
+			wantRec: func(t *testing.T, r *clitest.CommandRecorder) bool {
+				tx, _ := persistence.Ops[*portfoliov1.PortfolioEvent](db).Get(context.Background(), "buy2")
+				return assert.Equals(t, "Successfully updated 1 transaction(s).\n", r.String()) &&
+					assert.Equals(t, "othersecurity", tx.SecurityId)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := clitest.Record(tt.args.cmd)
+			if err := UpdateTransactions(tt.args.ctx, tt.args.cmd); (err != nil) != tt.wantErr {
+				t.Errorf("UpdateTransactions() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantRec != nil {
+				tt.wantRec(t, rec)
+			}
+		})
+	}
+}
+
+func TestDeleteTransactions(t *testing.T) {
+	db := internal.NewTestDB(t,
+		withPortfolio("myportfolio", "My Portfolio"),
+		withTransaction("buy1", "myportfolio", "mysecurity", time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)),
+		withTransaction("buy2", "myportfolio", "mysecurity", time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)),
+	)
+	srv := servertest.NewServer(db)
+	defer srv.Close()
+
+	type args struct {
+		ctx context.Context
+		cmd *cli.Command
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantRec assert.Want[*clitest.CommandRecorder]
+		wantErr bool
+	}{
+		{
+			name: "by IDs",
+			args: args{
+				ctx: clitest.NewSessionContext(t, srv),
+				cmd: clitest.MockCommand(t,
+					PortfolioCmd.Command("transactions").Command("delete").Flags,
+					"--id", "buy1",
+					"--id", "buy2",
+				),
+			},
+			wantRec: func(t *testing.T, r *clitest.CommandRecorder) bool {
+				list, _ := persistence.Ops[*portfoliov1.PortfolioEvent](db).List(context.Background(), "myportfolio")
+				return assert.Equals(t, "Successfully deleted 2 transaction(s).\n", r.String()) &&
+					assert.Equals(t, 0, len(list))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := clitest.Record(tt.args.cmd)
+			if err := DeleteTransactions(tt.args.ctx, tt.args.cmd); (err != nil) != tt.wantErr {
+				t.Errorf("DeleteTransactions() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantRec != nil {
+				tt.wantRec(t, rec)
+			}
+		})
+	}
+}
+
+func TestCreateTransfer(t *testing.T) {
+	db := internal.NewTestDB(t,
+		withPortfolio("myportfolio", "My Portfolio"),
+		withPortfolio("otherportfolio", "Other Portfolio"),
+		withTransaction("buy1", "myportfolio", "mysecurity", time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)),
+		withTransaction("buy2", "myportfolio", "mysecurity", time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)),
+	)
+	srv := servertest.NewServer(db)
+	defer srv.Close()
+
+	type args struct {
+		ctx context.Context
+		cmd *cli.Command
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantRec assert.Want[*clitest.CommandRecorder]
+		wantErr bool
+	}{
+		{
+			name: "shares",
+			args: args{
+				ctx: clitest.NewSessionContext(t, srv),
+				cmd: clitest.MockCommand(t,
+					PortfolioCmd.Command("transactions").Command("transfer").Flags,
+					"--from-portfolio-id", "myportfolio",
+					"--to-portfolio-id", "otherportfolio",
+					"--security-id", "mysecurity",
+					"--amount", "2",
+					"--time", "2024-01-01",
+				),
+			},
+			wantRec: func(t *testing.T, r *clitest.CommandRecorder) bool {
+				list, _ := persistence.Ops[*portfoliov1.PortfolioEvent](db).List(context.Background(), "otherportfolio")
+				return assert.Equals(t, true, strings.HasSuffix(r.String(), "from myportfolio to otherportfolio with 3 transaction(s).\n")) &&
+					assert.Equals(t, 2, len(list)) &&
+					assert.Equals(t, time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), list[0].PurchaseTime.AsTime())
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := clitest.Record(tt.args.cmd)
+			if err := CreateTransfer(tt.args.ctx, tt.args.cmd); (err != nil) != tt.wantErr {
+				t.Errorf("CreateTransfer() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantRec != nil {
+				tt.wantRec(t, rec)
+			}
+		})
+	}
+}
+
 func TestPredictPortfolios(t *testing.T) {
-	srv := servertest.NewServer(internal.NewTestDB(t))
+	srv := servertest.NewServer(internal.NewTestDB(t, withPortfolio("mybank-myportfolio", "My Portfolio")))
 	defer srv.Close()
 
 	type args struct {
@@ -0,0 +1,176 @@
+// Copyright 2023 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	mcli "github.com/oxisto/money-gopher/cli"
+	portfoliov1 "github.com/oxisto/money-gopher/gen"
+
+	"connectrpc.com/connect"
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v3"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// SavingsPlanCmd is the command for savings plan related commands.
+var SavingsPlanCmd = &cli.Command{
+	Name:   "savings-plan",
+	Usage:  "Manage savings plans that buy a security in regular intervals",
+	Before: mcli.InjectSession,
+	Commands: []*cli.Command{
+		{
+			Name:   "create",
+			Usage:  "Creates a new savings plan",
+			Action: CreateSavingsPlan,
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "id", Usage: "The identifier of the savings plan, e.g. myetf", Required: true},
+				&cli.StringFlag{Name: "portfolio-id", Usage: "The identifier of the portfolio the security is bought for", Required: true},
+				&cli.StringFlag{Name: "security-id", Usage: "The ID of the security that is bought (its ISIN)", Required: true},
+				&cli.StringFlag{Name: "display-name", Usage: "The display name of the savings plan"},
+				&cli.FloatFlag{Name: "amount", Usage: "The number of shares that are bought on each execution"},
+				&cli.FloatFlag{Name: "value", Usage: "The amount of money that is invested on each execution. Use either this or --amount"},
+				&cli.IntFlag{Name: "interval-months", Usage: "The number of months between two executions, e.g. 3 for a quarterly plan", Value: 1},
+				&cli.TimestampFlag{Name: "start", Usage: "The date of the first execution, e.g. 2024-01-15", Required: true, Config: cli.TimestampConfig{Layouts: []string{time.DateOnly}}},
+				&cli.TimestampFlag{Name: "end", Usage: "The date after which the plan is not executed anymore", Config: cli.TimestampConfig{Layouts: []string{time.DateOnly}}},
+				&cli.FloatFlag{Name: "fixed-fee", Usage: "The fee that applies to each execution"},
+				&cli.FloatFlag{Name: "fee-percentage", Usage: "The percentage of the invested value that applies as a fee, e.g. 1.5"},
+			},
+		},
+		{
+			Name:   "list",
+			Usage:  "Lists the savings plans of a portfolio",
+			Action: ListSavingsPlans,
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "portfolio-id", Usage: "The identifier of the portfolio", Required: true},
+			},
+		},
+		{
+			Name:   "delete",
+			Usage:  "Deletes a savings plan. Its transactions are kept",
+			Action: DeleteSavingsPlan,
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "id", Usage: "The identifier of the savings plan", Required: true},
+			},
+		},
+	},
+}
+
+// CreateSavingsPlan creates a new savings plan.
+func CreateSavingsPlan(ctx context.Context, cmd *cli.Command) error {
+	var (
+		s    = mcli.FromContext(ctx)
+		plan = &portfoliov1.SavingsPlan{
+			Id:             cmd.String("id"),
+			PortfolioId:    cmd.String("portfolio-id"),
+			SecurityId:     cmd.String("security-id"),
+			DisplayName:    cmd.String("display-name"),
+			Amount:         cmd.Float("amount"),
+			IntervalMonths: int32(cmd.Int("interval-months")),
+			StartTime:      timestamppb.New(cmd.Timestamp("start")),
+			FixedFee:       portfoliov1.Value(int32(cmd.Float("fixed-fee") * 100)),
+			FeePercentage:  cmd.Float("fee-percentage") / 100,
+		}
+	)
+
+	if cmd.IsSet("value") {
+		plan.Value = portfoliov1.Value(int32(cmd.Float("value") * 100))
+	}
+
+	if cmd.IsSet("end") {
+		plan.EndTime = timestamppb.New(cmd.Timestamp("end"))
+	}
+
+	res, err := s.PortfolioClient.CreateSavingsPlan(
+		context.Background(),
+		connect.NewRequest(&portfoliov1.CreateSavingsPlanRequest{
+			SavingsPlan: plan,
+		}),
+	)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(cmd.Writer, "Successfully created savings plan %s, which is next executed on %s.\n",
+		color.GreenString(res.Msg.Id),
+		color.CyanString(executionDate(res.Msg.NextExecutionTime)),
+	)
+
+	return nil
+}
+
+// ListSavingsPlans lists the savings plans of a portfolio.
+func ListSavingsPlans(ctx context.Context, cmd *cli.Command) error {
+	s := mcli.FromContext(ctx)
+	res, err := s.PortfolioClient.ListSavingsPlans(
+		context.Background(),
+		connect.NewRequest(&portfoliov1.ListSavingsPlansRequest{
+			PortfolioId: cmd.String("portfolio-id"),
+		}),
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range res.Msg.SavingsPlans {
+		volume := fmt.Sprintf("%g shares", p.Amount)
+		if p.Value != nil {
+			volume = p.Value.Pretty()
+		}
+
+		fmt.Fprintf(cmd.Writer, "| %-*s | %-*s | %*s | every %2d month(s) | next %s |\n",
+			20, p.Id,
+			12, p.SecurityId,
+			12, volume,
+			p.IntervalMonths,
+			executionDate(p.NextExecutionTime),
+		)
+	}
+
+	return nil
+}
+
+// DeleteSavingsPlan deletes a savings plan.
+func DeleteSavingsPlan(ctx context.Context, cmd *cli.Command) error {
+	s := mcli.FromContext(ctx)
+	_, err := s.PortfolioClient.DeleteSavingsPlan(
+		context.Background(),
+		connect.NewRequest(&portfoliov1.DeleteSavingsPlanRequest{
+			Id: cmd.String("id"),
+		}),
+	)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(cmd.Writer, "Successfully deleted savings plan %s.\n", color.GreenString(cmd.String("id")))
+
+	return nil
+}
+
+// executionDate returns the date of an execution of a savings plan, which is
+// "never" if the plan has ended.
+func executionDate(t *timestamppb.Timestamp) string {
+	if t == nil {
+		return "never"
+	}
+
+	return t.AsTime().Format(time.DateOnly)
+}
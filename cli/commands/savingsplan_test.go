@@ -0,0 +1,136 @@
+// Copyright 2023 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package commands
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/oxisto/money-gopher/internal"
+	"github.com/oxisto/money-gopher/internal/testing/clitest"
+	"github.com/oxisto/money-gopher/internal/testing/servertest"
+	"github.com/oxisto/money-gopher/persistence"
+
+	"github.com/oxisto/assert"
+	"github.com/urfave/cli/v3"
+)
+
+// withSavingsPlan creates a monthly savings plan that invests 50 EUR.
+func withSavingsPlan(id string, portfolioID string, securityID string) func(db *persistence.DB) {
+	return func(db *persistence.DB) {
+		_, _ = persistence.New(db).CreateSavingsPlan(context.Background(), persistence.CreateSavingsPlanParams{
+			ID:             id,
+			PortfolioID:    portfolioID,
+			SecurityID:     securityID,
+			Value:          5000,
+			IntervalMonths: 1,
+			StartTime:      time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+		})
+	}
+}
+
+func TestCreateSavingsPlan(t *testing.T) {
+	srv := servertest.NewServer(internal.NewTestDB(t, withPortfolio("myportfolio", "My Portfolio")))
+	defer srv.Close()
+
+	type args struct {
+		ctx context.Context
+		cmd *cli.Command
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantRec assert.Want[*clitest.CommandRecorder]
+		wantErr bool
+	}{
+		{
+			name: "happy path",
+			args: args{
+				ctx: clitest.NewSessionContext(t, srv),
+				cmd: clitest.MockCommand(t, SavingsPlanCmd.Command("create").Flags,
+					"--id", "myplan",
+					"--portfolio-id", "myportfolio",
+					"--security-id", "US0378331005",
+					"--value", "50",
+					"--start", "2999-01-31",
+					"--fee-percentage", "1.5",
+				),
+			},
+			wantRec: func(t *testing.T, r *clitest.CommandRecorder) bool {
+				return assert.Equals(t, true, strings.HasSuffix(r.String(), "which is next executed on 2999-01-31.\n"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := clitest.Record(tt.args.cmd)
+			if err := CreateSavingsPlan(tt.args.ctx, tt.args.cmd); (err != nil) != tt.wantErr {
+				t.Errorf("CreateSavingsPlan() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantRec != nil {
+				tt.wantRec(t, rec)
+			}
+		})
+	}
+}
+
+func TestListSavingsPlans(t *testing.T) {
+	srv := servertest.NewServer(internal.NewTestDB(t,
+		withPortfolio("myportfolio", "My Portfolio"),
+		withSavingsPlan("myplan", "myportfolio", "US0378331005"),
+	))
+	defer srv.Close()
+
+	type args struct {
+		ctx context.Context
+		cmd *cli.Command
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantRec assert.Want[*clitest.CommandRecorder]
+		wantErr bool
+	}{
+		{
+			name: "happy path",
+			args: args{
+				ctx: clitest.NewSessionContext(t, srv),
+				cmd: clitest.MockCommand(t, SavingsPlanCmd.Command("list").Flags, "--portfolio-id", "myportfolio"),
+			},
+			wantRec: func(t *testing.T, r *clitest.CommandRecorder) bool {
+				return assert.Equals(t, true, strings.Contains(r.String(), "myplan")) &&
+					assert.Equals(t, true, strings.Contains(r.String(), "50 EUR")) &&
+					assert.Equals(t, true, strings.Contains(r.String(), "next 2024-01-15"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := clitest.Record(tt.args.cmd)
+			if err := ListSavingsPlans(tt.args.ctx, tt.args.cmd); (err != nil) != tt.wantErr {
+				t.Errorf("ListSavingsPlans() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantRec != nil {
+				tt.wantRec(t, rec)
+			}
+		})
+	}
+}
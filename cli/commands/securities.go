@@ -19,6 +19,7 @@ package commands
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	mcli "github.com/oxisto/money-gopher/cli"
@@ -26,6 +27,7 @@ import (
 
 	"connectrpc.com/connect"
 	"github.com/urfave/cli/v3"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
 // SecuritiesCmd is the command for security related commands.
@@ -38,6 +40,21 @@ var SecuritiesCmd = &cli.Command{
 			Name:   "list",
 			Usage:  "Lists all securities",
 			Action: ListSecurities,
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "tag", Usage: "Only lists securities with this tag"},
+			},
+		},
+		{
+			Name:   "update",
+			Usage:  "Updates the note or the tags of a security",
+			Action: UpdateSecurity,
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "security-id", Usage: "The ID of the security (its ISIN)", Required: true},
+				&cli.StringFlag{Name: "note", Usage: "Sets the note of the security"},
+				&cli.StringSliceFlag{Name: "tag", Usage: "Sets the tags of the security. Can be specified multiple times"},
+			},
+			EnableShellCompletion: true,
+			ShellComplete:         PredictSecurities,
 		},
 		{
 			Name:   "update-quote",
@@ -60,7 +77,11 @@ var SecuritiesCmd = &cli.Command{
 // ListSecurities lists all securities.
 func ListSecurities(ctx context.Context, cmd *cli.Command) error {
 	s := mcli.FromContext(ctx)
-	res, err := s.SecuritiesClient.ListSecurities(context.Background(), connect.NewRequest(&portfoliov1.ListSecuritiesRequest{}))
+	res, err := s.SecuritiesClient.ListSecurities(context.Background(), connect.NewRequest(&portfoliov1.ListSecuritiesRequest{
+		Filter: &portfoliov1.ListSecuritiesRequest_Filter{
+			Tag: cmd.String("tag"),
+		},
+	}))
 	if err != nil {
 		return err
 	}
@@ -68,6 +89,43 @@ func ListSecurities(ctx context.Context, cmd *cli.Command) error {
 	return nil
 }
 
+// UpdateSecurity updates the note or the tags of a security.
+func UpdateSecurity(ctx context.Context, cmd *cli.Command) error {
+	var (
+		s    = mcli.FromContext(ctx)
+		in   = &portfoliov1.Security{Id: cmd.String("security-id")}
+		mask = &fieldmaskpb.FieldMask{}
+	)
+
+	if cmd.IsSet("note") {
+		in.Note = cmd.String("note")
+		mask.Paths = append(mask.Paths, "note")
+	}
+
+	if cmd.IsSet("tag") {
+		in.Tags = cmd.StringSlice("tag")
+		mask.Paths = append(mask.Paths, "tags")
+	}
+
+	if len(mask.Paths) == 0 {
+		return errors.New("nothing to update")
+	}
+
+	res, err := s.SecuritiesClient.UpdateSecurity(
+		context.Background(),
+		connect.NewRequest(&portfoliov1.UpdateSecurityRequest{
+			Security:   in,
+			UpdateMask: mask,
+		}),
+	)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(cmd.Writer, res.Msg)
+	return nil
+}
+
 // UpdateQuote triggers an update of one or more securities' quotes.
 func UpdateQuote(ctx context.Context, cmd *cli.Command) error {
 	s := mcli.FromContext(ctx)
@@ -36,7 +36,7 @@ import (
 func TestUpdateQuote(t *testing.T) {
 	srv := servertest.NewServer(internal.NewTestDB(t, func(db *persistence.DB) {
 		ops := persistence.Ops[*portfoliov1.Security](db)
-		ops.Replace(&portfoliov1.Security{
+		ops.Replace(context.Background(), &portfoliov1.Security{
 			Id:            "mysecurity",
 			QuoteProvider: moneygopher.Ref("mock"),
 		})
@@ -75,7 +75,7 @@ func TestUpdateQuote(t *testing.T) {
 func TestUpdateAllQuotes(t *testing.T) {
 	srv := servertest.NewServer(internal.NewTestDB(t, func(db *persistence.DB) {
 		ops := persistence.Ops[*portfoliov1.Security](db)
-		ops.Replace(&portfoliov1.Security{
+		ops.Replace(context.Background(), &portfoliov1.Security{
 			Id:            "mysecurity",
 			QuoteProvider: moneygopher.Ref("mock"),
 		})
@@ -163,6 +163,57 @@ func TestListSecurities(t *testing.T) {
 	}
 }
 
+func TestUpdateSecurity(t *testing.T) {
+	srv := servertest.NewServer(internal.NewTestDB(t, func(db *persistence.DB) {
+		ops := persistence.Ops[*portfoliov1.Security](db)
+		assert.NoError(t, ops.Replace(context.Background(), &portfoliov1.Security{
+			Id:          "mysecurity",
+			DisplayName: "My Security",
+		}))
+	}))
+	defer srv.Close()
+
+	type args struct {
+		ctx context.Context
+		cmd *cli.Command
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+		wantRec assert.Want[*clitest.CommandRecorder]
+	}{
+		{
+			name: "happy path",
+			args: args{
+				ctx: clitest.NewSessionContext(t, srv),
+				cmd: clitest.MockCommand(t,
+					SecuritiesCmd.Command("update").Flags,
+					"--security-id", "mysecurity",
+					"--note", "Pays a dividend",
+					"--tag", "dividend",
+				),
+			},
+			wantRec: func(t *testing.T, rec *clitest.CommandRecorder) bool {
+				return assert.Equals(t, true, strings.Contains(rec.String(), "Pays a dividend"))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := clitest.Record(tt.args.cmd)
+			if err := UpdateSecurity(tt.args.ctx, tt.args.cmd); (err != nil) != tt.wantErr {
+				t.Errorf("UpdateSecurity() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantRec != nil {
+				tt.wantRec(t, rec)
+			}
+		})
+	}
+}
+
 func TestPredictSecurities(t *testing.T) {
 	srv := servertest.NewServer(internal.NewTestDB(t))
 	defer srv.Close()
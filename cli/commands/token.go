@@ -0,0 +1,118 @@
+// Copyright 2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	mcli "github.com/oxisto/money-gopher/cli"
+	portfoliov1 "github.com/oxisto/money-gopher/gen"
+
+	"connectrpc.com/connect"
+	"github.com/urfave/cli/v3"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// TokenCmd is the command for personal access token related commands.
+var TokenCmd = &cli.Command{
+	Name:   "token",
+	Usage:  "Manage personal access tokens, e.g., for scripts. Use a token by setting MGO_TOKEN",
+	Before: mcli.InjectSession,
+	Commands: []*cli.Command{
+		{
+			Name:   "create",
+			Usage:  "Creates a new personal access token",
+			Action: CreateAccessToken,
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "display-name", Usage: "The display name of the token, e.g. the name of the script", Required: true},
+				&cli.StringSliceFlag{Name: "scope", Usage: "The permissions of the token, e.g. portfolios:read", Value: []string{"portfolios:read", "securities:read"}},
+				&cli.DurationFlag{Name: "expires-in", Usage: "The duration after which the token expires. If it is not specified, the token does not expire"},
+			},
+		},
+		{
+			Name:   "list",
+			Usage:  "Lists all personal access tokens",
+			Action: ListAccessTokens,
+		},
+		{
+			Name:   "delete",
+			Usage:  "Revokes a personal access token",
+			Action: DeleteAccessToken,
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "id", Usage: "The identifier of the token", Required: true},
+			},
+		},
+	},
+}
+
+// CreateAccessToken creates a new personal access token.
+func CreateAccessToken(ctx context.Context, cmd *cli.Command) error {
+	s := mcli.FromContext(ctx)
+	at := &portfoliov1.AccessToken{
+		DisplayName: cmd.String("display-name"),
+		Scopes:      cmd.StringSlice("scope"),
+	}
+
+	if cmd.IsSet("expires-in") {
+		at.ExpireTime = timestamppb.New(time.Now().Add(cmd.Duration("expires-in")))
+	}
+
+	res, err := s.TokenClient.CreateAccessToken(
+		context.Background(),
+		connect.NewRequest(&portfoliov1.CreateAccessTokenRequest{
+			AccessToken: at,
+		}),
+	)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(cmd.Writer, res.Msg.AccessToken)
+	fmt.Fprintf(cmd.Writer, "Token: %s\nPlease store it now, it cannot be retrieved again.\n", res.Msg.Token)
+	return nil
+}
+
+// ListAccessTokens lists all personal access tokens of the current user.
+func ListAccessTokens(ctx context.Context, cmd *cli.Command) error {
+	s := mcli.FromContext(ctx)
+	res, err := s.TokenClient.ListAccessTokens(context.Background(), connect.NewRequest(&portfoliov1.ListAccessTokensRequest{}))
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(cmd.Writer, res.Msg.AccessTokens)
+	return nil
+}
+
+// DeleteAccessToken revokes a personal access token.
+func DeleteAccessToken(ctx context.Context, cmd *cli.Command) error {
+	s := mcli.FromContext(ctx)
+	_, err := s.TokenClient.DeleteAccessToken(
+		context.Background(),
+		connect.NewRequest(&portfoliov1.DeleteAccessTokenRequest{
+			Id: cmd.String("id"),
+		}),
+	)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(cmd.Writer, "Access token revoked.")
+	return nil
+}
@@ -0,0 +1,94 @@
+// Copyright 2023 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	mcli "github.com/oxisto/money-gopher/cli"
+	portfoliov1 "github.com/oxisto/money-gopher/gen"
+
+	"connectrpc.com/connect"
+	"github.com/urfave/cli/v3"
+)
+
+// TrashCmd is the command for trash related commands.
+var TrashCmd = &cli.Command{
+	Name:   "trash",
+	Usage:  "Lists and restores deleted portfolios, transactions and securities",
+	Before: mcli.InjectSession,
+	Commands: []*cli.Command{
+		{
+			Name:   "list",
+			Usage:  "Lists all items in the trash",
+			Action: ListTrash,
+		},
+		{
+			Name:   "restore",
+			Usage:  "Restores an item from the trash",
+			Action: RestoreFromTrash,
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "type", Usage: "The type of the item, i.e. Portfolio, PortfolioEvent or Security", Required: true},
+				&cli.StringFlag{Name: "id", Usage: "The unique ID of the item", Required: true},
+			},
+		},
+	},
+}
+
+// ListTrash lists all items in the trash, one per line.
+func ListTrash(ctx context.Context, cmd *cli.Command) error {
+	s := mcli.FromContext(ctx)
+	res, err := s.PortfolioClient.ListTrash(context.Background(), connect.NewRequest(&portfoliov1.ListTrashRequest{}))
+	if err != nil {
+		return err
+	}
+
+	for _, item := range res.Msg.Items {
+		fmt.Fprintf(cmd.Writer, "%s/%s %q deleted %s",
+			item.ResourceType,
+			item.Id,
+			item.DisplayName,
+			item.DeleteTime.AsTime().Local().Format(time.DateTime),
+		)
+
+		if item.PurgeTime != nil {
+			fmt.Fprintf(cmd.Writer, ", purged after %s", item.PurgeTime.AsTime().Local().Format(time.DateTime))
+		}
+
+		fmt.Fprintln(cmd.Writer)
+	}
+
+	return nil
+}
+
+// RestoreFromTrash restores an item from the trash.
+func RestoreFromTrash(ctx context.Context, cmd *cli.Command) error {
+	s := mcli.FromContext(ctx)
+	_, err := s.PortfolioClient.RestoreFromTrash(context.Background(), connect.NewRequest(&portfoliov1.RestoreFromTrashRequest{
+		ResourceType: cmd.String("type"),
+		Id:           cmd.String("id"),
+	}))
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(cmd.Writer, "Restored %s/%s.\n", cmd.String("type"), cmd.String("id"))
+
+	return nil
+}
@@ -0,0 +1,125 @@
+// Copyright 2023 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package commands
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	portfoliov1 "github.com/oxisto/money-gopher/gen"
+	"github.com/oxisto/money-gopher/internal"
+	"github.com/oxisto/money-gopher/internal/testing/clitest"
+	"github.com/oxisto/money-gopher/internal/testing/servertest"
+	"github.com/oxisto/money-gopher/persistence"
+
+	"github.com/oxisto/assert"
+	"github.com/urfave/cli/v3"
+)
+
+func withTrashedPortfolio(id string, displayName string) func(db *persistence.DB) {
+	return func(db *persistence.DB) {
+		withPortfolio(id, displayName)(db)
+		_ = persistence.Ops[*portfoliov1.Portfolio](db).Delete(context.Background(), id)
+	}
+}
+
+func TestListTrash(t *testing.T) {
+	srv := servertest.NewServer(internal.NewTestDB(t, withTrashedPortfolio("myportfolio", "My Portfolio")))
+	defer srv.Close()
+
+	type args struct {
+		ctx context.Context
+		cmd *cli.Command
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantRec assert.Want[*clitest.CommandRecorder]
+		wantErr bool
+	}{
+		{
+			name: "happy path",
+			args: args{
+				ctx: clitest.NewSessionContext(t, srv),
+				cmd: clitest.MockCommand(t, TrashCmd.Command("list").Flags),
+			},
+			wantRec: func(t *testing.T, r *clitest.CommandRecorder) bool {
+				return assert.Equals(t, true, strings.HasPrefix(r.String(), `Portfolio/myportfolio "My Portfolio" deleted `))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := clitest.Record(tt.args.cmd)
+			if err := ListTrash(tt.args.ctx, tt.args.cmd); (err != nil) != tt.wantErr {
+				t.Errorf("ListTrash() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantRec != nil {
+				tt.wantRec(t, rec)
+			}
+		})
+	}
+}
+
+func TestRestoreFromTrash(t *testing.T) {
+	srv := servertest.NewServer(internal.NewTestDB(t, withTrashedPortfolio("myportfolio", "My Portfolio")))
+	defer srv.Close()
+
+	type args struct {
+		ctx context.Context
+		cmd *cli.Command
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantRec assert.Want[*clitest.CommandRecorder]
+		wantErr bool
+	}{
+		{
+			name: "happy path",
+			args: args{
+				ctx: clitest.NewSessionContext(t, srv),
+				cmd: clitest.MockCommand(t, TrashCmd.Command("restore").Flags, "--type", "Portfolio", "--id", "myportfolio"),
+			},
+			wantRec: func(t *testing.T, r *clitest.CommandRecorder) bool {
+				return assert.Equals(t, "Restored Portfolio/myportfolio.\n", r.String())
+			},
+		},
+		{
+			name: "not in trash",
+			args: args{
+				ctx: clitest.NewSessionContext(t, srv),
+				cmd: clitest.MockCommand(t, TrashCmd.Command("restore").Flags, "--type", "Portfolio", "--id", "myportfolio"),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := clitest.Record(tt.args.cmd)
+			if err := RestoreFromTrash(tt.args.ctx, tt.args.cmd); (err != nil) != tt.wantErr {
+				t.Errorf("RestoreFromTrash() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantRec != nil {
+				tt.wantRec(t, rec)
+			}
+		})
+	}
+}
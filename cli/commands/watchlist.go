@@ -0,0 +1,238 @@
+// Copyright 2023 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	mcli "github.com/oxisto/money-gopher/cli"
+	portfoliov1 "github.com/oxisto/money-gopher/gen"
+
+	"connectrpc.com/connect"
+	"github.com/urfave/cli/v3"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// WatchlistCmd is the command for watchlist related commands.
+var WatchlistCmd = &cli.Command{
+	Name:   "watchlist",
+	Usage:  "Manage watchlists of securities that you follow without holding them",
+	Before: mcli.InjectSession,
+	Commands: []*cli.Command{
+		{
+			Name:   "create",
+			Usage:  "Creates a new watchlist",
+			Action: CreateWatchlist,
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "id", Usage: "The identifier of the watchlist, e.g. mywatchlist", Required: true},
+				&cli.StringFlag{Name: "display-name", Usage: "The display name of the watchlist", Required: true},
+				&cli.StringSliceFlag{Name: "security-id", Usage: "The ID of a security to watch. Can be specified multiple times"},
+			},
+		},
+		{
+			Name:   "list",
+			Usage:  "Lists all watchlists",
+			Action: ListWatchlists,
+		},
+		{
+			Name:   "show",
+			Usage:  "Shows the securities on a watchlist together with their latest quote and changes",
+			Action: ShowWatchlist,
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "id", Usage: "The identifier of the watchlist", Required: true},
+			},
+		},
+		{
+			Name:   "add",
+			Usage:  "Adds securities to a watchlist",
+			Action: AddToWatchlist,
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "id", Usage: "The identifier of the watchlist", Required: true},
+				&cli.StringSliceFlag{Name: "security-id", Usage: "The ID of a security to add. Can be specified multiple times", Required: true},
+			},
+		},
+		{
+			Name:   "remove",
+			Usage:  "Removes securities from a watchlist",
+			Action: RemoveFromWatchlist,
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "id", Usage: "The identifier of the watchlist", Required: true},
+				&cli.StringSliceFlag{Name: "security-id", Usage: "The ID of a security to remove. Can be specified multiple times", Required: true},
+			},
+		},
+		{
+			Name:   "delete",
+			Usage:  "Deletes a watchlist",
+			Action: DeleteWatchlist,
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "id", Usage: "The identifier of the watchlist", Required: true},
+			},
+		},
+	},
+}
+
+// CreateWatchlist creates a new watchlist.
+func CreateWatchlist(ctx context.Context, cmd *cli.Command) error {
+	s := mcli.FromContext(ctx)
+	res, err := s.PortfolioClient.CreateWatchlist(
+		context.Background(),
+		connect.NewRequest(&portfoliov1.CreateWatchlistRequest{
+			Watchlist: &portfoliov1.Watchlist{
+				Id:          cmd.String("id"),
+				DisplayName: cmd.String("display-name"),
+				SecurityIds: cmd.StringSlice("security-id"),
+			},
+		}),
+	)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprint(cmd.Writer, res.Msg)
+	return nil
+}
+
+// ListWatchlists lists all watchlists of the current user.
+func ListWatchlists(ctx context.Context, cmd *cli.Command) error {
+	s := mcli.FromContext(ctx)
+	res, err := s.PortfolioClient.ListWatchlists(
+		context.Background(),
+		connect.NewRequest(&portfoliov1.ListWatchlistsRequest{}),
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, w := range res.Msg.Watchlists {
+		fmt.Fprintf(cmd.Writer, "%s %q %d securities\n", w.Id, w.DisplayName, len(w.SecurityIds))
+	}
+
+	return nil
+}
+
+// ShowWatchlist shows the securities on a watchlist together with their
+// latest quote, the change since the previous day and since they were added.
+func ShowWatchlist(ctx context.Context, cmd *cli.Command) error {
+	s := mcli.FromContext(ctx)
+	res, err := s.PortfolioClient.GetWatchlist(
+		context.Background(),
+		connect.NewRequest(&portfoliov1.GetWatchlistRequest{
+			Id: cmd.String("id"),
+		}),
+	)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(cmd.Writer, "=== %s ===\n\n", res.Msg.DisplayName)
+
+	for _, e := range res.Msg.Entries {
+		fmt.Fprintf(cmd.Writer, "| %-30s | %15s | %15s %6s %% | %15s %6s %% |\n",
+			e.Security.DisplayName,
+			prettyOrNone(e.LatestQuote),
+			prettyOrNone(e.DailyChange),
+			greenOrRed(e.DailyGains*100),
+			prettyOrNone(e.ChangeSinceAdded),
+			greenOrRed(e.GainsSinceAdded*100),
+		)
+	}
+
+	return nil
+}
+
+// AddToWatchlist adds securities to a watchlist.
+func AddToWatchlist(ctx context.Context, cmd *cli.Command) error {
+	return changeWatchlist(ctx, cmd, func(ids []string) []string {
+		for _, id := range cmd.StringSlice("security-id") {
+			if !slices.Contains(ids, id) {
+				ids = append(ids, id)
+			}
+		}
+
+		return ids
+	})
+}
+
+// RemoveFromWatchlist removes securities from a watchlist.
+func RemoveFromWatchlist(ctx context.Context, cmd *cli.Command) error {
+	remove := cmd.StringSlice("security-id")
+	return changeWatchlist(ctx, cmd, func(ids []string) []string {
+		return slices.DeleteFunc(ids, func(id string) bool {
+			return slices.Contains(remove, id)
+		})
+	})
+}
+
+// changeWatchlist replaces the securities of a watchlist with the ones
+// returned by change.
+func changeWatchlist(ctx context.Context, cmd *cli.Command, change func(ids []string) []string) error {
+	s := mcli.FromContext(ctx)
+	w, err := s.PortfolioClient.GetWatchlist(
+		context.Background(),
+		connect.NewRequest(&portfoliov1.GetWatchlistRequest{
+			Id: cmd.String("id"),
+		}),
+	)
+	if err != nil {
+		return err
+	}
+
+	res, err := s.PortfolioClient.UpdateWatchlist(
+		context.Background(),
+		connect.NewRequest(&portfoliov1.UpdateWatchlistRequest{
+			Watchlist: &portfoliov1.Watchlist{
+				Id:          w.Msg.Id,
+				SecurityIds: change(w.Msg.SecurityIds),
+			},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"security_ids"}},
+		}),
+	)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(cmd.Writer, "Watchlist %s contains %d securities.\n", res.Msg.Id, len(res.Msg.SecurityIds))
+	return nil
+}
+
+// DeleteWatchlist deletes a watchlist.
+func DeleteWatchlist(ctx context.Context, cmd *cli.Command) error {
+	s := mcli.FromContext(ctx)
+	_, err := s.PortfolioClient.DeleteWatchlist(
+		context.Background(),
+		connect.NewRequest(&portfoliov1.DeleteWatchlistRequest{
+			Id: cmd.String("id"),
+		}),
+	)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(cmd.Writer, "Watchlist %s deleted.\n", cmd.String("id"))
+	return nil
+}
+
+// prettyOrNone returns the pretty-printed value of c or "-", if c is nil.
+func prettyOrNone(c *portfoliov1.Currency) string {
+	if c == nil {
+		return "-"
+	}
+
+	return c.Pretty()
+}
@@ -0,0 +1,130 @@
+// Copyright 2023 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package commands
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/oxisto/money-gopher/internal"
+	"github.com/oxisto/money-gopher/internal/testing/clitest"
+	"github.com/oxisto/money-gopher/internal/testing/servertest"
+	"github.com/oxisto/money-gopher/persistence"
+
+	"github.com/oxisto/assert"
+	"github.com/urfave/cli/v3"
+)
+
+// withWatchlist creates an empty watchlist that is owned by our test user.
+func withWatchlist(id string, displayName string) func(db *persistence.DB) {
+	return func(db *persistence.DB) {
+		_, _ = persistence.New(db).CreateWatchlist(context.Background(), persistence.CreateWatchlistParams{
+			ID:          id,
+			DisplayName: displayName,
+			Owner:       internal.TestUser,
+		})
+	}
+}
+
+func TestAddToWatchlist(t *testing.T) {
+	srv := servertest.NewServer(internal.NewTestDB(t, withWatchlist("mywatchlist", "My Watchlist")))
+	defer srv.Close()
+
+	type args struct {
+		ctx context.Context
+		cmd *cli.Command
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantRec assert.Want[*clitest.CommandRecorder]
+		wantErr bool
+	}{
+		{
+			name: "happy path",
+			args: args{
+				ctx: clitest.NewSessionContext(t, srv),
+				cmd: clitest.MockCommand(t, WatchlistCmd.Command("add").Flags, "--id", "mywatchlist", "--security-id", "US0378331005"),
+			},
+			wantRec: func(t *testing.T, r *clitest.CommandRecorder) bool {
+				return assert.Equals(t, "Watchlist mywatchlist contains 1 securities.\n", r.String())
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := clitest.Record(tt.args.cmd)
+			if err := AddToWatchlist(tt.args.ctx, tt.args.cmd); (err != nil) != tt.wantErr {
+				t.Errorf("AddToWatchlist() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantRec != nil {
+				tt.wantRec(t, rec)
+			}
+		})
+	}
+}
+
+func TestShowWatchlist(t *testing.T) {
+	db := internal.NewTestDB(t, withWatchlist("mywatchlist", "My Watchlist"))
+	srv := servertest.NewServer(db)
+	defer srv.Close()
+
+	err := persistence.New(db).AddWatchlistEntry(context.Background(), persistence.AddWatchlistEntryParams{
+		WatchlistID: "mywatchlist",
+		SecurityID:  "US0378331005",
+		AddTime:     time.Now(),
+	})
+	assert.NoError(t, err)
+
+	type args struct {
+		ctx context.Context
+		cmd *cli.Command
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantRec assert.Want[*clitest.CommandRecorder]
+		wantErr bool
+	}{
+		{
+			name: "happy path",
+			args: args{
+				ctx: clitest.NewSessionContext(t, srv),
+				cmd: clitest.MockCommand(t, WatchlistCmd.Command("show").Flags, "--id", "mywatchlist"),
+			},
+			wantRec: func(t *testing.T, r *clitest.CommandRecorder) bool {
+				return assert.Equals(t, true, strings.HasPrefix(r.String(), "=== My Watchlist ===\n\n| Apple Inc.")) &&
+					assert.Equals(t, 1, strings.Count(r.String(), "|\n"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := clitest.Record(tt.args.cmd)
+			if err := ShowWatchlist(tt.args.ctx, tt.args.cmd); (err != nil) != tt.wantErr {
+				t.Errorf("ShowWatchlist() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantRec != nil {
+				tt.wantRec(t, rec)
+			}
+		})
+	}
+}
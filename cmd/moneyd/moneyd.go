@@ -29,5 +29,6 @@ import (
 func main() {
 	if err := commands.ServerCmd.Run(context.Background(), os.Args); err != nil {
 		slog.Error("Error while running command", tint.Err(err))
+		os.Exit(1)
 	}
 }
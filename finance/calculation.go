@@ -19,6 +19,8 @@ package finance
 
 import (
 	"math"
+	"slices"
+	"time"
 
 	portfoliov1 "github.com/oxisto/money-gopher/gen"
 )
@@ -31,6 +33,16 @@ type fifoTx struct {
 	value  *portfoliov1.Currency // value contains the net value of this transaction, i.e., without taxes and fees
 	fees   *portfoliov1.Currency // fees contain any fees associated to this transaction
 	ppu    *portfoliov1.Currency // ppu is the price per unit (amount)
+	time   time.Time             // time is the (original) purchase time of the shares
+}
+
+// Lot is a number of shares that were bought together at the same price and
+// that are still held in a portfolio.
+type Lot struct {
+	Amount float64
+	Price  *portfoliov1.Currency
+	Fees   *portfoliov1.Currency
+	Time   time.Time
 }
 
 type calculation struct {
@@ -57,40 +69,46 @@ func NewCalculation(txs []*portfoliov1.PortfolioEvent) *calculation {
 }
 
 func (c *calculation) Apply(tx *portfoliov1.PortfolioEvent) {
+	// Settle the transaction
+	c.Cash.PlusAssign(CashFlow(tx))
+
 	switch tx.Type {
-	case portfoliov1.PortfolioEventType_PORTFOLIO_EVENT_TYPE_DELIVERY_INBOUND:
+	case portfoliov1.PortfolioEventType_PORTFOLIO_EVENT_TYPE_DELIVERY_INBOUND,
+		portfoliov1.PortfolioEventType_PORTFOLIO_EVENT_TYPE_TRANSFER_INBOUND:
 		fallthrough
 	case portfoliov1.PortfolioEventType_PORTFOLIO_EVENT_TYPE_BUY:
-		var (
-			total *portfoliov1.Currency
-		)
-
 		// Increase the amount of shares and the fees by the value stored in the
 		// transaction
 		c.Fees.PlusAssign(tx.Fees)
 		c.Amount += tx.Amount
 
-		total = portfoliov1.Times(tx.Price, tx.Amount).Plus(tx.Fees).Plus(tx.Taxes)
-
-		// Decrease our cash
-		c.Cash.MinusAssign(total)
-
 		// Add the transaction to the FIFO list. We need to have a list because
 		// sold shares are sold according to the FIFO principle. We therefore
 		// need to store this information to reduce the amount in the items
 		// later when a sell transaction occurs.
-		c.fifo = append(c.fifo, &fifoTx{
+		item := &fifoTx{
 			amount: tx.Amount,
 			ppu:    tx.Price,
 			value:  portfoliov1.Times(tx.Price, tx.Amount),
 			fees:   tx.Fees,
+			time:   purchaseTime(tx),
+		}
+
+		// Transferred shares keep their original purchase time, so they need to
+		// be sorted into the list rather than appended to it.
+		i, _ := slices.BinarySearchFunc(c.fifo, item, func(a, b *fifoTx) int {
+			if a.time.After(b.time) {
+				return 1
+			}
+			return -1
 		})
-	case portfoliov1.PortfolioEventType_PORTFOLIO_EVENT_TYPE_DELIVERY_OUTBOUND:
+		c.fifo = slices.Insert(c.fifo, i, item)
+	case portfoliov1.PortfolioEventType_PORTFOLIO_EVENT_TYPE_DELIVERY_OUTBOUND,
+		portfoliov1.PortfolioEventType_PORTFOLIO_EVENT_TYPE_TRANSFER_OUTBOUND:
 		fallthrough
 	case portfoliov1.PortfolioEventType_PORTFOLIO_EVENT_TYPE_SELL:
 		var (
-			sold  float64
-			total *portfoliov1.Currency
+			sold float64
 		)
 
 		// Increase the fees and taxes by the value stored in the
@@ -98,11 +116,6 @@ func (c *calculation) Apply(tx *portfoliov1.PortfolioEvent) {
 		c.Fees.PlusAssign(tx.Fees)
 		c.Taxes.PlusAssign(tx.Taxes)
 
-		total = portfoliov1.Times(tx.Price, tx.Amount).Plus(tx.Fees).Plus(tx.Taxes)
-
-		// Increase our cash
-		c.Cash.PlusAssign(total)
-
 		// Store the amount of shares sold in this variable, since we later need
 		// to decrease it while looping through the FIFO list
 		sold = tx.Amount
@@ -146,15 +159,95 @@ func (c *calculation) Apply(tx *portfoliov1.PortfolioEvent) {
 
 			sold -= n
 		}
-	case portfoliov1.PortfolioEventType_PORTFOLIO_EVENT_TYPE_DEPOSIT_CASH:
-		// Add to the cash
-		c.Cash.PlusAssign(tx.Price)
-	case portfoliov1.PortfolioEventType_PORTFOLIO_EVENT_TYPE_WITHDRAW_CASH:
-		// Remove from the cash
-		c.Cash.MinusAssign(tx.Price)
 	}
 }
 
+// CashFlow returns the amount of cash that tx adds to (positive) or removes
+// from (negative) the bank account it settles against. Deliveries of
+// securities do not involve any cash.
+func CashFlow(tx *portfoliov1.PortfolioEvent) (f *portfoliov1.Currency) {
+	// Fees and taxes are optional, so we start with zero and add them up
+	costs := portfoliov1.Zero().Plus(tx.Fees).Plus(tx.Taxes)
+
+	switch tx.Type {
+	case portfoliov1.PortfolioEventType_PORTFOLIO_EVENT_TYPE_BUY:
+		// We pay the price as well as fees and taxes
+		return portfoliov1.Minus(portfoliov1.Times(tx.Price, -tx.Amount), costs)
+	case portfoliov1.PortfolioEventType_PORTFOLIO_EVENT_TYPE_SELL:
+		// We receive the price, but still pay fees and taxes
+		return portfoliov1.Minus(portfoliov1.Times(tx.Price, tx.Amount), costs)
+	case portfoliov1.PortfolioEventType_PORTFOLIO_EVENT_TYPE_DIVIDEND,
+		portfoliov1.PortfolioEventType_PORTFOLIO_EVENT_TYPE_INTEREST:
+		return portfoliov1.Minus(portfoliov1.Zero().Plus(tx.Price), costs)
+	case portfoliov1.PortfolioEventType_PORTFOLIO_EVENT_TYPE_DEPOSIT_CASH,
+		portfoliov1.PortfolioEventType_PORTFOLIO_EVENT_TYPE_TAX_REFUND:
+		return portfoliov1.Zero().Plus(tx.Price)
+	case portfoliov1.PortfolioEventType_PORTFOLIO_EVENT_TYPE_WITHDRAW_CASH,
+		portfoliov1.PortfolioEventType_PORTFOLIO_EVENT_TYPE_ACCOUNT_FEES:
+		return portfoliov1.Minus(portfoliov1.Zero(), portfoliov1.Zero().Plus(tx.Price))
+	case portfoliov1.PortfolioEventType_PORTFOLIO_EVENT_TYPE_TRANSFER_CASH_IN:
+		return portfoliov1.Zero().Plus(tx.Price)
+	case portfoliov1.PortfolioEventType_PORTFOLIO_EVENT_TYPE_TRANSFER_CASH_OUT:
+		return portfoliov1.Minus(portfoliov1.Zero(), portfoliov1.Zero().Plus(tx.Price).Plus(tx.Fees))
+	case portfoliov1.PortfolioEventType_PORTFOLIO_EVENT_TYPE_TRANSFER_OUTBOUND:
+		// Only the fees of the transfer are paid by the source portfolio
+		return portfoliov1.Minus(portfoliov1.Zero(), portfoliov1.Zero().Plus(tx.Fees))
+	}
+
+	return portfoliov1.Zero()
+}
+
+// purchaseTime returns the time the shares of tx were originally bought. This
+// differs from the time of the event for transferred shares.
+func purchaseTime(tx *portfoliov1.PortfolioEvent) time.Time {
+	if tx.PurchaseTime != nil {
+		return tx.PurchaseTime.AsTime()
+	}
+
+	return tx.Time.AsTime()
+}
+
+// Lots returns the lots that are still held, ordered by their purchase time.
+func (c *calculation) Lots() (lots []*Lot) {
+	for _, item := range c.fifo {
+		if item.amount <= 0 {
+			continue
+		}
+
+		lots = append(lots, &Lot{
+			Amount: item.amount,
+			Price:  item.ppu,
+			Fees:   item.fees,
+			Time:   item.time,
+		})
+	}
+
+	return
+}
+
+// TakeLots takes amount shares out of lots according to the FIFO principle.
+// Fees of partially taken lots are split proportionally. If lots do not
+// contain enough shares, all of them are returned.
+func TakeLots(lots []*Lot, amount float64) (taken []*Lot) {
+	for _, lot := range lots {
+		if amount <= 0 {
+			break
+		}
+
+		n := math.Min(amount, lot.Amount)
+		taken = append(taken, &Lot{
+			Amount: n,
+			Price:  lot.Price,
+			Fees:   portfoliov1.Times(portfoliov1.Zero().Plus(lot.Fees), n/lot.Amount),
+			Time:   lot.Time,
+		})
+
+		amount -= n
+	}
+
+	return
+}
+
 func (c *calculation) NetValue() (f *portfoliov1.Currency) {
 	f = portfoliov1.Zero()
 
@@ -19,10 +19,13 @@ package finance
 
 import (
 	"testing"
+	"time"
 
 	portfoliov1 "github.com/oxisto/money-gopher/gen"
 
 	"github.com/oxisto/assert"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestNewCalculation(t *testing.T) {
@@ -94,7 +97,45 @@ func TestNewCalculation(t *testing.T) {
 					assert.Equals(t, 494614, int(c.GrossValue().Value)) &&
 					assert.Equals(t, 19657, int(c.NetPrice().Value)) &&
 					assert.Equals(t, 19785, int(c.GrossPrice().Value)) &&
-					assert.Equals(t, 44099, int(c.Cash.Value))
+					assert.Equals(t, 37861, int(c.Cash.Value))
+			},
+		},
+		{
+			name: "transferred lots keep their purchase time",
+			args: args{
+				txs: []*portfoliov1.PortfolioEvent{
+					{
+						Type:   portfoliov1.PortfolioEventType_PORTFOLIO_EVENT_TYPE_BUY,
+						Time:   timestamppb.New(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)),
+						Amount: 5,
+						Price:  portfoliov1.Value(20000),
+						Fees:   portfoliov1.Value(500),
+					},
+					{
+						Type:         portfoliov1.PortfolioEventType_PORTFOLIO_EVENT_TYPE_TRANSFER_INBOUND,
+						Time:         timestamppb.New(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)),
+						PurchaseTime: timestamppb.New(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)),
+						Amount:       2,
+						Price:        portfoliov1.Value(10000),
+						Fees:         portfoliov1.Value(200),
+					},
+					{
+						Type:   portfoliov1.PortfolioEventType_PORTFOLIO_EVENT_TYPE_TRANSFER_OUTBOUND,
+						Time:   timestamppb.New(time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC)),
+						Amount: 3,
+						Fees:   portfoliov1.Value(100),
+					},
+				},
+			},
+			want: func(t *testing.T, c *calculation) bool {
+				lots := c.Lots()
+				return true &&
+					assert.Equals(t, 4, c.Amount) &&
+					assert.Equals(t, 80000, int(c.NetValue().Value)) &&
+					assert.Equals(t, -100600, int(c.Cash.Value)) &&
+					assert.Equals(t, 1, len(lots)) &&
+					assert.Equals(t, 4, lots[0].Amount) &&
+					assert.Equals(t, time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), lots[0].Time)
 			},
 		},
 	}
@@ -105,3 +146,41 @@ func TestNewCalculation(t *testing.T) {
 		})
 	}
 }
+
+func TestTakeLots(t *testing.T) {
+	lots := []*Lot{
+		{Amount: 2, Price: portfoliov1.Value(10000), Fees: portfoliov1.Value(200)},
+		{Amount: 4, Price: portfoliov1.Value(20000), Fees: portfoliov1.Value(400)},
+	}
+
+	type args struct {
+		amount float64
+	}
+	tests := []struct {
+		name string
+		args args
+		want []*Lot
+	}{
+		{
+			name: "partial lot",
+			args: args{amount: 3},
+			want: []*Lot{
+				{Amount: 2, Price: portfoliov1.Value(10000), Fees: portfoliov1.Value(200)},
+				{Amount: 1, Price: portfoliov1.Value(20000), Fees: portfoliov1.Value(100)},
+			},
+		},
+		{
+			name: "more than available",
+			args: args{amount: 10},
+			want: []*Lot{
+				{Amount: 2, Price: portfoliov1.Value(10000), Fees: portfoliov1.Value(200)},
+				{Amount: 4, Price: portfoliov1.Value(20000), Fees: portfoliov1.Value(400)},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equals(t, tt.want, TakeLots(lots, tt.args.amount), protocmp.Transform())
+		})
+	}
+}
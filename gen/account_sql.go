@@ -24,29 +24,35 @@ import (
 	"github.com/oxisto/money-gopher/persistence"
 )
 
-func (*BankAccount) InitTables(db *persistence.DB) (err error) {
+func (*BankAccount) InitTables(db persistence.Preparer) (err error) {
 	_, err1 := db.Exec(`CREATE TABLE IF NOT EXISTS bank_accounts (
 id TEXT PRIMARY KEY,
-display_name TEXT NOT NULL
+display_name TEXT NOT NULL,
+owner TEXT NOT NULL DEFAULT '',
+currency TEXT NOT NULL DEFAULT 'EUR',
+iban TEXT NOT NULL DEFAULT '',
+institution TEXT NOT NULL DEFAULT ''
 );`)
 	err2 := (&PortfolioEvent{}).InitTables(db)
 
 	return errors.Join(err1, err2)
 }
 
-func (*BankAccount) PrepareReplace(db *persistence.DB) (stmt *sql.Stmt, err error) {
-	return db.Prepare(`REPLACE INTO bank_accounts (id, display_name) VALUES (?,?);`)
+func (*BankAccount) PrepareReplace(db persistence.Preparer) (stmt *sql.Stmt, err error) {
+	return db.Prepare(`REPLACE INTO bank_accounts (id, display_name, owner, currency, iban, institution) VALUES (?,?,?,?,?,?);`)
 }
 
-func (*BankAccount) PrepareList(db *persistence.DB) (stmt *sql.Stmt, err error) {
-	return db.Prepare(`SELECT id, display_name FROM bank_accounts`)
+// PrepareList prepares a query that lists all bank accounts of a user. It
+// expects the subject of the user as argument.
+func (*BankAccount) PrepareList(db persistence.Preparer) (stmt *sql.Stmt, err error) {
+	return db.Prepare(`SELECT id, display_name, owner, currency, iban, institution FROM bank_accounts WHERE ?1 IS NULL OR owner = ?1 ORDER BY id`)
 }
 
-func (*BankAccount) PrepareGet(db *persistence.DB) (stmt *sql.Stmt, err error) {
-	return db.Prepare(`SELECT id, display_name FROM bank_accounts WHERE id = ?`)
+func (*BankAccount) PrepareGet(db persistence.Preparer) (stmt *sql.Stmt, err error) {
+	return db.Prepare(`SELECT id, display_name, owner, currency, iban, institution FROM bank_accounts WHERE id = ?`)
 }
 
-func (*BankAccount) PrepareUpdate(db *persistence.DB, columns []string) (stmt *sql.Stmt, err error) {
+func (*BankAccount) PrepareUpdate(db persistence.Preparer, columns []string) (stmt *sql.Stmt, err error) {
 	// We need to make sure to quote columns here because they are potentially evil user input
 	var (
 		query string
@@ -63,12 +69,12 @@ func (*BankAccount) PrepareUpdate(db *persistence.DB, columns []string) (stmt *s
 	return db.Prepare(query)
 }
 
-func (*BankAccount) PrepareDelete(db *persistence.DB) (stmt *sql.Stmt, err error) {
+func (*BankAccount) PrepareDelete(db persistence.Preparer) (stmt *sql.Stmt, err error) {
 	return db.Prepare(`DELETE FROM bank_accounts WHERE id = ?`)
 }
 
 func (p *BankAccount) ReplaceIntoArgs() []any {
-	return []any{p.Id, p.DisplayName}
+	return []any{p.Id, p.DisplayName, p.Owner, p.Currency, p.Iban, p.Institution}
 }
 
 func (p *BankAccount) UpdateArgs(columns []string) (args []any) {
@@ -78,6 +84,12 @@ func (p *BankAccount) UpdateArgs(columns []string) (args []any) {
 			args = append(args, p.Id)
 		case "display_name":
 			args = append(args, p.DisplayName)
+		case "currency":
+			args = append(args, p.Currency)
+		case "iban":
+			args = append(args, p.Iban)
+		case "institution":
+			args = append(args, p.Institution)
 		}
 	}
 
@@ -89,7 +101,7 @@ func (*BankAccount) Scan(sc persistence.Scanner) (obj persistence.StorageObject,
 		acc BankAccount
 	)
 
-	err = sc.Scan(&acc.Id, &acc.DisplayName)
+	err = sc.Scan(&acc.Id, &acc.DisplayName, &acc.Owner, &acc.Currency, &acc.Iban, &acc.Institution)
 	if err != nil {
 		return nil, err
 	}
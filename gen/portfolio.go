@@ -23,6 +23,10 @@ import (
 	"time"
 )
 
+// EventMap returns the events of the portfolio that concern a security,
+// indexed by the security ID. Events that only concern cash, such as deposits,
+// are not contained; their effect on the bank account is determined by
+// finance.CashFlow like the one of all other events.
 func (p *Portfolio) EventMap() (m map[string][]*PortfolioEvent) {
 	m = make(map[string][]*PortfolioEvent)
 
@@ -30,9 +34,6 @@ func (p *Portfolio) EventMap() (m map[string][]*PortfolioEvent) {
 		name := tx.GetSecurityId()
 		if name != "" {
 			m[name] = append(m[name], tx)
-		} else {
-			// a little bit of a hack
-			m["cash"] = append(m["cash"], tx)
 		}
 	}
 
@@ -53,6 +54,23 @@ func EventsBefore(txs []*PortfolioEvent, t time.Time) (out []*PortfolioEvent) {
 	return
 }
 
+// ExecutedEvents returns the events of txs that are not pending. Pending
+// executions of savings plans are only expected, so they neither hold shares
+// nor settle against a bank account until they are confirmed.
+func ExecutedEvents(txs []*PortfolioEvent) (out []*PortfolioEvent) {
+	out = make([]*PortfolioEvent, 0, len(txs))
+
+	for _, tx := range txs {
+		if tx.GetPending() {
+			continue
+		}
+
+		out = append(out, tx)
+	}
+
+	return
+}
+
 func (tx *PortfolioEvent) MakeUniqueID() {
 	// Create a unique ID based on a hash containing:
 	//  - security ID
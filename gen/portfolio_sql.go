@@ -18,6 +18,7 @@ package portfoliov1
 
 import (
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"strings"
 	"time"
@@ -27,31 +28,59 @@ import (
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 )
 
-var _ persistence.StorageObject = &Portfolio{}
+var (
+	_ persistence.QueryableObject = &Portfolio{}
+	_ persistence.QueryableObject = &PortfolioEvent{}
+)
+
+// AllOwners can be supplied to the list operation of portfolios, bank accounts
+// and portfolio shares instead of a particular owner or portfolio to list all
+// entries. This should only be used for administrative tasks.
+var AllOwners any = nil
 
-func (*Portfolio) InitTables(db *persistence.DB) (err error) {
+func (*Portfolio) InitTables(db persistence.Preparer) (err error) {
 	_, err1 := db.Exec(`CREATE TABLE IF NOT EXISTS portfolios (
 id TEXT PRIMARY KEY,
-display_name TEXT NOT NULL
+display_name TEXT NOT NULL,
+owner TEXT NOT NULL DEFAULT '',
+delete_time DATETIME,
+bank_account_id TEXT NOT NULL DEFAULT '',
+note TEXT NOT NULL DEFAULT '',
+tags TEXT NOT NULL DEFAULT '[]'
 );`)
 	err2 := (&PortfolioEvent{}).InitTables(db)
+	err3 := (&PortfolioShare{}).InitTables(db)
 
-	return errors.Join(err1, err2)
+	return errors.Join(err1, err2, err3)
 }
 
-func (*Portfolio) PrepareReplace(db *persistence.DB) (stmt *sql.Stmt, err error) {
-	return db.Prepare(`REPLACE INTO portfolios (id, display_name) VALUES (?,?);`)
+func (*Portfolio) PrepareReplace(db persistence.Preparer) (stmt *sql.Stmt, err error) {
+	return db.Prepare(`REPLACE INTO portfolios (id, display_name, owner, bank_account_id, note, tags) VALUES (?,?,?,?,?,?);`)
 }
 
-func (*Portfolio) PrepareList(db *persistence.DB) (stmt *sql.Stmt, err error) {
-	return db.Prepare(`SELECT id, display_name FROM portfolios`)
+// PrepareList prepares a query that lists all portfolios that a user owns or
+// that are shared with them. It expects the subject of the user as argument.
+func (p *Portfolio) PrepareList(db persistence.Preparer) (stmt *sql.Stmt, err error) {
+	return db.Prepare(p.ListQuery().Query)
+}
+
+// ListQuery returns the query of [Portfolio.PrepareList], which can be
+// filtered by tag and sorted by its display name.
+func (*Portfolio) ListQuery() persistence.ListQuery {
+	return persistence.ListQuery{
+		Query: `SELECT id, display_name, owner, bank_account_id, note, tags FROM portfolios
+WHERE delete_time IS NULL AND
+(?1 IS NULL OR owner = ?1 OR id IN (SELECT portfolio_id FROM portfolio_shares WHERE subject = ?1))`,
+		Columns: []string{"id", "display_name", "tags"},
+		Key:     []string{"id"},
+	}
 }
 
-func (*Portfolio) PrepareGet(db *persistence.DB) (stmt *sql.Stmt, err error) {
-	return db.Prepare(`SELECT id, display_name FROM portfolios WHERE id = ?`)
+func (*Portfolio) PrepareGet(db persistence.Preparer) (stmt *sql.Stmt, err error) {
+	return db.Prepare(`SELECT id, display_name, owner, bank_account_id, note, tags FROM portfolios WHERE id = ? AND delete_time IS NULL`)
 }
 
-func (*Portfolio) PrepareUpdate(db *persistence.DB, columns []string) (stmt *sql.Stmt, err error) {
+func (*Portfolio) PrepareUpdate(db persistence.Preparer, columns []string) (stmt *sql.Stmt, err error) {
 	// We need to make sure to quote columns here because they are potentially evil user input
 	var (
 		query string
@@ -68,12 +97,14 @@ func (*Portfolio) PrepareUpdate(db *persistence.DB, columns []string) (stmt *sql
 	return db.Prepare(query)
 }
 
-func (*Portfolio) PrepareDelete(db *persistence.DB) (stmt *sql.Stmt, err error) {
-	return db.Prepare(`DELETE FROM portfolios WHERE id = ?`)
+// PrepareDelete prepares a query that moves a portfolio to the trash. A
+// trigger also moves all of its events there.
+func (*Portfolio) PrepareDelete(db persistence.Preparer) (stmt *sql.Stmt, err error) {
+	return db.Prepare(`UPDATE portfolios SET delete_time = CURRENT_TIMESTAMP WHERE id = ? AND delete_time IS NULL`)
 }
 
 func (p *Portfolio) ReplaceIntoArgs() []any {
-	return []any{p.Id, p.DisplayName}
+	return []any{p.Id, p.DisplayName, p.Owner, p.BankAccountId, p.Note, marshalTags(p.Tags)}
 }
 
 func (p *Portfolio) UpdateArgs(columns []string) (args []any) {
@@ -83,6 +114,12 @@ func (p *Portfolio) UpdateArgs(columns []string) (args []any) {
 			args = append(args, p.Id)
 		case "display_name":
 			args = append(args, p.DisplayName)
+		case "bank_account_id":
+			args = append(args, p.BankAccountId)
+		case "note":
+			args = append(args, p.Note)
+		case "tags":
+			args = append(args, marshalTags(p.Tags))
 		}
 	}
 
@@ -91,10 +128,16 @@ func (p *Portfolio) UpdateArgs(columns []string) (args []any) {
 
 func (*Portfolio) Scan(sc persistence.Scanner) (obj persistence.StorageObject, err error) {
 	var (
-		p Portfolio
+		p    Portfolio
+		tags string
 	)
 
-	err = sc.Scan(&p.Id, &p.DisplayName)
+	err = sc.Scan(&p.Id, &p.DisplayName, &p.Owner, &p.BankAccountId, &p.Note, &tags)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Tags, err = unmarshalTags(tags)
 	if err != nil {
 		return nil, err
 	}
@@ -102,7 +145,7 @@ func (*Portfolio) Scan(sc persistence.Scanner) (obj persistence.StorageObject, e
 	return &p, nil
 }
 
-func (*PortfolioEvent) InitTables(db *persistence.DB) (err error) {
+func (*PortfolioEvent) InitTables(db persistence.Preparer) (err error) {
 	_, err = db.Exec(`CREATE TABLE IF NOT EXISTS portfolio_events (
 id TEXT PRIMARY KEY,
 type INTEGER NOT NULL,
@@ -112,7 +155,15 @@ security_id TEXT NOT NULL,
 amount REAL,
 price INTEGER,
 fees INTEGER,
-taxes INTEGER
+taxes INTEGER,
+delete_time DATETIME,
+deleted_with_portfolio BOOLEAN NOT NULL DEFAULT FALSE,
+transfer_id TEXT NOT NULL DEFAULT '',
+purchase_time DATETIME,
+note TEXT NOT NULL DEFAULT '',
+tags TEXT NOT NULL DEFAULT '[]',
+savings_plan_id TEXT NOT NULL DEFAULT '',
+pending BOOLEAN NOT NULL DEFAULT FALSE
 );`)
 	if err != nil {
 		return err
@@ -121,22 +172,33 @@ taxes INTEGER
 	return
 }
 
-func (*PortfolioEvent) PrepareReplace(db *persistence.DB) (stmt *sql.Stmt, err error) {
+func (*PortfolioEvent) PrepareReplace(db persistence.Preparer) (stmt *sql.Stmt, err error) {
 	return db.Prepare(`REPLACE INTO portfolio_events
-(id, type, time, portfolio_id, security_id, amount, price, fees, taxes)
-VALUES (?,?,?,?,?,?,?,?,?);`)
+(id, type, time, portfolio_id, security_id, amount, price, fees, taxes, transfer_id, purchase_time, note, tags, savings_plan_id, pending)
+VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?,?,?);`)
+}
+
+func (e *PortfolioEvent) PrepareList(db persistence.Preparer) (stmt *sql.Stmt, err error) {
+	return db.Prepare(e.ListQuery().Query + ` ORDER BY time ASC`)
 }
 
-func (*PortfolioEvent) PrepareList(db *persistence.DB) (stmt *sql.Stmt, err error) {
-	return db.Prepare(`SELECT id, type, time, portfolio_id, security_id, amount, price, fees, taxes
-FROM portfolio_events WHERE portfolio_id = ? ORDER BY time ASC`)
+// ListQuery returns the query of [PortfolioEvent.PrepareList], which can be
+// filtered by time, type, security and tag.
+func (*PortfolioEvent) ListQuery() persistence.ListQuery {
+	return persistence.ListQuery{
+		Query: `SELECT id, type, time, portfolio_id, security_id, amount, price, fees, taxes, transfer_id, purchase_time, note, tags, savings_plan_id, pending
+FROM portfolio_events WHERE portfolio_id = ? AND delete_time IS NULL`,
+		Columns: []string{"time", "type", "security_id", "tags"},
+		Key:     []string{"id"},
+	}
 }
 
-func (*PortfolioEvent) PrepareGet(db *persistence.DB) (stmt *sql.Stmt, err error) {
-	return db.Prepare(`SELECT * FROM portfolio_events WHERE id = ?`)
+func (*PortfolioEvent) PrepareGet(db persistence.Preparer) (stmt *sql.Stmt, err error) {
+	return db.Prepare(`SELECT id, type, time, portfolio_id, security_id, amount, price, fees, taxes, transfer_id, purchase_time, note, tags, savings_plan_id, pending
+FROM portfolio_events WHERE id = ? AND delete_time IS NULL`)
 }
 
-func (*PortfolioEvent) PrepareUpdate(db *persistence.DB, columns []string) (stmt *sql.Stmt, err error) {
+func (*PortfolioEvent) PrepareUpdate(db persistence.Preparer, columns []string) (stmt *sql.Stmt, err error) {
 	// We need to make sure to quote columns here because they are potentially evil user input
 	var (
 		query string
@@ -153,8 +215,9 @@ func (*PortfolioEvent) PrepareUpdate(db *persistence.DB, columns []string) (stmt
 	return db.Prepare(query)
 }
 
-func (*PortfolioEvent) PrepareDelete(db *persistence.DB) (stmt *sql.Stmt, err error) {
-	return db.Prepare(`DELETE FROM portfolio_events WHERE id = ?`)
+// PrepareDelete prepares a query that moves a portfolio event to the trash.
+func (*PortfolioEvent) PrepareDelete(db persistence.Preparer) (stmt *sql.Stmt, err error) {
+	return db.Prepare(`UPDATE portfolio_events SET delete_time = CURRENT_TIMESTAMP WHERE id = ? AND delete_time IS NULL`)
 }
 
 func (e *PortfolioEvent) ReplaceIntoArgs() []any {
@@ -168,7 +231,48 @@ func (e *PortfolioEvent) ReplaceIntoArgs() []any {
 		e.Price.GetValue(),
 		e.Fees.GetValue(),
 		e.Taxes.GetValue(),
+		e.TransferId,
+		purchaseTime(e),
+		e.Note,
+		marshalTags(e.Tags),
+		e.SavingsPlanId,
+		e.Pending,
+	}
+}
+
+// purchaseTime returns the purchase time of an event for the database, which
+// is NULL if it is not set.
+func purchaseTime(e *PortfolioEvent) sql.NullTime {
+	if e.PurchaseTime == nil {
+		return sql.NullTime{}
+	}
+
+	return sql.NullTime{Time: e.PurchaseTime.AsTime(), Valid: true}
+}
+
+// marshalTags returns tags for the database, which stores them as a JSON
+// array, so that they can be filtered with json_each.
+func marshalTags(tags []string) string {
+	if len(tags) == 0 {
+		return "[]"
+	}
+
+	b, _ := json.Marshal(tags)
+	return string(b)
+}
+
+// unmarshalTags parses the tags that [marshalTags] stored in the database.
+func unmarshalTags(s string) (tags []string, err error) {
+	err = json.Unmarshal([]byte(s), &tags)
+	if err != nil {
+		return nil, err
 	}
+
+	if len(tags) == 0 {
+		return nil, nil
+	}
+
+	return tags, nil
 }
 
 func (e *PortfolioEvent) UpdateArgs(columns []string) (args []any) {
@@ -192,6 +296,14 @@ func (e *PortfolioEvent) UpdateArgs(columns []string) (args []any) {
 			args = append(args, e.Fees.GetValue())
 		case "taxes":
 			args = append(args, e.Taxes.GetValue())
+		case "purchase_time":
+			args = append(args, purchaseTime(e))
+		case "note":
+			args = append(args, e.Note)
+		case "tags":
+			args = append(args, marshalTags(e.Tags))
+		case "pending":
+			args = append(args, e.Pending)
 		}
 	}
 
@@ -200,8 +312,10 @@ func (e *PortfolioEvent) UpdateArgs(columns []string) (args []any) {
 
 func (*PortfolioEvent) Scan(sc persistence.Scanner) (obj persistence.StorageObject, err error) {
 	var (
-		e PortfolioEvent
-		t time.Time
+		e    PortfolioEvent
+		t    time.Time
+		pt   sql.NullTime
+		tags string
 	)
 
 	e.Price = Zero()
@@ -218,12 +332,101 @@ func (*PortfolioEvent) Scan(sc persistence.Scanner) (obj persistence.StorageObje
 		&e.Price.Value,
 		&e.Fees.Value,
 		&e.Taxes.Value,
+		&e.TransferId,
+		&pt,
+		&e.Note,
+		&tags,
+		&e.SavingsPlanId,
+		&e.Pending,
 	)
 	if err != nil {
 		return nil, err
 	}
 
+	e.Tags, err = unmarshalTags(tags)
+	if err != nil {
+		return nil, err
+	}
+
 	e.Time = timestamppb.New(t)
+	if pt.Valid {
+		e.PurchaseTime = timestamppb.New(pt.Time)
+	}
 
 	return &e, nil
 }
+
+func (*PortfolioShare) InitTables(db persistence.Preparer) (err error) {
+	_, err = db.Exec(`CREATE TABLE IF NOT EXISTS portfolio_shares (
+portfolio_id TEXT NOT NULL,
+subject TEXT NOT NULL,
+access INTEGER NOT NULL,
+PRIMARY KEY (portfolio_id, subject)
+);`)
+
+	return
+}
+
+func (*PortfolioShare) PrepareReplace(db persistence.Preparer) (stmt *sql.Stmt, err error) {
+	return db.Prepare(`REPLACE INTO portfolio_shares (portfolio_id, subject, access) VALUES (?,?,?);`)
+}
+
+// PrepareList prepares a query that lists all shares of a portfolio. It
+// expects the portfolio ID as argument.
+func (*PortfolioShare) PrepareList(db persistence.Preparer) (stmt *sql.Stmt, err error) {
+	return db.Prepare(`SELECT portfolio_id, subject, access FROM portfolio_shares
+WHERE ?1 IS NULL OR portfolio_id = ?1 ORDER BY portfolio_id, subject`)
+}
+
+func (*PortfolioShare) PrepareGet(db persistence.Preparer) (stmt *sql.Stmt, err error) {
+	return db.Prepare(`SELECT portfolio_id, subject, access FROM portfolio_shares WHERE portfolio_id = ? AND subject = ?`)
+}
+
+func (*PortfolioShare) PrepareUpdate(db persistence.Preparer, columns []string) (stmt *sql.Stmt, err error) {
+	// We need to make sure to quote columns here because they are potentially evil user input
+	var (
+		query string
+		set   []string
+	)
+
+	set = make([]string, len(columns))
+	for i, col := range columns {
+		set[i] = persistence.Quote(col) + " = ?"
+	}
+
+	query += "UPDATE portfolio_shares SET " + strings.Join(set, ", ") + " WHERE portfolio_id = ? AND subject = ?;"
+
+	return db.Prepare(query)
+}
+
+func (*PortfolioShare) PrepareDelete(db persistence.Preparer) (stmt *sql.Stmt, err error) {
+	return db.Prepare(`DELETE FROM portfolio_shares WHERE portfolio_id = ? AND subject = ?`)
+}
+
+func (s *PortfolioShare) ReplaceIntoArgs() []any {
+	return []any{s.PortfolioId, s.Subject, s.Access}
+}
+
+func (s *PortfolioShare) UpdateArgs(columns []string) (args []any) {
+	for _, col := range columns {
+		switch col {
+		case "access":
+			args = append(args, s.Access)
+		}
+	}
+
+	return args
+}
+
+func (*PortfolioShare) Scan(sc persistence.Scanner) (obj persistence.StorageObject, err error) {
+	var (
+		s PortfolioShare
+	)
+
+	err = sc.Scan(&s.PortfolioId, &s.Subject, &s.Access)
+	if err != nil {
+		return nil, err
+	}
+
+	return &s, nil
+}
@@ -26,6 +26,14 @@ const (
 	PortfolioServiceName = "mgo.portfolio.v1.PortfolioService"
 	// SecuritiesServiceName is the fully-qualified name of the SecuritiesService service.
 	SecuritiesServiceName = "mgo.portfolio.v1.SecuritiesService"
+	// AdminServiceName is the fully-qualified name of the AdminService service.
+	AdminServiceName = "mgo.portfolio.v1.AdminService"
+	// TokenServiceName is the fully-qualified name of the TokenService service.
+	TokenServiceName = "mgo.portfolio.v1.TokenService"
+	// AuditServiceName is the fully-qualified name of the AuditService service.
+	AuditServiceName = "mgo.portfolio.v1.AuditService"
+	// AlertServiceName is the fully-qualified name of the AlertService service.
+	AlertServiceName = "mgo.portfolio.v1.AlertService"
 )
 
 // These constants are the fully-qualified names of the RPCs defined in this package. They're
@@ -54,6 +62,12 @@ const (
 	// PortfolioServiceGetPortfolioSnapshotProcedure is the fully-qualified name of the
 	// PortfolioService's GetPortfolioSnapshot RPC.
 	PortfolioServiceGetPortfolioSnapshotProcedure = "/mgo.portfolio.v1.PortfolioService/GetPortfolioSnapshot"
+	// PortfolioServiceWatchPortfolioSnapshotProcedure is the fully-qualified name of the
+	// PortfolioService's WatchPortfolioSnapshot RPC.
+	PortfolioServiceWatchPortfolioSnapshotProcedure = "/mgo.portfolio.v1.PortfolioService/WatchPortfolioSnapshot"
+	// PortfolioServiceGetConsolidatedSnapshotProcedure is the fully-qualified name of the
+	// PortfolioService's GetConsolidatedSnapshot RPC.
+	PortfolioServiceGetConsolidatedSnapshotProcedure = "/mgo.portfolio.v1.PortfolioService/GetConsolidatedSnapshot"
 	// PortfolioServiceCreatePortfolioTransactionProcedure is the fully-qualified name of the
 	// PortfolioService's CreatePortfolioTransaction RPC.
 	PortfolioServiceCreatePortfolioTransactionProcedure = "/mgo.portfolio.v1.PortfolioService/CreatePortfolioTransaction"
@@ -69,18 +83,99 @@ const (
 	// PortfolioServiceDeletePortfolioTransactionProcedure is the fully-qualified name of the
 	// PortfolioService's DeletePortfolioTransaction RPC.
 	PortfolioServiceDeletePortfolioTransactionProcedure = "/mgo.portfolio.v1.PortfolioService/DeletePortfolioTransaction"
+	// PortfolioServiceBatchCreatePortfolioTransactionsProcedure is the fully-qualified name of the
+	// PortfolioService's BatchCreatePortfolioTransactions RPC.
+	PortfolioServiceBatchCreatePortfolioTransactionsProcedure = "/mgo.portfolio.v1.PortfolioService/BatchCreatePortfolioTransactions"
+	// PortfolioServiceBatchUpdatePortfolioTransactionsProcedure is the fully-qualified name of the
+	// PortfolioService's BatchUpdatePortfolioTransactions RPC.
+	PortfolioServiceBatchUpdatePortfolioTransactionsProcedure = "/mgo.portfolio.v1.PortfolioService/BatchUpdatePortfolioTransactions"
+	// PortfolioServiceBatchDeletePortfolioTransactionsProcedure is the fully-qualified name of the
+	// PortfolioService's BatchDeletePortfolioTransactions RPC.
+	PortfolioServiceBatchDeletePortfolioTransactionsProcedure = "/mgo.portfolio.v1.PortfolioService/BatchDeletePortfolioTransactions"
 	// PortfolioServiceImportTransactionsProcedure is the fully-qualified name of the PortfolioService's
 	// ImportTransactions RPC.
 	PortfolioServiceImportTransactionsProcedure = "/mgo.portfolio.v1.PortfolioService/ImportTransactions"
+	// PortfolioServiceConfirmPortfolioTransactionProcedure is the fully-qualified name of the
+	// PortfolioService's ConfirmPortfolioTransaction RPC.
+	PortfolioServiceConfirmPortfolioTransactionProcedure = "/mgo.portfolio.v1.PortfolioService/ConfirmPortfolioTransaction"
+	// PortfolioServiceUploadAttachmentProcedure is the fully-qualified name of the PortfolioService's
+	// UploadAttachment RPC.
+	PortfolioServiceUploadAttachmentProcedure = "/mgo.portfolio.v1.PortfolioService/UploadAttachment"
+	// PortfolioServiceDownloadAttachmentProcedure is the fully-qualified name of the PortfolioService's
+	// DownloadAttachment RPC.
+	PortfolioServiceDownloadAttachmentProcedure = "/mgo.portfolio.v1.PortfolioService/DownloadAttachment"
+	// PortfolioServiceListAttachmentsProcedure is the fully-qualified name of the PortfolioService's
+	// ListAttachments RPC.
+	PortfolioServiceListAttachmentsProcedure = "/mgo.portfolio.v1.PortfolioService/ListAttachments"
+	// PortfolioServiceDeleteAttachmentProcedure is the fully-qualified name of the PortfolioService's
+	// DeleteAttachment RPC.
+	PortfolioServiceDeleteAttachmentProcedure = "/mgo.portfolio.v1.PortfolioService/DeleteAttachment"
+	// PortfolioServiceCreatePortfolioTransferProcedure is the fully-qualified name of the
+	// PortfolioService's CreatePortfolioTransfer RPC.
+	PortfolioServiceCreatePortfolioTransferProcedure = "/mgo.portfolio.v1.PortfolioService/CreatePortfolioTransfer"
 	// PortfolioServiceCreateBankAccountProcedure is the fully-qualified name of the PortfolioService's
 	// CreateBankAccount RPC.
 	PortfolioServiceCreateBankAccountProcedure = "/mgo.portfolio.v1.PortfolioService/CreateBankAccount"
+	// PortfolioServiceListBankAccountsProcedure is the fully-qualified name of the PortfolioService's
+	// ListBankAccounts RPC.
+	PortfolioServiceListBankAccountsProcedure = "/mgo.portfolio.v1.PortfolioService/ListBankAccounts"
+	// PortfolioServiceGetBankAccountProcedure is the fully-qualified name of the PortfolioService's
+	// GetBankAccount RPC.
+	PortfolioServiceGetBankAccountProcedure = "/mgo.portfolio.v1.PortfolioService/GetBankAccount"
+	// PortfolioServiceGetCashLedgerProcedure is the fully-qualified name of the PortfolioService's
+	// GetCashLedger RPC.
+	PortfolioServiceGetCashLedgerProcedure = "/mgo.portfolio.v1.PortfolioService/GetCashLedger"
 	// PortfolioServiceUpdateBankAccountProcedure is the fully-qualified name of the PortfolioService's
 	// UpdateBankAccount RPC.
 	PortfolioServiceUpdateBankAccountProcedure = "/mgo.portfolio.v1.PortfolioService/UpdateBankAccount"
 	// PortfolioServiceDeleteBankAccountProcedure is the fully-qualified name of the PortfolioService's
 	// DeleteBankAccount RPC.
 	PortfolioServiceDeleteBankAccountProcedure = "/mgo.portfolio.v1.PortfolioService/DeleteBankAccount"
+	// PortfolioServiceCreateWatchlistProcedure is the fully-qualified name of the PortfolioService's
+	// CreateWatchlist RPC.
+	PortfolioServiceCreateWatchlistProcedure = "/mgo.portfolio.v1.PortfolioService/CreateWatchlist"
+	// PortfolioServiceListWatchlistsProcedure is the fully-qualified name of the PortfolioService's
+	// ListWatchlists RPC.
+	PortfolioServiceListWatchlistsProcedure = "/mgo.portfolio.v1.PortfolioService/ListWatchlists"
+	// PortfolioServiceGetWatchlistProcedure is the fully-qualified name of the PortfolioService's
+	// GetWatchlist RPC.
+	PortfolioServiceGetWatchlistProcedure = "/mgo.portfolio.v1.PortfolioService/GetWatchlist"
+	// PortfolioServiceUpdateWatchlistProcedure is the fully-qualified name of the PortfolioService's
+	// UpdateWatchlist RPC.
+	PortfolioServiceUpdateWatchlistProcedure = "/mgo.portfolio.v1.PortfolioService/UpdateWatchlist"
+	// PortfolioServiceDeleteWatchlistProcedure is the fully-qualified name of the PortfolioService's
+	// DeleteWatchlist RPC.
+	PortfolioServiceDeleteWatchlistProcedure = "/mgo.portfolio.v1.PortfolioService/DeleteWatchlist"
+	// PortfolioServiceCreateSavingsPlanProcedure is the fully-qualified name of the PortfolioService's
+	// CreateSavingsPlan RPC.
+	PortfolioServiceCreateSavingsPlanProcedure = "/mgo.portfolio.v1.PortfolioService/CreateSavingsPlan"
+	// PortfolioServiceListSavingsPlansProcedure is the fully-qualified name of the PortfolioService's
+	// ListSavingsPlans RPC.
+	PortfolioServiceListSavingsPlansProcedure = "/mgo.portfolio.v1.PortfolioService/ListSavingsPlans"
+	// PortfolioServiceGetSavingsPlanProcedure is the fully-qualified name of the PortfolioService's
+	// GetSavingsPlan RPC.
+	PortfolioServiceGetSavingsPlanProcedure = "/mgo.portfolio.v1.PortfolioService/GetSavingsPlan"
+	// PortfolioServiceUpdateSavingsPlanProcedure is the fully-qualified name of the PortfolioService's
+	// UpdateSavingsPlan RPC.
+	PortfolioServiceUpdateSavingsPlanProcedure = "/mgo.portfolio.v1.PortfolioService/UpdateSavingsPlan"
+	// PortfolioServiceDeleteSavingsPlanProcedure is the fully-qualified name of the PortfolioService's
+	// DeleteSavingsPlan RPC.
+	PortfolioServiceDeleteSavingsPlanProcedure = "/mgo.portfolio.v1.PortfolioService/DeleteSavingsPlan"
+	// PortfolioServiceSharePortfolioProcedure is the fully-qualified name of the PortfolioService's
+	// SharePortfolio RPC.
+	PortfolioServiceSharePortfolioProcedure = "/mgo.portfolio.v1.PortfolioService/SharePortfolio"
+	// PortfolioServiceUnsharePortfolioProcedure is the fully-qualified name of the PortfolioService's
+	// UnsharePortfolio RPC.
+	PortfolioServiceUnsharePortfolioProcedure = "/mgo.portfolio.v1.PortfolioService/UnsharePortfolio"
+	// PortfolioServiceListPortfolioSharesProcedure is the fully-qualified name of the
+	// PortfolioService's ListPortfolioShares RPC.
+	PortfolioServiceListPortfolioSharesProcedure = "/mgo.portfolio.v1.PortfolioService/ListPortfolioShares"
+	// PortfolioServiceListTrashProcedure is the fully-qualified name of the PortfolioService's
+	// ListTrash RPC.
+	PortfolioServiceListTrashProcedure = "/mgo.portfolio.v1.PortfolioService/ListTrash"
+	// PortfolioServiceRestoreFromTrashProcedure is the fully-qualified name of the PortfolioService's
+	// RestoreFromTrash RPC.
+	PortfolioServiceRestoreFromTrashProcedure = "/mgo.portfolio.v1.PortfolioService/RestoreFromTrash"
 	// SecuritiesServiceListSecuritiesProcedure is the fully-qualified name of the SecuritiesService's
 	// ListSecurities RPC.
 	SecuritiesServiceListSecuritiesProcedure = "/mgo.portfolio.v1.SecuritiesService/ListSecurities"
@@ -99,33 +194,109 @@ const (
 	// SecuritiesServiceTriggerSecurityQuoteUpdateProcedure is the fully-qualified name of the
 	// SecuritiesService's TriggerSecurityQuoteUpdate RPC.
 	SecuritiesServiceTriggerSecurityQuoteUpdateProcedure = "/mgo.portfolio.v1.SecuritiesService/TriggerSecurityQuoteUpdate"
+	// AdminServiceCreateBackupProcedure is the fully-qualified name of the AdminService's CreateBackup
+	// RPC.
+	AdminServiceCreateBackupProcedure = "/mgo.portfolio.v1.AdminService/CreateBackup"
+	// AdminServiceExportDumpProcedure is the fully-qualified name of the AdminService's ExportDump RPC.
+	AdminServiceExportDumpProcedure = "/mgo.portfolio.v1.AdminService/ExportDump"
+	// AdminServiceRestoreDumpProcedure is the fully-qualified name of the AdminService's RestoreDump
+	// RPC.
+	AdminServiceRestoreDumpProcedure = "/mgo.portfolio.v1.AdminService/RestoreDump"
+	// TokenServiceCreateAccessTokenProcedure is the fully-qualified name of the TokenService's
+	// CreateAccessToken RPC.
+	TokenServiceCreateAccessTokenProcedure = "/mgo.portfolio.v1.TokenService/CreateAccessToken"
+	// TokenServiceListAccessTokensProcedure is the fully-qualified name of the TokenService's
+	// ListAccessTokens RPC.
+	TokenServiceListAccessTokensProcedure = "/mgo.portfolio.v1.TokenService/ListAccessTokens"
+	// TokenServiceDeleteAccessTokenProcedure is the fully-qualified name of the TokenService's
+	// DeleteAccessToken RPC.
+	TokenServiceDeleteAccessTokenProcedure = "/mgo.portfolio.v1.TokenService/DeleteAccessToken"
+	// AuditServiceListAuditEventsProcedure is the fully-qualified name of the AuditService's
+	// ListAuditEvents RPC.
+	AuditServiceListAuditEventsProcedure = "/mgo.portfolio.v1.AuditService/ListAuditEvents"
+	// AlertServiceCreateAlertRuleProcedure is the fully-qualified name of the AlertService's
+	// CreateAlertRule RPC.
+	AlertServiceCreateAlertRuleProcedure = "/mgo.portfolio.v1.AlertService/CreateAlertRule"
+	// AlertServiceListAlertRulesProcedure is the fully-qualified name of the AlertService's
+	// ListAlertRules RPC.
+	AlertServiceListAlertRulesProcedure = "/mgo.portfolio.v1.AlertService/ListAlertRules"
+	// AlertServiceDeleteAlertRuleProcedure is the fully-qualified name of the AlertService's
+	// DeleteAlertRule RPC.
+	AlertServiceDeleteAlertRuleProcedure = "/mgo.portfolio.v1.AlertService/DeleteAlertRule"
+	// AlertServiceListTriggeredAlertsProcedure is the fully-qualified name of the AlertService's
+	// ListTriggeredAlerts RPC.
+	AlertServiceListTriggeredAlertsProcedure = "/mgo.portfolio.v1.AlertService/ListTriggeredAlerts"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
 var (
-	portfolioServiceServiceDescriptor                           = gen.File_mgo_proto.Services().ByName("PortfolioService")
-	portfolioServiceCreatePortfolioMethodDescriptor             = portfolioServiceServiceDescriptor.Methods().ByName("CreatePortfolio")
-	portfolioServiceListPortfoliosMethodDescriptor              = portfolioServiceServiceDescriptor.Methods().ByName("ListPortfolios")
-	portfolioServiceGetPortfolioMethodDescriptor                = portfolioServiceServiceDescriptor.Methods().ByName("GetPortfolio")
-	portfolioServiceUpdatePortfolioMethodDescriptor             = portfolioServiceServiceDescriptor.Methods().ByName("UpdatePortfolio")
-	portfolioServiceDeletePortfolioMethodDescriptor             = portfolioServiceServiceDescriptor.Methods().ByName("DeletePortfolio")
-	portfolioServiceGetPortfolioSnapshotMethodDescriptor        = portfolioServiceServiceDescriptor.Methods().ByName("GetPortfolioSnapshot")
-	portfolioServiceCreatePortfolioTransactionMethodDescriptor  = portfolioServiceServiceDescriptor.Methods().ByName("CreatePortfolioTransaction")
-	portfolioServiceGetPortfolioTransactionMethodDescriptor     = portfolioServiceServiceDescriptor.Methods().ByName("GetPortfolioTransaction")
-	portfolioServiceListPortfolioTransactionsMethodDescriptor   = portfolioServiceServiceDescriptor.Methods().ByName("ListPortfolioTransactions")
-	portfolioServiceUpdatePortfolioTransactionMethodDescriptor  = portfolioServiceServiceDescriptor.Methods().ByName("UpdatePortfolioTransaction")
-	portfolioServiceDeletePortfolioTransactionMethodDescriptor  = portfolioServiceServiceDescriptor.Methods().ByName("DeletePortfolioTransaction")
-	portfolioServiceImportTransactionsMethodDescriptor          = portfolioServiceServiceDescriptor.Methods().ByName("ImportTransactions")
-	portfolioServiceCreateBankAccountMethodDescriptor           = portfolioServiceServiceDescriptor.Methods().ByName("CreateBankAccount")
-	portfolioServiceUpdateBankAccountMethodDescriptor           = portfolioServiceServiceDescriptor.Methods().ByName("UpdateBankAccount")
-	portfolioServiceDeleteBankAccountMethodDescriptor           = portfolioServiceServiceDescriptor.Methods().ByName("DeleteBankAccount")
-	securitiesServiceServiceDescriptor                          = gen.File_mgo_proto.Services().ByName("SecuritiesService")
-	securitiesServiceListSecuritiesMethodDescriptor             = securitiesServiceServiceDescriptor.Methods().ByName("ListSecurities")
-	securitiesServiceGetSecurityMethodDescriptor                = securitiesServiceServiceDescriptor.Methods().ByName("GetSecurity")
-	securitiesServiceCreateSecurityMethodDescriptor             = securitiesServiceServiceDescriptor.Methods().ByName("CreateSecurity")
-	securitiesServiceUpdateSecurityMethodDescriptor             = securitiesServiceServiceDescriptor.Methods().ByName("UpdateSecurity")
-	securitiesServiceDeleteSecurityMethodDescriptor             = securitiesServiceServiceDescriptor.Methods().ByName("DeleteSecurity")
-	securitiesServiceTriggerSecurityQuoteUpdateMethodDescriptor = securitiesServiceServiceDescriptor.Methods().ByName("TriggerSecurityQuoteUpdate")
+	portfolioServiceServiceDescriptor                                = gen.File_mgo_proto.Services().ByName("PortfolioService")
+	portfolioServiceCreatePortfolioMethodDescriptor                  = portfolioServiceServiceDescriptor.Methods().ByName("CreatePortfolio")
+	portfolioServiceListPortfoliosMethodDescriptor                   = portfolioServiceServiceDescriptor.Methods().ByName("ListPortfolios")
+	portfolioServiceGetPortfolioMethodDescriptor                     = portfolioServiceServiceDescriptor.Methods().ByName("GetPortfolio")
+	portfolioServiceUpdatePortfolioMethodDescriptor                  = portfolioServiceServiceDescriptor.Methods().ByName("UpdatePortfolio")
+	portfolioServiceDeletePortfolioMethodDescriptor                  = portfolioServiceServiceDescriptor.Methods().ByName("DeletePortfolio")
+	portfolioServiceGetPortfolioSnapshotMethodDescriptor             = portfolioServiceServiceDescriptor.Methods().ByName("GetPortfolioSnapshot")
+	portfolioServiceWatchPortfolioSnapshotMethodDescriptor           = portfolioServiceServiceDescriptor.Methods().ByName("WatchPortfolioSnapshot")
+	portfolioServiceGetConsolidatedSnapshotMethodDescriptor          = portfolioServiceServiceDescriptor.Methods().ByName("GetConsolidatedSnapshot")
+	portfolioServiceCreatePortfolioTransactionMethodDescriptor       = portfolioServiceServiceDescriptor.Methods().ByName("CreatePortfolioTransaction")
+	portfolioServiceGetPortfolioTransactionMethodDescriptor          = portfolioServiceServiceDescriptor.Methods().ByName("GetPortfolioTransaction")
+	portfolioServiceListPortfolioTransactionsMethodDescriptor        = portfolioServiceServiceDescriptor.Methods().ByName("ListPortfolioTransactions")
+	portfolioServiceUpdatePortfolioTransactionMethodDescriptor       = portfolioServiceServiceDescriptor.Methods().ByName("UpdatePortfolioTransaction")
+	portfolioServiceDeletePortfolioTransactionMethodDescriptor       = portfolioServiceServiceDescriptor.Methods().ByName("DeletePortfolioTransaction")
+	portfolioServiceBatchCreatePortfolioTransactionsMethodDescriptor = portfolioServiceServiceDescriptor.Methods().ByName("BatchCreatePortfolioTransactions")
+	portfolioServiceBatchUpdatePortfolioTransactionsMethodDescriptor = portfolioServiceServiceDescriptor.Methods().ByName("BatchUpdatePortfolioTransactions")
+	portfolioServiceBatchDeletePortfolioTransactionsMethodDescriptor = portfolioServiceServiceDescriptor.Methods().ByName("BatchDeletePortfolioTransactions")
+	portfolioServiceImportTransactionsMethodDescriptor               = portfolioServiceServiceDescriptor.Methods().ByName("ImportTransactions")
+	portfolioServiceConfirmPortfolioTransactionMethodDescriptor      = portfolioServiceServiceDescriptor.Methods().ByName("ConfirmPortfolioTransaction")
+	portfolioServiceUploadAttachmentMethodDescriptor                 = portfolioServiceServiceDescriptor.Methods().ByName("UploadAttachment")
+	portfolioServiceDownloadAttachmentMethodDescriptor               = portfolioServiceServiceDescriptor.Methods().ByName("DownloadAttachment")
+	portfolioServiceListAttachmentsMethodDescriptor                  = portfolioServiceServiceDescriptor.Methods().ByName("ListAttachments")
+	portfolioServiceDeleteAttachmentMethodDescriptor                 = portfolioServiceServiceDescriptor.Methods().ByName("DeleteAttachment")
+	portfolioServiceCreatePortfolioTransferMethodDescriptor          = portfolioServiceServiceDescriptor.Methods().ByName("CreatePortfolioTransfer")
+	portfolioServiceCreateBankAccountMethodDescriptor                = portfolioServiceServiceDescriptor.Methods().ByName("CreateBankAccount")
+	portfolioServiceListBankAccountsMethodDescriptor                 = portfolioServiceServiceDescriptor.Methods().ByName("ListBankAccounts")
+	portfolioServiceGetBankAccountMethodDescriptor                   = portfolioServiceServiceDescriptor.Methods().ByName("GetBankAccount")
+	portfolioServiceGetCashLedgerMethodDescriptor                    = portfolioServiceServiceDescriptor.Methods().ByName("GetCashLedger")
+	portfolioServiceUpdateBankAccountMethodDescriptor                = portfolioServiceServiceDescriptor.Methods().ByName("UpdateBankAccount")
+	portfolioServiceDeleteBankAccountMethodDescriptor                = portfolioServiceServiceDescriptor.Methods().ByName("DeleteBankAccount")
+	portfolioServiceCreateWatchlistMethodDescriptor                  = portfolioServiceServiceDescriptor.Methods().ByName("CreateWatchlist")
+	portfolioServiceListWatchlistsMethodDescriptor                   = portfolioServiceServiceDescriptor.Methods().ByName("ListWatchlists")
+	portfolioServiceGetWatchlistMethodDescriptor                     = portfolioServiceServiceDescriptor.Methods().ByName("GetWatchlist")
+	portfolioServiceUpdateWatchlistMethodDescriptor                  = portfolioServiceServiceDescriptor.Methods().ByName("UpdateWatchlist")
+	portfolioServiceDeleteWatchlistMethodDescriptor                  = portfolioServiceServiceDescriptor.Methods().ByName("DeleteWatchlist")
+	portfolioServiceCreateSavingsPlanMethodDescriptor                = portfolioServiceServiceDescriptor.Methods().ByName("CreateSavingsPlan")
+	portfolioServiceListSavingsPlansMethodDescriptor                 = portfolioServiceServiceDescriptor.Methods().ByName("ListSavingsPlans")
+	portfolioServiceGetSavingsPlanMethodDescriptor                   = portfolioServiceServiceDescriptor.Methods().ByName("GetSavingsPlan")
+	portfolioServiceUpdateSavingsPlanMethodDescriptor                = portfolioServiceServiceDescriptor.Methods().ByName("UpdateSavingsPlan")
+	portfolioServiceDeleteSavingsPlanMethodDescriptor                = portfolioServiceServiceDescriptor.Methods().ByName("DeleteSavingsPlan")
+	portfolioServiceSharePortfolioMethodDescriptor                   = portfolioServiceServiceDescriptor.Methods().ByName("SharePortfolio")
+	portfolioServiceUnsharePortfolioMethodDescriptor                 = portfolioServiceServiceDescriptor.Methods().ByName("UnsharePortfolio")
+	portfolioServiceListPortfolioSharesMethodDescriptor              = portfolioServiceServiceDescriptor.Methods().ByName("ListPortfolioShares")
+	portfolioServiceListTrashMethodDescriptor                        = portfolioServiceServiceDescriptor.Methods().ByName("ListTrash")
+	portfolioServiceRestoreFromTrashMethodDescriptor                 = portfolioServiceServiceDescriptor.Methods().ByName("RestoreFromTrash")
+	securitiesServiceServiceDescriptor                               = gen.File_mgo_proto.Services().ByName("SecuritiesService")
+	securitiesServiceListSecuritiesMethodDescriptor                  = securitiesServiceServiceDescriptor.Methods().ByName("ListSecurities")
+	securitiesServiceGetSecurityMethodDescriptor                     = securitiesServiceServiceDescriptor.Methods().ByName("GetSecurity")
+	securitiesServiceCreateSecurityMethodDescriptor                  = securitiesServiceServiceDescriptor.Methods().ByName("CreateSecurity")
+	securitiesServiceUpdateSecurityMethodDescriptor                  = securitiesServiceServiceDescriptor.Methods().ByName("UpdateSecurity")
+	securitiesServiceDeleteSecurityMethodDescriptor                  = securitiesServiceServiceDescriptor.Methods().ByName("DeleteSecurity")
+	securitiesServiceTriggerSecurityQuoteUpdateMethodDescriptor      = securitiesServiceServiceDescriptor.Methods().ByName("TriggerSecurityQuoteUpdate")
+	adminServiceServiceDescriptor                                    = gen.File_mgo_proto.Services().ByName("AdminService")
+	adminServiceCreateBackupMethodDescriptor                         = adminServiceServiceDescriptor.Methods().ByName("CreateBackup")
+	adminServiceExportDumpMethodDescriptor                           = adminServiceServiceDescriptor.Methods().ByName("ExportDump")
+	adminServiceRestoreDumpMethodDescriptor                          = adminServiceServiceDescriptor.Methods().ByName("RestoreDump")
+	tokenServiceServiceDescriptor                                    = gen.File_mgo_proto.Services().ByName("TokenService")
+	tokenServiceCreateAccessTokenMethodDescriptor                    = tokenServiceServiceDescriptor.Methods().ByName("CreateAccessToken")
+	tokenServiceListAccessTokensMethodDescriptor                     = tokenServiceServiceDescriptor.Methods().ByName("ListAccessTokens")
+	tokenServiceDeleteAccessTokenMethodDescriptor                    = tokenServiceServiceDescriptor.Methods().ByName("DeleteAccessToken")
+	auditServiceServiceDescriptor                                    = gen.File_mgo_proto.Services().ByName("AuditService")
+	auditServiceListAuditEventsMethodDescriptor                      = auditServiceServiceDescriptor.Methods().ByName("ListAuditEvents")
+	alertServiceServiceDescriptor                                    = gen.File_mgo_proto.Services().ByName("AlertService")
+	alertServiceCreateAlertRuleMethodDescriptor                      = alertServiceServiceDescriptor.Methods().ByName("CreateAlertRule")
+	alertServiceListAlertRulesMethodDescriptor                       = alertServiceServiceDescriptor.Methods().ByName("ListAlertRules")
+	alertServiceDeleteAlertRuleMethodDescriptor                      = alertServiceServiceDescriptor.Methods().ByName("DeleteAlertRule")
+	alertServiceListTriggeredAlertsMethodDescriptor                  = alertServiceServiceDescriptor.Methods().ByName("ListTriggeredAlerts")
 )
 
 // PortfolioServiceClient is a client for the mgo.portfolio.v1.PortfolioService service.
@@ -134,17 +305,74 @@ type PortfolioServiceClient interface {
 	ListPortfolios(context.Context, *connect.Request[gen.ListPortfoliosRequest]) (*connect.Response[gen.ListPortfoliosResponse], error)
 	GetPortfolio(context.Context, *connect.Request[gen.GetPortfolioRequest]) (*connect.Response[gen.Portfolio], error)
 	UpdatePortfolio(context.Context, *connect.Request[gen.UpdatePortfolioRequest]) (*connect.Response[gen.Portfolio], error)
+	// DeletePortfolio moves a portfolio and all of its transactions to the
+	// trash.
 	DeletePortfolio(context.Context, *connect.Request[gen.DeletePortfolioRequest]) (*connect.Response[emptypb.Empty], error)
 	GetPortfolioSnapshot(context.Context, *connect.Request[gen.GetPortfolioSnapshotRequest]) (*connect.Response[gen.PortfolioSnapshot], error)
+	// WatchPortfolioSnapshot streams the current snapshot of a portfolio and
+	// a new one whenever its transactions or the quotes of its securities
+	// change.
+	WatchPortfolioSnapshot(context.Context, *connect.Request[gen.WatchPortfolioSnapshotRequest]) (*connect.ServerStreamForClient[gen.PortfolioSnapshot], error)
+	// GetConsolidatedSnapshot aggregates the snapshots of several portfolios,
+	// e.g., the depots of a household at different banks.
+	GetConsolidatedSnapshot(context.Context, *connect.Request[gen.GetConsolidatedSnapshotRequest]) (*connect.Response[gen.ConsolidatedSnapshot], error)
 	CreatePortfolioTransaction(context.Context, *connect.Request[gen.CreatePortfolioTransactionRequest]) (*connect.Response[gen.PortfolioEvent], error)
 	GetPortfolioTransaction(context.Context, *connect.Request[gen.GetPortfolioTransactionRequest]) (*connect.Response[gen.PortfolioEvent], error)
 	ListPortfolioTransactions(context.Context, *connect.Request[gen.ListPortfolioTransactionsRequest]) (*connect.Response[gen.ListPortfolioTransactionsResponse], error)
 	UpdatePortfolioTransaction(context.Context, *connect.Request[gen.UpdatePortfolioTransactionRequest]) (*connect.Response[gen.PortfolioEvent], error)
+	// DeletePortfolioTransaction moves a transaction to the trash.
 	DeletePortfolioTransaction(context.Context, *connect.Request[gen.DeletePortfolioTransactionRequest]) (*connect.Response[emptypb.Empty], error)
+	// BatchCreatePortfolioTransactions creates several transactions at once.
+	// Either all or none of them are created.
+	BatchCreatePortfolioTransactions(context.Context, *connect.Request[gen.BatchCreatePortfolioTransactionsRequest]) (*connect.Response[gen.BatchCreatePortfolioTransactionsResponse], error)
+	// BatchUpdatePortfolioTransactions updates several transactions at once.
+	// Either all or none of them are updated.
+	BatchUpdatePortfolioTransactions(context.Context, *connect.Request[gen.BatchUpdatePortfolioTransactionsRequest]) (*connect.Response[gen.BatchUpdatePortfolioTransactionsResponse], error)
+	// BatchDeletePortfolioTransactions moves several transactions to the trash
+	// at once. Either all or none of them are deleted.
+	BatchDeletePortfolioTransactions(context.Context, *connect.Request[gen.BatchDeletePortfolioTransactionsRequest]) (*connect.Response[emptypb.Empty], error)
 	ImportTransactions(context.Context, *connect.Request[gen.ImportTransactionsRequest]) (*connect.Response[emptypb.Empty], error)
+	// ConfirmPortfolioTransaction confirms a pending transaction of a savings
+	// plan, optionally adjusting it to the actual execution.
+	ConfirmPortfolioTransaction(context.Context, *connect.Request[gen.ConfirmPortfolioTransactionRequest]) (*connect.Response[gen.PortfolioEvent], error)
+	// UploadAttachment attaches a document to a transaction. The first message
+	// contains the attachment, all following messages its content.
+	UploadAttachment(context.Context) *connect.ClientStreamForClient[gen.UploadAttachmentRequest, gen.Attachment]
+	// DownloadAttachment streams the attachment, followed by its content.
+	DownloadAttachment(context.Context, *connect.Request[gen.DownloadAttachmentRequest]) (*connect.ServerStreamForClient[gen.DownloadAttachmentResponse], error)
+	ListAttachments(context.Context, *connect.Request[gen.ListAttachmentsRequest]) (*connect.Response[gen.ListAttachmentsResponse], error)
+	DeleteAttachment(context.Context, *connect.Request[gen.DeleteAttachmentRequest]) (*connect.Response[emptypb.Empty], error)
+	// CreatePortfolioTransfer transfers shares or cash between two portfolios.
+	// Deleting one of its transactions deletes the whole transfer.
+	CreatePortfolioTransfer(context.Context, *connect.Request[gen.CreatePortfolioTransferRequest]) (*connect.Response[gen.PortfolioTransfer], error)
 	CreateBankAccount(context.Context, *connect.Request[gen.CreateBankAccountRequest]) (*connect.Response[gen.BankAccount], error)
+	ListBankAccounts(context.Context, *connect.Request[gen.ListBankAccountsRequest]) (*connect.Response[gen.ListBankAccountsResponse], error)
+	GetBankAccount(context.Context, *connect.Request[gen.GetBankAccountRequest]) (*connect.Response[gen.BankAccount], error)
+	// GetCashLedger returns the running cash ledger of a bank account.
+	GetCashLedger(context.Context, *connect.Request[gen.GetCashLedgerRequest]) (*connect.Response[gen.CashLedger], error)
 	UpdateBankAccount(context.Context, *connect.Request[gen.UpdateBankAccountRequest]) (*connect.Response[gen.BankAccount], error)
 	DeleteBankAccount(context.Context, *connect.Request[gen.DeleteBankAccountRequest]) (*connect.Response[emptypb.Empty], error)
+	CreateWatchlist(context.Context, *connect.Request[gen.CreateWatchlistRequest]) (*connect.Response[gen.Watchlist], error)
+	ListWatchlists(context.Context, *connect.Request[gen.ListWatchlistsRequest]) (*connect.Response[gen.ListWatchlistsResponse], error)
+	GetWatchlist(context.Context, *connect.Request[gen.GetWatchlistRequest]) (*connect.Response[gen.Watchlist], error)
+	UpdateWatchlist(context.Context, *connect.Request[gen.UpdateWatchlistRequest]) (*connect.Response[gen.Watchlist], error)
+	DeleteWatchlist(context.Context, *connect.Request[gen.DeleteWatchlistRequest]) (*connect.Response[emptypb.Empty], error)
+	CreateSavingsPlan(context.Context, *connect.Request[gen.CreateSavingsPlanRequest]) (*connect.Response[gen.SavingsPlan], error)
+	ListSavingsPlans(context.Context, *connect.Request[gen.ListSavingsPlansRequest]) (*connect.Response[gen.ListSavingsPlansResponse], error)
+	GetSavingsPlan(context.Context, *connect.Request[gen.GetSavingsPlanRequest]) (*connect.Response[gen.SavingsPlan], error)
+	UpdateSavingsPlan(context.Context, *connect.Request[gen.UpdateSavingsPlanRequest]) (*connect.Response[gen.SavingsPlan], error)
+	// DeleteSavingsPlan stops a savings plan. Its transactions are kept.
+	DeleteSavingsPlan(context.Context, *connect.Request[gen.DeleteSavingsPlanRequest]) (*connect.Response[emptypb.Empty], error)
+	SharePortfolio(context.Context, *connect.Request[gen.SharePortfolioRequest]) (*connect.Response[gen.PortfolioShare], error)
+	UnsharePortfolio(context.Context, *connect.Request[gen.UnsharePortfolioRequest]) (*connect.Response[emptypb.Empty], error)
+	ListPortfolioShares(context.Context, *connect.Request[gen.ListPortfolioSharesRequest]) (*connect.Response[gen.ListPortfolioSharesResponse], error)
+	// ListTrash lists the deleted portfolios and transactions of the user as
+	// well as deleted securities, if the user is allowed to change them.
+	ListTrash(context.Context, *connect.Request[gen.ListTrashRequest]) (*connect.Response[gen.ListTrashResponse], error)
+	// RestoreFromTrash restores a deleted portfolio, transaction or security.
+	// Restoring a portfolio also restores the transactions that were deleted
+	// together with it.
+	RestoreFromTrash(context.Context, *connect.Request[gen.RestoreFromTrashRequest]) (*connect.Response[emptypb.Empty], error)
 }
 
 // NewPortfolioServiceClient constructs a client for the mgo.portfolio.v1.PortfolioService service.
@@ -196,6 +424,20 @@ func NewPortfolioServiceClient(httpClient connect.HTTPClient, baseURL string, op
 			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
+		watchPortfolioSnapshot: connect.NewClient[gen.WatchPortfolioSnapshotRequest, gen.PortfolioSnapshot](
+			httpClient,
+			baseURL+PortfolioServiceWatchPortfolioSnapshotProcedure,
+			connect.WithSchema(portfolioServiceWatchPortfolioSnapshotMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getConsolidatedSnapshot: connect.NewClient[gen.GetConsolidatedSnapshotRequest, gen.ConsolidatedSnapshot](
+			httpClient,
+			baseURL+PortfolioServiceGetConsolidatedSnapshotProcedure,
+			connect.WithSchema(portfolioServiceGetConsolidatedSnapshotMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
 		createPortfolioTransaction: connect.NewClient[gen.CreatePortfolioTransactionRequest, gen.PortfolioEvent](
 			httpClient,
 			baseURL+PortfolioServiceCreatePortfolioTransactionProcedure,
@@ -228,18 +470,95 @@ func NewPortfolioServiceClient(httpClient connect.HTTPClient, baseURL string, op
 			connect.WithSchema(portfolioServiceDeletePortfolioTransactionMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		batchCreatePortfolioTransactions: connect.NewClient[gen.BatchCreatePortfolioTransactionsRequest, gen.BatchCreatePortfolioTransactionsResponse](
+			httpClient,
+			baseURL+PortfolioServiceBatchCreatePortfolioTransactionsProcedure,
+			connect.WithSchema(portfolioServiceBatchCreatePortfolioTransactionsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		batchUpdatePortfolioTransactions: connect.NewClient[gen.BatchUpdatePortfolioTransactionsRequest, gen.BatchUpdatePortfolioTransactionsResponse](
+			httpClient,
+			baseURL+PortfolioServiceBatchUpdatePortfolioTransactionsProcedure,
+			connect.WithSchema(portfolioServiceBatchUpdatePortfolioTransactionsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		batchDeletePortfolioTransactions: connect.NewClient[gen.BatchDeletePortfolioTransactionsRequest, emptypb.Empty](
+			httpClient,
+			baseURL+PortfolioServiceBatchDeletePortfolioTransactionsProcedure,
+			connect.WithSchema(portfolioServiceBatchDeletePortfolioTransactionsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		importTransactions: connect.NewClient[gen.ImportTransactionsRequest, emptypb.Empty](
 			httpClient,
 			baseURL+PortfolioServiceImportTransactionsProcedure,
 			connect.WithSchema(portfolioServiceImportTransactionsMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		confirmPortfolioTransaction: connect.NewClient[gen.ConfirmPortfolioTransactionRequest, gen.PortfolioEvent](
+			httpClient,
+			baseURL+PortfolioServiceConfirmPortfolioTransactionProcedure,
+			connect.WithSchema(portfolioServiceConfirmPortfolioTransactionMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		uploadAttachment: connect.NewClient[gen.UploadAttachmentRequest, gen.Attachment](
+			httpClient,
+			baseURL+PortfolioServiceUploadAttachmentProcedure,
+			connect.WithSchema(portfolioServiceUploadAttachmentMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		downloadAttachment: connect.NewClient[gen.DownloadAttachmentRequest, gen.DownloadAttachmentResponse](
+			httpClient,
+			baseURL+PortfolioServiceDownloadAttachmentProcedure,
+			connect.WithSchema(portfolioServiceDownloadAttachmentMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		listAttachments: connect.NewClient[gen.ListAttachmentsRequest, gen.ListAttachmentsResponse](
+			httpClient,
+			baseURL+PortfolioServiceListAttachmentsProcedure,
+			connect.WithSchema(portfolioServiceListAttachmentsMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		deleteAttachment: connect.NewClient[gen.DeleteAttachmentRequest, emptypb.Empty](
+			httpClient,
+			baseURL+PortfolioServiceDeleteAttachmentProcedure,
+			connect.WithSchema(portfolioServiceDeleteAttachmentMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		createPortfolioTransfer: connect.NewClient[gen.CreatePortfolioTransferRequest, gen.PortfolioTransfer](
+			httpClient,
+			baseURL+PortfolioServiceCreatePortfolioTransferProcedure,
+			connect.WithSchema(portfolioServiceCreatePortfolioTransferMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		createBankAccount: connect.NewClient[gen.CreateBankAccountRequest, gen.BankAccount](
 			httpClient,
 			baseURL+PortfolioServiceCreateBankAccountProcedure,
 			connect.WithSchema(portfolioServiceCreateBankAccountMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		listBankAccounts: connect.NewClient[gen.ListBankAccountsRequest, gen.ListBankAccountsResponse](
+			httpClient,
+			baseURL+PortfolioServiceListBankAccountsProcedure,
+			connect.WithSchema(portfolioServiceListBankAccountsMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getBankAccount: connect.NewClient[gen.GetBankAccountRequest, gen.BankAccount](
+			httpClient,
+			baseURL+PortfolioServiceGetBankAccountProcedure,
+			connect.WithSchema(portfolioServiceGetBankAccountMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getCashLedger: connect.NewClient[gen.GetCashLedgerRequest, gen.CashLedger](
+			httpClient,
+			baseURL+PortfolioServiceGetCashLedgerProcedure,
+			connect.WithSchema(portfolioServiceGetCashLedgerMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
 		updateBankAccount: connect.NewClient[gen.UpdateBankAccountRequest, gen.BankAccount](
 			httpClient,
 			baseURL+PortfolioServiceUpdateBankAccountProcedure,
@@ -252,26 +571,151 @@ func NewPortfolioServiceClient(httpClient connect.HTTPClient, baseURL string, op
 			connect.WithSchema(portfolioServiceDeleteBankAccountMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		createWatchlist: connect.NewClient[gen.CreateWatchlistRequest, gen.Watchlist](
+			httpClient,
+			baseURL+PortfolioServiceCreateWatchlistProcedure,
+			connect.WithSchema(portfolioServiceCreateWatchlistMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listWatchlists: connect.NewClient[gen.ListWatchlistsRequest, gen.ListWatchlistsResponse](
+			httpClient,
+			baseURL+PortfolioServiceListWatchlistsProcedure,
+			connect.WithSchema(portfolioServiceListWatchlistsMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getWatchlist: connect.NewClient[gen.GetWatchlistRequest, gen.Watchlist](
+			httpClient,
+			baseURL+PortfolioServiceGetWatchlistProcedure,
+			connect.WithSchema(portfolioServiceGetWatchlistMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		updateWatchlist: connect.NewClient[gen.UpdateWatchlistRequest, gen.Watchlist](
+			httpClient,
+			baseURL+PortfolioServiceUpdateWatchlistProcedure,
+			connect.WithSchema(portfolioServiceUpdateWatchlistMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		deleteWatchlist: connect.NewClient[gen.DeleteWatchlistRequest, emptypb.Empty](
+			httpClient,
+			baseURL+PortfolioServiceDeleteWatchlistProcedure,
+			connect.WithSchema(portfolioServiceDeleteWatchlistMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		createSavingsPlan: connect.NewClient[gen.CreateSavingsPlanRequest, gen.SavingsPlan](
+			httpClient,
+			baseURL+PortfolioServiceCreateSavingsPlanProcedure,
+			connect.WithSchema(portfolioServiceCreateSavingsPlanMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listSavingsPlans: connect.NewClient[gen.ListSavingsPlansRequest, gen.ListSavingsPlansResponse](
+			httpClient,
+			baseURL+PortfolioServiceListSavingsPlansProcedure,
+			connect.WithSchema(portfolioServiceListSavingsPlansMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getSavingsPlan: connect.NewClient[gen.GetSavingsPlanRequest, gen.SavingsPlan](
+			httpClient,
+			baseURL+PortfolioServiceGetSavingsPlanProcedure,
+			connect.WithSchema(portfolioServiceGetSavingsPlanMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		updateSavingsPlan: connect.NewClient[gen.UpdateSavingsPlanRequest, gen.SavingsPlan](
+			httpClient,
+			baseURL+PortfolioServiceUpdateSavingsPlanProcedure,
+			connect.WithSchema(portfolioServiceUpdateSavingsPlanMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		deleteSavingsPlan: connect.NewClient[gen.DeleteSavingsPlanRequest, emptypb.Empty](
+			httpClient,
+			baseURL+PortfolioServiceDeleteSavingsPlanProcedure,
+			connect.WithSchema(portfolioServiceDeleteSavingsPlanMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		sharePortfolio: connect.NewClient[gen.SharePortfolioRequest, gen.PortfolioShare](
+			httpClient,
+			baseURL+PortfolioServiceSharePortfolioProcedure,
+			connect.WithSchema(portfolioServiceSharePortfolioMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		unsharePortfolio: connect.NewClient[gen.UnsharePortfolioRequest, emptypb.Empty](
+			httpClient,
+			baseURL+PortfolioServiceUnsharePortfolioProcedure,
+			connect.WithSchema(portfolioServiceUnsharePortfolioMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listPortfolioShares: connect.NewClient[gen.ListPortfolioSharesRequest, gen.ListPortfolioSharesResponse](
+			httpClient,
+			baseURL+PortfolioServiceListPortfolioSharesProcedure,
+			connect.WithSchema(portfolioServiceListPortfolioSharesMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		listTrash: connect.NewClient[gen.ListTrashRequest, gen.ListTrashResponse](
+			httpClient,
+			baseURL+PortfolioServiceListTrashProcedure,
+			connect.WithSchema(portfolioServiceListTrashMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		restoreFromTrash: connect.NewClient[gen.RestoreFromTrashRequest, emptypb.Empty](
+			httpClient,
+			baseURL+PortfolioServiceRestoreFromTrashProcedure,
+			connect.WithSchema(portfolioServiceRestoreFromTrashMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // portfolioServiceClient implements PortfolioServiceClient.
 type portfolioServiceClient struct {
-	createPortfolio            *connect.Client[gen.CreatePortfolioRequest, gen.Portfolio]
-	listPortfolios             *connect.Client[gen.ListPortfoliosRequest, gen.ListPortfoliosResponse]
-	getPortfolio               *connect.Client[gen.GetPortfolioRequest, gen.Portfolio]
-	updatePortfolio            *connect.Client[gen.UpdatePortfolioRequest, gen.Portfolio]
-	deletePortfolio            *connect.Client[gen.DeletePortfolioRequest, emptypb.Empty]
-	getPortfolioSnapshot       *connect.Client[gen.GetPortfolioSnapshotRequest, gen.PortfolioSnapshot]
-	createPortfolioTransaction *connect.Client[gen.CreatePortfolioTransactionRequest, gen.PortfolioEvent]
-	getPortfolioTransaction    *connect.Client[gen.GetPortfolioTransactionRequest, gen.PortfolioEvent]
-	listPortfolioTransactions  *connect.Client[gen.ListPortfolioTransactionsRequest, gen.ListPortfolioTransactionsResponse]
-	updatePortfolioTransaction *connect.Client[gen.UpdatePortfolioTransactionRequest, gen.PortfolioEvent]
-	deletePortfolioTransaction *connect.Client[gen.DeletePortfolioTransactionRequest, emptypb.Empty]
-	importTransactions         *connect.Client[gen.ImportTransactionsRequest, emptypb.Empty]
-	createBankAccount          *connect.Client[gen.CreateBankAccountRequest, gen.BankAccount]
-	updateBankAccount          *connect.Client[gen.UpdateBankAccountRequest, gen.BankAccount]
-	deleteBankAccount          *connect.Client[gen.DeleteBankAccountRequest, emptypb.Empty]
+	createPortfolio                  *connect.Client[gen.CreatePortfolioRequest, gen.Portfolio]
+	listPortfolios                   *connect.Client[gen.ListPortfoliosRequest, gen.ListPortfoliosResponse]
+	getPortfolio                     *connect.Client[gen.GetPortfolioRequest, gen.Portfolio]
+	updatePortfolio                  *connect.Client[gen.UpdatePortfolioRequest, gen.Portfolio]
+	deletePortfolio                  *connect.Client[gen.DeletePortfolioRequest, emptypb.Empty]
+	getPortfolioSnapshot             *connect.Client[gen.GetPortfolioSnapshotRequest, gen.PortfolioSnapshot]
+	watchPortfolioSnapshot           *connect.Client[gen.WatchPortfolioSnapshotRequest, gen.PortfolioSnapshot]
+	getConsolidatedSnapshot          *connect.Client[gen.GetConsolidatedSnapshotRequest, gen.ConsolidatedSnapshot]
+	createPortfolioTransaction       *connect.Client[gen.CreatePortfolioTransactionRequest, gen.PortfolioEvent]
+	getPortfolioTransaction          *connect.Client[gen.GetPortfolioTransactionRequest, gen.PortfolioEvent]
+	listPortfolioTransactions        *connect.Client[gen.ListPortfolioTransactionsRequest, gen.ListPortfolioTransactionsResponse]
+	updatePortfolioTransaction       *connect.Client[gen.UpdatePortfolioTransactionRequest, gen.PortfolioEvent]
+	deletePortfolioTransaction       *connect.Client[gen.DeletePortfolioTransactionRequest, emptypb.Empty]
+	batchCreatePortfolioTransactions *connect.Client[gen.BatchCreatePortfolioTransactionsRequest, gen.BatchCreatePortfolioTransactionsResponse]
+	batchUpdatePortfolioTransactions *connect.Client[gen.BatchUpdatePortfolioTransactionsRequest, gen.BatchUpdatePortfolioTransactionsResponse]
+	batchDeletePortfolioTransactions *connect.Client[gen.BatchDeletePortfolioTransactionsRequest, emptypb.Empty]
+	importTransactions               *connect.Client[gen.ImportTransactionsRequest, emptypb.Empty]
+	confirmPortfolioTransaction      *connect.Client[gen.ConfirmPortfolioTransactionRequest, gen.PortfolioEvent]
+	uploadAttachment                 *connect.Client[gen.UploadAttachmentRequest, gen.Attachment]
+	downloadAttachment               *connect.Client[gen.DownloadAttachmentRequest, gen.DownloadAttachmentResponse]
+	listAttachments                  *connect.Client[gen.ListAttachmentsRequest, gen.ListAttachmentsResponse]
+	deleteAttachment                 *connect.Client[gen.DeleteAttachmentRequest, emptypb.Empty]
+	createPortfolioTransfer          *connect.Client[gen.CreatePortfolioTransferRequest, gen.PortfolioTransfer]
+	createBankAccount                *connect.Client[gen.CreateBankAccountRequest, gen.BankAccount]
+	listBankAccounts                 *connect.Client[gen.ListBankAccountsRequest, gen.ListBankAccountsResponse]
+	getBankAccount                   *connect.Client[gen.GetBankAccountRequest, gen.BankAccount]
+	getCashLedger                    *connect.Client[gen.GetCashLedgerRequest, gen.CashLedger]
+	updateBankAccount                *connect.Client[gen.UpdateBankAccountRequest, gen.BankAccount]
+	deleteBankAccount                *connect.Client[gen.DeleteBankAccountRequest, emptypb.Empty]
+	createWatchlist                  *connect.Client[gen.CreateWatchlistRequest, gen.Watchlist]
+	listWatchlists                   *connect.Client[gen.ListWatchlistsRequest, gen.ListWatchlistsResponse]
+	getWatchlist                     *connect.Client[gen.GetWatchlistRequest, gen.Watchlist]
+	updateWatchlist                  *connect.Client[gen.UpdateWatchlistRequest, gen.Watchlist]
+	deleteWatchlist                  *connect.Client[gen.DeleteWatchlistRequest, emptypb.Empty]
+	createSavingsPlan                *connect.Client[gen.CreateSavingsPlanRequest, gen.SavingsPlan]
+	listSavingsPlans                 *connect.Client[gen.ListSavingsPlansRequest, gen.ListSavingsPlansResponse]
+	getSavingsPlan                   *connect.Client[gen.GetSavingsPlanRequest, gen.SavingsPlan]
+	updateSavingsPlan                *connect.Client[gen.UpdateSavingsPlanRequest, gen.SavingsPlan]
+	deleteSavingsPlan                *connect.Client[gen.DeleteSavingsPlanRequest, emptypb.Empty]
+	sharePortfolio                   *connect.Client[gen.SharePortfolioRequest, gen.PortfolioShare]
+	unsharePortfolio                 *connect.Client[gen.UnsharePortfolioRequest, emptypb.Empty]
+	listPortfolioShares              *connect.Client[gen.ListPortfolioSharesRequest, gen.ListPortfolioSharesResponse]
+	listTrash                        *connect.Client[gen.ListTrashRequest, gen.ListTrashResponse]
+	restoreFromTrash                 *connect.Client[gen.RestoreFromTrashRequest, emptypb.Empty]
 }
 
 // CreatePortfolio calls mgo.portfolio.v1.PortfolioService.CreatePortfolio.
@@ -304,6 +748,16 @@ func (c *portfolioServiceClient) GetPortfolioSnapshot(ctx context.Context, req *
 	return c.getPortfolioSnapshot.CallUnary(ctx, req)
 }
 
+// WatchPortfolioSnapshot calls mgo.portfolio.v1.PortfolioService.WatchPortfolioSnapshot.
+func (c *portfolioServiceClient) WatchPortfolioSnapshot(ctx context.Context, req *connect.Request[gen.WatchPortfolioSnapshotRequest]) (*connect.ServerStreamForClient[gen.PortfolioSnapshot], error) {
+	return c.watchPortfolioSnapshot.CallServerStream(ctx, req)
+}
+
+// GetConsolidatedSnapshot calls mgo.portfolio.v1.PortfolioService.GetConsolidatedSnapshot.
+func (c *portfolioServiceClient) GetConsolidatedSnapshot(ctx context.Context, req *connect.Request[gen.GetConsolidatedSnapshotRequest]) (*connect.Response[gen.ConsolidatedSnapshot], error) {
+	return c.getConsolidatedSnapshot.CallUnary(ctx, req)
+}
+
 // CreatePortfolioTransaction calls mgo.portfolio.v1.PortfolioService.CreatePortfolioTransaction.
 func (c *portfolioServiceClient) CreatePortfolioTransaction(ctx context.Context, req *connect.Request[gen.CreatePortfolioTransactionRequest]) (*connect.Response[gen.PortfolioEvent], error) {
 	return c.createPortfolioTransaction.CallUnary(ctx, req)
@@ -329,16 +783,79 @@ func (c *portfolioServiceClient) DeletePortfolioTransaction(ctx context.Context,
 	return c.deletePortfolioTransaction.CallUnary(ctx, req)
 }
 
+// BatchCreatePortfolioTransactions calls
+// mgo.portfolio.v1.PortfolioService.BatchCreatePortfolioTransactions.
+func (c *portfolioServiceClient) BatchCreatePortfolioTransactions(ctx context.Context, req *connect.Request[gen.BatchCreatePortfolioTransactionsRequest]) (*connect.Response[gen.BatchCreatePortfolioTransactionsResponse], error) {
+	return c.batchCreatePortfolioTransactions.CallUnary(ctx, req)
+}
+
+// BatchUpdatePortfolioTransactions calls
+// mgo.portfolio.v1.PortfolioService.BatchUpdatePortfolioTransactions.
+func (c *portfolioServiceClient) BatchUpdatePortfolioTransactions(ctx context.Context, req *connect.Request[gen.BatchUpdatePortfolioTransactionsRequest]) (*connect.Response[gen.BatchUpdatePortfolioTransactionsResponse], error) {
+	return c.batchUpdatePortfolioTransactions.CallUnary(ctx, req)
+}
+
+// BatchDeletePortfolioTransactions calls
+// mgo.portfolio.v1.PortfolioService.BatchDeletePortfolioTransactions.
+func (c *portfolioServiceClient) BatchDeletePortfolioTransactions(ctx context.Context, req *connect.Request[gen.BatchDeletePortfolioTransactionsRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.batchDeletePortfolioTransactions.CallUnary(ctx, req)
+}
+
 // ImportTransactions calls mgo.portfolio.v1.PortfolioService.ImportTransactions.
 func (c *portfolioServiceClient) ImportTransactions(ctx context.Context, req *connect.Request[gen.ImportTransactionsRequest]) (*connect.Response[emptypb.Empty], error) {
 	return c.importTransactions.CallUnary(ctx, req)
 }
 
+// ConfirmPortfolioTransaction calls mgo.portfolio.v1.PortfolioService.ConfirmPortfolioTransaction.
+func (c *portfolioServiceClient) ConfirmPortfolioTransaction(ctx context.Context, req *connect.Request[gen.ConfirmPortfolioTransactionRequest]) (*connect.Response[gen.PortfolioEvent], error) {
+	return c.confirmPortfolioTransaction.CallUnary(ctx, req)
+}
+
+// UploadAttachment calls mgo.portfolio.v1.PortfolioService.UploadAttachment.
+func (c *portfolioServiceClient) UploadAttachment(ctx context.Context) *connect.ClientStreamForClient[gen.UploadAttachmentRequest, gen.Attachment] {
+	return c.uploadAttachment.CallClientStream(ctx)
+}
+
+// DownloadAttachment calls mgo.portfolio.v1.PortfolioService.DownloadAttachment.
+func (c *portfolioServiceClient) DownloadAttachment(ctx context.Context, req *connect.Request[gen.DownloadAttachmentRequest]) (*connect.ServerStreamForClient[gen.DownloadAttachmentResponse], error) {
+	return c.downloadAttachment.CallServerStream(ctx, req)
+}
+
+// ListAttachments calls mgo.portfolio.v1.PortfolioService.ListAttachments.
+func (c *portfolioServiceClient) ListAttachments(ctx context.Context, req *connect.Request[gen.ListAttachmentsRequest]) (*connect.Response[gen.ListAttachmentsResponse], error) {
+	return c.listAttachments.CallUnary(ctx, req)
+}
+
+// DeleteAttachment calls mgo.portfolio.v1.PortfolioService.DeleteAttachment.
+func (c *portfolioServiceClient) DeleteAttachment(ctx context.Context, req *connect.Request[gen.DeleteAttachmentRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.deleteAttachment.CallUnary(ctx, req)
+}
+
+// CreatePortfolioTransfer calls mgo.portfolio.v1.PortfolioService.CreatePortfolioTransfer.
+func (c *portfolioServiceClient) CreatePortfolioTransfer(ctx context.Context, req *connect.Request[gen.CreatePortfolioTransferRequest]) (*connect.Response[gen.PortfolioTransfer], error) {
+	return c.createPortfolioTransfer.CallUnary(ctx, req)
+}
+
 // CreateBankAccount calls mgo.portfolio.v1.PortfolioService.CreateBankAccount.
 func (c *portfolioServiceClient) CreateBankAccount(ctx context.Context, req *connect.Request[gen.CreateBankAccountRequest]) (*connect.Response[gen.BankAccount], error) {
 	return c.createBankAccount.CallUnary(ctx, req)
 }
 
+// ListBankAccounts calls mgo.portfolio.v1.PortfolioService.ListBankAccounts.
+func (c *portfolioServiceClient) ListBankAccounts(ctx context.Context, req *connect.Request[gen.ListBankAccountsRequest]) (*connect.Response[gen.ListBankAccountsResponse], error) {
+	return c.listBankAccounts.CallUnary(ctx, req)
+}
+
+// GetBankAccount calls mgo.portfolio.v1.PortfolioService.GetBankAccount.
+func (c *portfolioServiceClient) GetBankAccount(ctx context.Context, req *connect.Request[gen.GetBankAccountRequest]) (*connect.Response[gen.BankAccount], error) {
+	return c.getBankAccount.CallUnary(ctx, req)
+}
+
+// GetCashLedger calls mgo.portfolio.v1.PortfolioService.GetCashLedger.
+func (c *portfolioServiceClient) GetCashLedger(ctx context.Context, req *connect.Request[gen.GetCashLedgerRequest]) (*connect.Response[gen.CashLedger], error) {
+	return c.getCashLedger.CallUnary(ctx, req)
+}
+
 // UpdateBankAccount calls mgo.portfolio.v1.PortfolioService.UpdateBankAccount.
 func (c *portfolioServiceClient) UpdateBankAccount(ctx context.Context, req *connect.Request[gen.UpdateBankAccountRequest]) (*connect.Response[gen.BankAccount], error) {
 	return c.updateBankAccount.CallUnary(ctx, req)
@@ -349,23 +866,155 @@ func (c *portfolioServiceClient) DeleteBankAccount(ctx context.Context, req *con
 	return c.deleteBankAccount.CallUnary(ctx, req)
 }
 
+// CreateWatchlist calls mgo.portfolio.v1.PortfolioService.CreateWatchlist.
+func (c *portfolioServiceClient) CreateWatchlist(ctx context.Context, req *connect.Request[gen.CreateWatchlistRequest]) (*connect.Response[gen.Watchlist], error) {
+	return c.createWatchlist.CallUnary(ctx, req)
+}
+
+// ListWatchlists calls mgo.portfolio.v1.PortfolioService.ListWatchlists.
+func (c *portfolioServiceClient) ListWatchlists(ctx context.Context, req *connect.Request[gen.ListWatchlistsRequest]) (*connect.Response[gen.ListWatchlistsResponse], error) {
+	return c.listWatchlists.CallUnary(ctx, req)
+}
+
+// GetWatchlist calls mgo.portfolio.v1.PortfolioService.GetWatchlist.
+func (c *portfolioServiceClient) GetWatchlist(ctx context.Context, req *connect.Request[gen.GetWatchlistRequest]) (*connect.Response[gen.Watchlist], error) {
+	return c.getWatchlist.CallUnary(ctx, req)
+}
+
+// UpdateWatchlist calls mgo.portfolio.v1.PortfolioService.UpdateWatchlist.
+func (c *portfolioServiceClient) UpdateWatchlist(ctx context.Context, req *connect.Request[gen.UpdateWatchlistRequest]) (*connect.Response[gen.Watchlist], error) {
+	return c.updateWatchlist.CallUnary(ctx, req)
+}
+
+// DeleteWatchlist calls mgo.portfolio.v1.PortfolioService.DeleteWatchlist.
+func (c *portfolioServiceClient) DeleteWatchlist(ctx context.Context, req *connect.Request[gen.DeleteWatchlistRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.deleteWatchlist.CallUnary(ctx, req)
+}
+
+// CreateSavingsPlan calls mgo.portfolio.v1.PortfolioService.CreateSavingsPlan.
+func (c *portfolioServiceClient) CreateSavingsPlan(ctx context.Context, req *connect.Request[gen.CreateSavingsPlanRequest]) (*connect.Response[gen.SavingsPlan], error) {
+	return c.createSavingsPlan.CallUnary(ctx, req)
+}
+
+// ListSavingsPlans calls mgo.portfolio.v1.PortfolioService.ListSavingsPlans.
+func (c *portfolioServiceClient) ListSavingsPlans(ctx context.Context, req *connect.Request[gen.ListSavingsPlansRequest]) (*connect.Response[gen.ListSavingsPlansResponse], error) {
+	return c.listSavingsPlans.CallUnary(ctx, req)
+}
+
+// GetSavingsPlan calls mgo.portfolio.v1.PortfolioService.GetSavingsPlan.
+func (c *portfolioServiceClient) GetSavingsPlan(ctx context.Context, req *connect.Request[gen.GetSavingsPlanRequest]) (*connect.Response[gen.SavingsPlan], error) {
+	return c.getSavingsPlan.CallUnary(ctx, req)
+}
+
+// UpdateSavingsPlan calls mgo.portfolio.v1.PortfolioService.UpdateSavingsPlan.
+func (c *portfolioServiceClient) UpdateSavingsPlan(ctx context.Context, req *connect.Request[gen.UpdateSavingsPlanRequest]) (*connect.Response[gen.SavingsPlan], error) {
+	return c.updateSavingsPlan.CallUnary(ctx, req)
+}
+
+// DeleteSavingsPlan calls mgo.portfolio.v1.PortfolioService.DeleteSavingsPlan.
+func (c *portfolioServiceClient) DeleteSavingsPlan(ctx context.Context, req *connect.Request[gen.DeleteSavingsPlanRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.deleteSavingsPlan.CallUnary(ctx, req)
+}
+
+// SharePortfolio calls mgo.portfolio.v1.PortfolioService.SharePortfolio.
+func (c *portfolioServiceClient) SharePortfolio(ctx context.Context, req *connect.Request[gen.SharePortfolioRequest]) (*connect.Response[gen.PortfolioShare], error) {
+	return c.sharePortfolio.CallUnary(ctx, req)
+}
+
+// UnsharePortfolio calls mgo.portfolio.v1.PortfolioService.UnsharePortfolio.
+func (c *portfolioServiceClient) UnsharePortfolio(ctx context.Context, req *connect.Request[gen.UnsharePortfolioRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.unsharePortfolio.CallUnary(ctx, req)
+}
+
+// ListPortfolioShares calls mgo.portfolio.v1.PortfolioService.ListPortfolioShares.
+func (c *portfolioServiceClient) ListPortfolioShares(ctx context.Context, req *connect.Request[gen.ListPortfolioSharesRequest]) (*connect.Response[gen.ListPortfolioSharesResponse], error) {
+	return c.listPortfolioShares.CallUnary(ctx, req)
+}
+
+// ListTrash calls mgo.portfolio.v1.PortfolioService.ListTrash.
+func (c *portfolioServiceClient) ListTrash(ctx context.Context, req *connect.Request[gen.ListTrashRequest]) (*connect.Response[gen.ListTrashResponse], error) {
+	return c.listTrash.CallUnary(ctx, req)
+}
+
+// RestoreFromTrash calls mgo.portfolio.v1.PortfolioService.RestoreFromTrash.
+func (c *portfolioServiceClient) RestoreFromTrash(ctx context.Context, req *connect.Request[gen.RestoreFromTrashRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.restoreFromTrash.CallUnary(ctx, req)
+}
+
 // PortfolioServiceHandler is an implementation of the mgo.portfolio.v1.PortfolioService service.
 type PortfolioServiceHandler interface {
 	CreatePortfolio(context.Context, *connect.Request[gen.CreatePortfolioRequest]) (*connect.Response[gen.Portfolio], error)
 	ListPortfolios(context.Context, *connect.Request[gen.ListPortfoliosRequest]) (*connect.Response[gen.ListPortfoliosResponse], error)
 	GetPortfolio(context.Context, *connect.Request[gen.GetPortfolioRequest]) (*connect.Response[gen.Portfolio], error)
 	UpdatePortfolio(context.Context, *connect.Request[gen.UpdatePortfolioRequest]) (*connect.Response[gen.Portfolio], error)
+	// DeletePortfolio moves a portfolio and all of its transactions to the
+	// trash.
 	DeletePortfolio(context.Context, *connect.Request[gen.DeletePortfolioRequest]) (*connect.Response[emptypb.Empty], error)
 	GetPortfolioSnapshot(context.Context, *connect.Request[gen.GetPortfolioSnapshotRequest]) (*connect.Response[gen.PortfolioSnapshot], error)
+	// WatchPortfolioSnapshot streams the current snapshot of a portfolio and
+	// a new one whenever its transactions or the quotes of its securities
+	// change.
+	WatchPortfolioSnapshot(context.Context, *connect.Request[gen.WatchPortfolioSnapshotRequest], *connect.ServerStream[gen.PortfolioSnapshot]) error
+	// GetConsolidatedSnapshot aggregates the snapshots of several portfolios,
+	// e.g., the depots of a household at different banks.
+	GetConsolidatedSnapshot(context.Context, *connect.Request[gen.GetConsolidatedSnapshotRequest]) (*connect.Response[gen.ConsolidatedSnapshot], error)
 	CreatePortfolioTransaction(context.Context, *connect.Request[gen.CreatePortfolioTransactionRequest]) (*connect.Response[gen.PortfolioEvent], error)
 	GetPortfolioTransaction(context.Context, *connect.Request[gen.GetPortfolioTransactionRequest]) (*connect.Response[gen.PortfolioEvent], error)
 	ListPortfolioTransactions(context.Context, *connect.Request[gen.ListPortfolioTransactionsRequest]) (*connect.Response[gen.ListPortfolioTransactionsResponse], error)
 	UpdatePortfolioTransaction(context.Context, *connect.Request[gen.UpdatePortfolioTransactionRequest]) (*connect.Response[gen.PortfolioEvent], error)
+	// DeletePortfolioTransaction moves a transaction to the trash.
 	DeletePortfolioTransaction(context.Context, *connect.Request[gen.DeletePortfolioTransactionRequest]) (*connect.Response[emptypb.Empty], error)
+	// BatchCreatePortfolioTransactions creates several transactions at once.
+	// Either all or none of them are created.
+	BatchCreatePortfolioTransactions(context.Context, *connect.Request[gen.BatchCreatePortfolioTransactionsRequest]) (*connect.Response[gen.BatchCreatePortfolioTransactionsResponse], error)
+	// BatchUpdatePortfolioTransactions updates several transactions at once.
+	// Either all or none of them are updated.
+	BatchUpdatePortfolioTransactions(context.Context, *connect.Request[gen.BatchUpdatePortfolioTransactionsRequest]) (*connect.Response[gen.BatchUpdatePortfolioTransactionsResponse], error)
+	// BatchDeletePortfolioTransactions moves several transactions to the trash
+	// at once. Either all or none of them are deleted.
+	BatchDeletePortfolioTransactions(context.Context, *connect.Request[gen.BatchDeletePortfolioTransactionsRequest]) (*connect.Response[emptypb.Empty], error)
 	ImportTransactions(context.Context, *connect.Request[gen.ImportTransactionsRequest]) (*connect.Response[emptypb.Empty], error)
+	// ConfirmPortfolioTransaction confirms a pending transaction of a savings
+	// plan, optionally adjusting it to the actual execution.
+	ConfirmPortfolioTransaction(context.Context, *connect.Request[gen.ConfirmPortfolioTransactionRequest]) (*connect.Response[gen.PortfolioEvent], error)
+	// UploadAttachment attaches a document to a transaction. The first message
+	// contains the attachment, all following messages its content.
+	UploadAttachment(context.Context, *connect.ClientStream[gen.UploadAttachmentRequest]) (*connect.Response[gen.Attachment], error)
+	// DownloadAttachment streams the attachment, followed by its content.
+	DownloadAttachment(context.Context, *connect.Request[gen.DownloadAttachmentRequest], *connect.ServerStream[gen.DownloadAttachmentResponse]) error
+	ListAttachments(context.Context, *connect.Request[gen.ListAttachmentsRequest]) (*connect.Response[gen.ListAttachmentsResponse], error)
+	DeleteAttachment(context.Context, *connect.Request[gen.DeleteAttachmentRequest]) (*connect.Response[emptypb.Empty], error)
+	// CreatePortfolioTransfer transfers shares or cash between two portfolios.
+	// Deleting one of its transactions deletes the whole transfer.
+	CreatePortfolioTransfer(context.Context, *connect.Request[gen.CreatePortfolioTransferRequest]) (*connect.Response[gen.PortfolioTransfer], error)
 	CreateBankAccount(context.Context, *connect.Request[gen.CreateBankAccountRequest]) (*connect.Response[gen.BankAccount], error)
+	ListBankAccounts(context.Context, *connect.Request[gen.ListBankAccountsRequest]) (*connect.Response[gen.ListBankAccountsResponse], error)
+	GetBankAccount(context.Context, *connect.Request[gen.GetBankAccountRequest]) (*connect.Response[gen.BankAccount], error)
+	// GetCashLedger returns the running cash ledger of a bank account.
+	GetCashLedger(context.Context, *connect.Request[gen.GetCashLedgerRequest]) (*connect.Response[gen.CashLedger], error)
 	UpdateBankAccount(context.Context, *connect.Request[gen.UpdateBankAccountRequest]) (*connect.Response[gen.BankAccount], error)
 	DeleteBankAccount(context.Context, *connect.Request[gen.DeleteBankAccountRequest]) (*connect.Response[emptypb.Empty], error)
+	CreateWatchlist(context.Context, *connect.Request[gen.CreateWatchlistRequest]) (*connect.Response[gen.Watchlist], error)
+	ListWatchlists(context.Context, *connect.Request[gen.ListWatchlistsRequest]) (*connect.Response[gen.ListWatchlistsResponse], error)
+	GetWatchlist(context.Context, *connect.Request[gen.GetWatchlistRequest]) (*connect.Response[gen.Watchlist], error)
+	UpdateWatchlist(context.Context, *connect.Request[gen.UpdateWatchlistRequest]) (*connect.Response[gen.Watchlist], error)
+	DeleteWatchlist(context.Context, *connect.Request[gen.DeleteWatchlistRequest]) (*connect.Response[emptypb.Empty], error)
+	CreateSavingsPlan(context.Context, *connect.Request[gen.CreateSavingsPlanRequest]) (*connect.Response[gen.SavingsPlan], error)
+	ListSavingsPlans(context.Context, *connect.Request[gen.ListSavingsPlansRequest]) (*connect.Response[gen.ListSavingsPlansResponse], error)
+	GetSavingsPlan(context.Context, *connect.Request[gen.GetSavingsPlanRequest]) (*connect.Response[gen.SavingsPlan], error)
+	UpdateSavingsPlan(context.Context, *connect.Request[gen.UpdateSavingsPlanRequest]) (*connect.Response[gen.SavingsPlan], error)
+	// DeleteSavingsPlan stops a savings plan. Its transactions are kept.
+	DeleteSavingsPlan(context.Context, *connect.Request[gen.DeleteSavingsPlanRequest]) (*connect.Response[emptypb.Empty], error)
+	SharePortfolio(context.Context, *connect.Request[gen.SharePortfolioRequest]) (*connect.Response[gen.PortfolioShare], error)
+	UnsharePortfolio(context.Context, *connect.Request[gen.UnsharePortfolioRequest]) (*connect.Response[emptypb.Empty], error)
+	ListPortfolioShares(context.Context, *connect.Request[gen.ListPortfolioSharesRequest]) (*connect.Response[gen.ListPortfolioSharesResponse], error)
+	// ListTrash lists the deleted portfolios and transactions of the user as
+	// well as deleted securities, if the user is allowed to change them.
+	ListTrash(context.Context, *connect.Request[gen.ListTrashRequest]) (*connect.Response[gen.ListTrashResponse], error)
+	// RestoreFromTrash restores a deleted portfolio, transaction or security.
+	// Restoring a portfolio also restores the transactions that were deleted
+	// together with it.
+	RestoreFromTrash(context.Context, *connect.Request[gen.RestoreFromTrashRequest]) (*connect.Response[emptypb.Empty], error)
 }
 
 // NewPortfolioServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -413,6 +1062,20 @@ func NewPortfolioServiceHandler(svc PortfolioServiceHandler, opts ...connect.Han
 		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
+	portfolioServiceWatchPortfolioSnapshotHandler := connect.NewServerStreamHandler(
+		PortfolioServiceWatchPortfolioSnapshotProcedure,
+		svc.WatchPortfolioSnapshot,
+		connect.WithSchema(portfolioServiceWatchPortfolioSnapshotMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	portfolioServiceGetConsolidatedSnapshotHandler := connect.NewUnaryHandler(
+		PortfolioServiceGetConsolidatedSnapshotProcedure,
+		svc.GetConsolidatedSnapshot,
+		connect.WithSchema(portfolioServiceGetConsolidatedSnapshotMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
 	portfolioServiceCreatePortfolioTransactionHandler := connect.NewUnaryHandler(
 		PortfolioServiceCreatePortfolioTransactionProcedure,
 		svc.CreatePortfolioTransaction,
@@ -445,18 +1108,95 @@ func NewPortfolioServiceHandler(svc PortfolioServiceHandler, opts ...connect.Han
 		connect.WithSchema(portfolioServiceDeletePortfolioTransactionMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	portfolioServiceBatchCreatePortfolioTransactionsHandler := connect.NewUnaryHandler(
+		PortfolioServiceBatchCreatePortfolioTransactionsProcedure,
+		svc.BatchCreatePortfolioTransactions,
+		connect.WithSchema(portfolioServiceBatchCreatePortfolioTransactionsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	portfolioServiceBatchUpdatePortfolioTransactionsHandler := connect.NewUnaryHandler(
+		PortfolioServiceBatchUpdatePortfolioTransactionsProcedure,
+		svc.BatchUpdatePortfolioTransactions,
+		connect.WithSchema(portfolioServiceBatchUpdatePortfolioTransactionsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	portfolioServiceBatchDeletePortfolioTransactionsHandler := connect.NewUnaryHandler(
+		PortfolioServiceBatchDeletePortfolioTransactionsProcedure,
+		svc.BatchDeletePortfolioTransactions,
+		connect.WithSchema(portfolioServiceBatchDeletePortfolioTransactionsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	portfolioServiceImportTransactionsHandler := connect.NewUnaryHandler(
 		PortfolioServiceImportTransactionsProcedure,
 		svc.ImportTransactions,
 		connect.WithSchema(portfolioServiceImportTransactionsMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	portfolioServiceConfirmPortfolioTransactionHandler := connect.NewUnaryHandler(
+		PortfolioServiceConfirmPortfolioTransactionProcedure,
+		svc.ConfirmPortfolioTransaction,
+		connect.WithSchema(portfolioServiceConfirmPortfolioTransactionMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	portfolioServiceUploadAttachmentHandler := connect.NewClientStreamHandler(
+		PortfolioServiceUploadAttachmentProcedure,
+		svc.UploadAttachment,
+		connect.WithSchema(portfolioServiceUploadAttachmentMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	portfolioServiceDownloadAttachmentHandler := connect.NewServerStreamHandler(
+		PortfolioServiceDownloadAttachmentProcedure,
+		svc.DownloadAttachment,
+		connect.WithSchema(portfolioServiceDownloadAttachmentMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	portfolioServiceListAttachmentsHandler := connect.NewUnaryHandler(
+		PortfolioServiceListAttachmentsProcedure,
+		svc.ListAttachments,
+		connect.WithSchema(portfolioServiceListAttachmentsMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	portfolioServiceDeleteAttachmentHandler := connect.NewUnaryHandler(
+		PortfolioServiceDeleteAttachmentProcedure,
+		svc.DeleteAttachment,
+		connect.WithSchema(portfolioServiceDeleteAttachmentMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	portfolioServiceCreatePortfolioTransferHandler := connect.NewUnaryHandler(
+		PortfolioServiceCreatePortfolioTransferProcedure,
+		svc.CreatePortfolioTransfer,
+		connect.WithSchema(portfolioServiceCreatePortfolioTransferMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	portfolioServiceCreateBankAccountHandler := connect.NewUnaryHandler(
 		PortfolioServiceCreateBankAccountProcedure,
 		svc.CreateBankAccount,
 		connect.WithSchema(portfolioServiceCreateBankAccountMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	portfolioServiceListBankAccountsHandler := connect.NewUnaryHandler(
+		PortfolioServiceListBankAccountsProcedure,
+		svc.ListBankAccounts,
+		connect.WithSchema(portfolioServiceListBankAccountsMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	portfolioServiceGetBankAccountHandler := connect.NewUnaryHandler(
+		PortfolioServiceGetBankAccountProcedure,
+		svc.GetBankAccount,
+		connect.WithSchema(portfolioServiceGetBankAccountMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	portfolioServiceGetCashLedgerHandler := connect.NewUnaryHandler(
+		PortfolioServiceGetCashLedgerProcedure,
+		svc.GetCashLedger,
+		connect.WithSchema(portfolioServiceGetCashLedgerMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
 	portfolioServiceUpdateBankAccountHandler := connect.NewUnaryHandler(
 		PortfolioServiceUpdateBankAccountProcedure,
 		svc.UpdateBankAccount,
@@ -469,6 +1209,102 @@ func NewPortfolioServiceHandler(svc PortfolioServiceHandler, opts ...connect.Han
 		connect.WithSchema(portfolioServiceDeleteBankAccountMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	portfolioServiceCreateWatchlistHandler := connect.NewUnaryHandler(
+		PortfolioServiceCreateWatchlistProcedure,
+		svc.CreateWatchlist,
+		connect.WithSchema(portfolioServiceCreateWatchlistMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	portfolioServiceListWatchlistsHandler := connect.NewUnaryHandler(
+		PortfolioServiceListWatchlistsProcedure,
+		svc.ListWatchlists,
+		connect.WithSchema(portfolioServiceListWatchlistsMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	portfolioServiceGetWatchlistHandler := connect.NewUnaryHandler(
+		PortfolioServiceGetWatchlistProcedure,
+		svc.GetWatchlist,
+		connect.WithSchema(portfolioServiceGetWatchlistMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	portfolioServiceUpdateWatchlistHandler := connect.NewUnaryHandler(
+		PortfolioServiceUpdateWatchlistProcedure,
+		svc.UpdateWatchlist,
+		connect.WithSchema(portfolioServiceUpdateWatchlistMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	portfolioServiceDeleteWatchlistHandler := connect.NewUnaryHandler(
+		PortfolioServiceDeleteWatchlistProcedure,
+		svc.DeleteWatchlist,
+		connect.WithSchema(portfolioServiceDeleteWatchlistMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	portfolioServiceCreateSavingsPlanHandler := connect.NewUnaryHandler(
+		PortfolioServiceCreateSavingsPlanProcedure,
+		svc.CreateSavingsPlan,
+		connect.WithSchema(portfolioServiceCreateSavingsPlanMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	portfolioServiceListSavingsPlansHandler := connect.NewUnaryHandler(
+		PortfolioServiceListSavingsPlansProcedure,
+		svc.ListSavingsPlans,
+		connect.WithSchema(portfolioServiceListSavingsPlansMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	portfolioServiceGetSavingsPlanHandler := connect.NewUnaryHandler(
+		PortfolioServiceGetSavingsPlanProcedure,
+		svc.GetSavingsPlan,
+		connect.WithSchema(portfolioServiceGetSavingsPlanMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	portfolioServiceUpdateSavingsPlanHandler := connect.NewUnaryHandler(
+		PortfolioServiceUpdateSavingsPlanProcedure,
+		svc.UpdateSavingsPlan,
+		connect.WithSchema(portfolioServiceUpdateSavingsPlanMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	portfolioServiceDeleteSavingsPlanHandler := connect.NewUnaryHandler(
+		PortfolioServiceDeleteSavingsPlanProcedure,
+		svc.DeleteSavingsPlan,
+		connect.WithSchema(portfolioServiceDeleteSavingsPlanMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	portfolioServiceSharePortfolioHandler := connect.NewUnaryHandler(
+		PortfolioServiceSharePortfolioProcedure,
+		svc.SharePortfolio,
+		connect.WithSchema(portfolioServiceSharePortfolioMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	portfolioServiceUnsharePortfolioHandler := connect.NewUnaryHandler(
+		PortfolioServiceUnsharePortfolioProcedure,
+		svc.UnsharePortfolio,
+		connect.WithSchema(portfolioServiceUnsharePortfolioMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	portfolioServiceListPortfolioSharesHandler := connect.NewUnaryHandler(
+		PortfolioServiceListPortfolioSharesProcedure,
+		svc.ListPortfolioShares,
+		connect.WithSchema(portfolioServiceListPortfolioSharesMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	portfolioServiceListTrashHandler := connect.NewUnaryHandler(
+		PortfolioServiceListTrashProcedure,
+		svc.ListTrash,
+		connect.WithSchema(portfolioServiceListTrashMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	portfolioServiceRestoreFromTrashHandler := connect.NewUnaryHandler(
+		PortfolioServiceRestoreFromTrashProcedure,
+		svc.RestoreFromTrash,
+		connect.WithSchema(portfolioServiceRestoreFromTrashMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/mgo.portfolio.v1.PortfolioService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case PortfolioServiceCreatePortfolioProcedure:
@@ -483,6 +1319,10 @@ func NewPortfolioServiceHandler(svc PortfolioServiceHandler, opts ...connect.Han
 			portfolioServiceDeletePortfolioHandler.ServeHTTP(w, r)
 		case PortfolioServiceGetPortfolioSnapshotProcedure:
 			portfolioServiceGetPortfolioSnapshotHandler.ServeHTTP(w, r)
+		case PortfolioServiceWatchPortfolioSnapshotProcedure:
+			portfolioServiceWatchPortfolioSnapshotHandler.ServeHTTP(w, r)
+		case PortfolioServiceGetConsolidatedSnapshotProcedure:
+			portfolioServiceGetConsolidatedSnapshotHandler.ServeHTTP(w, r)
 		case PortfolioServiceCreatePortfolioTransactionProcedure:
 			portfolioServiceCreatePortfolioTransactionHandler.ServeHTTP(w, r)
 		case PortfolioServiceGetPortfolioTransactionProcedure:
@@ -493,14 +1333,68 @@ func NewPortfolioServiceHandler(svc PortfolioServiceHandler, opts ...connect.Han
 			portfolioServiceUpdatePortfolioTransactionHandler.ServeHTTP(w, r)
 		case PortfolioServiceDeletePortfolioTransactionProcedure:
 			portfolioServiceDeletePortfolioTransactionHandler.ServeHTTP(w, r)
+		case PortfolioServiceBatchCreatePortfolioTransactionsProcedure:
+			portfolioServiceBatchCreatePortfolioTransactionsHandler.ServeHTTP(w, r)
+		case PortfolioServiceBatchUpdatePortfolioTransactionsProcedure:
+			portfolioServiceBatchUpdatePortfolioTransactionsHandler.ServeHTTP(w, r)
+		case PortfolioServiceBatchDeletePortfolioTransactionsProcedure:
+			portfolioServiceBatchDeletePortfolioTransactionsHandler.ServeHTTP(w, r)
 		case PortfolioServiceImportTransactionsProcedure:
 			portfolioServiceImportTransactionsHandler.ServeHTTP(w, r)
+		case PortfolioServiceConfirmPortfolioTransactionProcedure:
+			portfolioServiceConfirmPortfolioTransactionHandler.ServeHTTP(w, r)
+		case PortfolioServiceUploadAttachmentProcedure:
+			portfolioServiceUploadAttachmentHandler.ServeHTTP(w, r)
+		case PortfolioServiceDownloadAttachmentProcedure:
+			portfolioServiceDownloadAttachmentHandler.ServeHTTP(w, r)
+		case PortfolioServiceListAttachmentsProcedure:
+			portfolioServiceListAttachmentsHandler.ServeHTTP(w, r)
+		case PortfolioServiceDeleteAttachmentProcedure:
+			portfolioServiceDeleteAttachmentHandler.ServeHTTP(w, r)
+		case PortfolioServiceCreatePortfolioTransferProcedure:
+			portfolioServiceCreatePortfolioTransferHandler.ServeHTTP(w, r)
 		case PortfolioServiceCreateBankAccountProcedure:
 			portfolioServiceCreateBankAccountHandler.ServeHTTP(w, r)
+		case PortfolioServiceListBankAccountsProcedure:
+			portfolioServiceListBankAccountsHandler.ServeHTTP(w, r)
+		case PortfolioServiceGetBankAccountProcedure:
+			portfolioServiceGetBankAccountHandler.ServeHTTP(w, r)
+		case PortfolioServiceGetCashLedgerProcedure:
+			portfolioServiceGetCashLedgerHandler.ServeHTTP(w, r)
 		case PortfolioServiceUpdateBankAccountProcedure:
 			portfolioServiceUpdateBankAccountHandler.ServeHTTP(w, r)
 		case PortfolioServiceDeleteBankAccountProcedure:
 			portfolioServiceDeleteBankAccountHandler.ServeHTTP(w, r)
+		case PortfolioServiceCreateWatchlistProcedure:
+			portfolioServiceCreateWatchlistHandler.ServeHTTP(w, r)
+		case PortfolioServiceListWatchlistsProcedure:
+			portfolioServiceListWatchlistsHandler.ServeHTTP(w, r)
+		case PortfolioServiceGetWatchlistProcedure:
+			portfolioServiceGetWatchlistHandler.ServeHTTP(w, r)
+		case PortfolioServiceUpdateWatchlistProcedure:
+			portfolioServiceUpdateWatchlistHandler.ServeHTTP(w, r)
+		case PortfolioServiceDeleteWatchlistProcedure:
+			portfolioServiceDeleteWatchlistHandler.ServeHTTP(w, r)
+		case PortfolioServiceCreateSavingsPlanProcedure:
+			portfolioServiceCreateSavingsPlanHandler.ServeHTTP(w, r)
+		case PortfolioServiceListSavingsPlansProcedure:
+			portfolioServiceListSavingsPlansHandler.ServeHTTP(w, r)
+		case PortfolioServiceGetSavingsPlanProcedure:
+			portfolioServiceGetSavingsPlanHandler.ServeHTTP(w, r)
+		case PortfolioServiceUpdateSavingsPlanProcedure:
+			portfolioServiceUpdateSavingsPlanHandler.ServeHTTP(w, r)
+		case PortfolioServiceDeleteSavingsPlanProcedure:
+			portfolioServiceDeleteSavingsPlanHandler.ServeHTTP(w, r)
+		case PortfolioServiceSharePortfolioProcedure:
+			portfolioServiceSharePortfolioHandler.ServeHTTP(w, r)
+		case PortfolioServiceUnsharePortfolioProcedure:
+			portfolioServiceUnsharePortfolioHandler.ServeHTTP(w, r)
+		case PortfolioServiceListPortfolioSharesProcedure:
+			portfolioServiceListPortfolioSharesHandler.ServeHTTP(w, r)
+		case PortfolioServiceListTrashProcedure:
+			portfolioServiceListTrashHandler.ServeHTTP(w, r)
+		case PortfolioServiceRestoreFromTrashProcedure:
+			portfolioServiceRestoreFromTrashHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -534,6 +1428,14 @@ func (UnimplementedPortfolioServiceHandler) GetPortfolioSnapshot(context.Context
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgo.portfolio.v1.PortfolioService.GetPortfolioSnapshot is not implemented"))
 }
 
+func (UnimplementedPortfolioServiceHandler) WatchPortfolioSnapshot(context.Context, *connect.Request[gen.WatchPortfolioSnapshotRequest], *connect.ServerStream[gen.PortfolioSnapshot]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("mgo.portfolio.v1.PortfolioService.WatchPortfolioSnapshot is not implemented"))
+}
+
+func (UnimplementedPortfolioServiceHandler) GetConsolidatedSnapshot(context.Context, *connect.Request[gen.GetConsolidatedSnapshotRequest]) (*connect.Response[gen.ConsolidatedSnapshot], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgo.portfolio.v1.PortfolioService.GetConsolidatedSnapshot is not implemented"))
+}
+
 func (UnimplementedPortfolioServiceHandler) CreatePortfolioTransaction(context.Context, *connect.Request[gen.CreatePortfolioTransactionRequest]) (*connect.Response[gen.PortfolioEvent], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgo.portfolio.v1.PortfolioService.CreatePortfolioTransaction is not implemented"))
 }
@@ -554,14 +1456,62 @@ func (UnimplementedPortfolioServiceHandler) DeletePortfolioTransaction(context.C
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgo.portfolio.v1.PortfolioService.DeletePortfolioTransaction is not implemented"))
 }
 
+func (UnimplementedPortfolioServiceHandler) BatchCreatePortfolioTransactions(context.Context, *connect.Request[gen.BatchCreatePortfolioTransactionsRequest]) (*connect.Response[gen.BatchCreatePortfolioTransactionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgo.portfolio.v1.PortfolioService.BatchCreatePortfolioTransactions is not implemented"))
+}
+
+func (UnimplementedPortfolioServiceHandler) BatchUpdatePortfolioTransactions(context.Context, *connect.Request[gen.BatchUpdatePortfolioTransactionsRequest]) (*connect.Response[gen.BatchUpdatePortfolioTransactionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgo.portfolio.v1.PortfolioService.BatchUpdatePortfolioTransactions is not implemented"))
+}
+
+func (UnimplementedPortfolioServiceHandler) BatchDeletePortfolioTransactions(context.Context, *connect.Request[gen.BatchDeletePortfolioTransactionsRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgo.portfolio.v1.PortfolioService.BatchDeletePortfolioTransactions is not implemented"))
+}
+
 func (UnimplementedPortfolioServiceHandler) ImportTransactions(context.Context, *connect.Request[gen.ImportTransactionsRequest]) (*connect.Response[emptypb.Empty], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgo.portfolio.v1.PortfolioService.ImportTransactions is not implemented"))
 }
 
+func (UnimplementedPortfolioServiceHandler) ConfirmPortfolioTransaction(context.Context, *connect.Request[gen.ConfirmPortfolioTransactionRequest]) (*connect.Response[gen.PortfolioEvent], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgo.portfolio.v1.PortfolioService.ConfirmPortfolioTransaction is not implemented"))
+}
+
+func (UnimplementedPortfolioServiceHandler) UploadAttachment(context.Context, *connect.ClientStream[gen.UploadAttachmentRequest]) (*connect.Response[gen.Attachment], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgo.portfolio.v1.PortfolioService.UploadAttachment is not implemented"))
+}
+
+func (UnimplementedPortfolioServiceHandler) DownloadAttachment(context.Context, *connect.Request[gen.DownloadAttachmentRequest], *connect.ServerStream[gen.DownloadAttachmentResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("mgo.portfolio.v1.PortfolioService.DownloadAttachment is not implemented"))
+}
+
+func (UnimplementedPortfolioServiceHandler) ListAttachments(context.Context, *connect.Request[gen.ListAttachmentsRequest]) (*connect.Response[gen.ListAttachmentsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgo.portfolio.v1.PortfolioService.ListAttachments is not implemented"))
+}
+
+func (UnimplementedPortfolioServiceHandler) DeleteAttachment(context.Context, *connect.Request[gen.DeleteAttachmentRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgo.portfolio.v1.PortfolioService.DeleteAttachment is not implemented"))
+}
+
+func (UnimplementedPortfolioServiceHandler) CreatePortfolioTransfer(context.Context, *connect.Request[gen.CreatePortfolioTransferRequest]) (*connect.Response[gen.PortfolioTransfer], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgo.portfolio.v1.PortfolioService.CreatePortfolioTransfer is not implemented"))
+}
+
 func (UnimplementedPortfolioServiceHandler) CreateBankAccount(context.Context, *connect.Request[gen.CreateBankAccountRequest]) (*connect.Response[gen.BankAccount], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgo.portfolio.v1.PortfolioService.CreateBankAccount is not implemented"))
 }
 
+func (UnimplementedPortfolioServiceHandler) ListBankAccounts(context.Context, *connect.Request[gen.ListBankAccountsRequest]) (*connect.Response[gen.ListBankAccountsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgo.portfolio.v1.PortfolioService.ListBankAccounts is not implemented"))
+}
+
+func (UnimplementedPortfolioServiceHandler) GetBankAccount(context.Context, *connect.Request[gen.GetBankAccountRequest]) (*connect.Response[gen.BankAccount], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgo.portfolio.v1.PortfolioService.GetBankAccount is not implemented"))
+}
+
+func (UnimplementedPortfolioServiceHandler) GetCashLedger(context.Context, *connect.Request[gen.GetCashLedgerRequest]) (*connect.Response[gen.CashLedger], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgo.portfolio.v1.PortfolioService.GetCashLedger is not implemented"))
+}
+
 func (UnimplementedPortfolioServiceHandler) UpdateBankAccount(context.Context, *connect.Request[gen.UpdateBankAccountRequest]) (*connect.Response[gen.BankAccount], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgo.portfolio.v1.PortfolioService.UpdateBankAccount is not implemented"))
 }
@@ -570,12 +1520,73 @@ func (UnimplementedPortfolioServiceHandler) DeleteBankAccount(context.Context, *
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgo.portfolio.v1.PortfolioService.DeleteBankAccount is not implemented"))
 }
 
+func (UnimplementedPortfolioServiceHandler) CreateWatchlist(context.Context, *connect.Request[gen.CreateWatchlistRequest]) (*connect.Response[gen.Watchlist], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgo.portfolio.v1.PortfolioService.CreateWatchlist is not implemented"))
+}
+
+func (UnimplementedPortfolioServiceHandler) ListWatchlists(context.Context, *connect.Request[gen.ListWatchlistsRequest]) (*connect.Response[gen.ListWatchlistsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgo.portfolio.v1.PortfolioService.ListWatchlists is not implemented"))
+}
+
+func (UnimplementedPortfolioServiceHandler) GetWatchlist(context.Context, *connect.Request[gen.GetWatchlistRequest]) (*connect.Response[gen.Watchlist], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgo.portfolio.v1.PortfolioService.GetWatchlist is not implemented"))
+}
+
+func (UnimplementedPortfolioServiceHandler) UpdateWatchlist(context.Context, *connect.Request[gen.UpdateWatchlistRequest]) (*connect.Response[gen.Watchlist], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgo.portfolio.v1.PortfolioService.UpdateWatchlist is not implemented"))
+}
+
+func (UnimplementedPortfolioServiceHandler) DeleteWatchlist(context.Context, *connect.Request[gen.DeleteWatchlistRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgo.portfolio.v1.PortfolioService.DeleteWatchlist is not implemented"))
+}
+
+func (UnimplementedPortfolioServiceHandler) CreateSavingsPlan(context.Context, *connect.Request[gen.CreateSavingsPlanRequest]) (*connect.Response[gen.SavingsPlan], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgo.portfolio.v1.PortfolioService.CreateSavingsPlan is not implemented"))
+}
+
+func (UnimplementedPortfolioServiceHandler) ListSavingsPlans(context.Context, *connect.Request[gen.ListSavingsPlansRequest]) (*connect.Response[gen.ListSavingsPlansResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgo.portfolio.v1.PortfolioService.ListSavingsPlans is not implemented"))
+}
+
+func (UnimplementedPortfolioServiceHandler) GetSavingsPlan(context.Context, *connect.Request[gen.GetSavingsPlanRequest]) (*connect.Response[gen.SavingsPlan], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgo.portfolio.v1.PortfolioService.GetSavingsPlan is not implemented"))
+}
+
+func (UnimplementedPortfolioServiceHandler) UpdateSavingsPlan(context.Context, *connect.Request[gen.UpdateSavingsPlanRequest]) (*connect.Response[gen.SavingsPlan], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgo.portfolio.v1.PortfolioService.UpdateSavingsPlan is not implemented"))
+}
+
+func (UnimplementedPortfolioServiceHandler) DeleteSavingsPlan(context.Context, *connect.Request[gen.DeleteSavingsPlanRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgo.portfolio.v1.PortfolioService.DeleteSavingsPlan is not implemented"))
+}
+
+func (UnimplementedPortfolioServiceHandler) SharePortfolio(context.Context, *connect.Request[gen.SharePortfolioRequest]) (*connect.Response[gen.PortfolioShare], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgo.portfolio.v1.PortfolioService.SharePortfolio is not implemented"))
+}
+
+func (UnimplementedPortfolioServiceHandler) UnsharePortfolio(context.Context, *connect.Request[gen.UnsharePortfolioRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgo.portfolio.v1.PortfolioService.UnsharePortfolio is not implemented"))
+}
+
+func (UnimplementedPortfolioServiceHandler) ListPortfolioShares(context.Context, *connect.Request[gen.ListPortfolioSharesRequest]) (*connect.Response[gen.ListPortfolioSharesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgo.portfolio.v1.PortfolioService.ListPortfolioShares is not implemented"))
+}
+
+func (UnimplementedPortfolioServiceHandler) ListTrash(context.Context, *connect.Request[gen.ListTrashRequest]) (*connect.Response[gen.ListTrashResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgo.portfolio.v1.PortfolioService.ListTrash is not implemented"))
+}
+
+func (UnimplementedPortfolioServiceHandler) RestoreFromTrash(context.Context, *connect.Request[gen.RestoreFromTrashRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgo.portfolio.v1.PortfolioService.RestoreFromTrash is not implemented"))
+}
+
 // SecuritiesServiceClient is a client for the mgo.portfolio.v1.SecuritiesService service.
 type SecuritiesServiceClient interface {
 	ListSecurities(context.Context, *connect.Request[gen.ListSecuritiesRequest]) (*connect.Response[gen.ListSecuritiesResponse], error)
 	GetSecurity(context.Context, *connect.Request[gen.GetSecurityRequest]) (*connect.Response[gen.Security], error)
 	CreateSecurity(context.Context, *connect.Request[gen.CreateSecurityRequest]) (*connect.Response[gen.Security], error)
 	UpdateSecurity(context.Context, *connect.Request[gen.UpdateSecurityRequest]) (*connect.Response[gen.Security], error)
+	// DeleteSecurity moves a security to the trash.
 	DeleteSecurity(context.Context, *connect.Request[gen.DeleteSecurityRequest]) (*connect.Response[emptypb.Empty], error)
 	TriggerSecurityQuoteUpdate(context.Context, *connect.Request[gen.TriggerQuoteUpdateRequest]) (*connect.Response[gen.TriggerQuoteUpdateResponse], error)
 }
@@ -677,6 +1688,7 @@ type SecuritiesServiceHandler interface {
 	GetSecurity(context.Context, *connect.Request[gen.GetSecurityRequest]) (*connect.Response[gen.Security], error)
 	CreateSecurity(context.Context, *connect.Request[gen.CreateSecurityRequest]) (*connect.Response[gen.Security], error)
 	UpdateSecurity(context.Context, *connect.Request[gen.UpdateSecurityRequest]) (*connect.Response[gen.Security], error)
+	// DeleteSecurity moves a security to the trash.
 	DeleteSecurity(context.Context, *connect.Request[gen.DeleteSecurityRequest]) (*connect.Response[emptypb.Empty], error)
 	TriggerSecurityQuoteUpdate(context.Context, *connect.Request[gen.TriggerQuoteUpdateRequest]) (*connect.Response[gen.TriggerQuoteUpdateResponse], error)
 }
@@ -771,3 +1783,467 @@ func (UnimplementedSecuritiesServiceHandler) DeleteSecurity(context.Context, *co
 func (UnimplementedSecuritiesServiceHandler) TriggerSecurityQuoteUpdate(context.Context, *connect.Request[gen.TriggerQuoteUpdateRequest]) (*connect.Response[gen.TriggerQuoteUpdateResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgo.portfolio.v1.SecuritiesService.TriggerSecurityQuoteUpdate is not implemented"))
 }
+
+// AdminServiceClient is a client for the mgo.portfolio.v1.AdminService service.
+type AdminServiceClient interface {
+	CreateBackup(context.Context, *connect.Request[gen.CreateBackupRequest]) (*connect.Response[gen.CreateBackupResponse], error)
+	ExportDump(context.Context, *connect.Request[gen.ExportDumpRequest]) (*connect.Response[gen.Dump], error)
+	RestoreDump(context.Context, *connect.Request[gen.RestoreDumpRequest]) (*connect.Response[emptypb.Empty], error)
+}
+
+// NewAdminServiceClient constructs a client for the mgo.portfolio.v1.AdminService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewAdminServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) AdminServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &adminServiceClient{
+		createBackup: connect.NewClient[gen.CreateBackupRequest, gen.CreateBackupResponse](
+			httpClient,
+			baseURL+AdminServiceCreateBackupProcedure,
+			connect.WithSchema(adminServiceCreateBackupMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		exportDump: connect.NewClient[gen.ExportDumpRequest, gen.Dump](
+			httpClient,
+			baseURL+AdminServiceExportDumpProcedure,
+			connect.WithSchema(adminServiceExportDumpMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		restoreDump: connect.NewClient[gen.RestoreDumpRequest, emptypb.Empty](
+			httpClient,
+			baseURL+AdminServiceRestoreDumpProcedure,
+			connect.WithSchema(adminServiceRestoreDumpMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// adminServiceClient implements AdminServiceClient.
+type adminServiceClient struct {
+	createBackup *connect.Client[gen.CreateBackupRequest, gen.CreateBackupResponse]
+	exportDump   *connect.Client[gen.ExportDumpRequest, gen.Dump]
+	restoreDump  *connect.Client[gen.RestoreDumpRequest, emptypb.Empty]
+}
+
+// CreateBackup calls mgo.portfolio.v1.AdminService.CreateBackup.
+func (c *adminServiceClient) CreateBackup(ctx context.Context, req *connect.Request[gen.CreateBackupRequest]) (*connect.Response[gen.CreateBackupResponse], error) {
+	return c.createBackup.CallUnary(ctx, req)
+}
+
+// ExportDump calls mgo.portfolio.v1.AdminService.ExportDump.
+func (c *adminServiceClient) ExportDump(ctx context.Context, req *connect.Request[gen.ExportDumpRequest]) (*connect.Response[gen.Dump], error) {
+	return c.exportDump.CallUnary(ctx, req)
+}
+
+// RestoreDump calls mgo.portfolio.v1.AdminService.RestoreDump.
+func (c *adminServiceClient) RestoreDump(ctx context.Context, req *connect.Request[gen.RestoreDumpRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.restoreDump.CallUnary(ctx, req)
+}
+
+// AdminServiceHandler is an implementation of the mgo.portfolio.v1.AdminService service.
+type AdminServiceHandler interface {
+	CreateBackup(context.Context, *connect.Request[gen.CreateBackupRequest]) (*connect.Response[gen.CreateBackupResponse], error)
+	ExportDump(context.Context, *connect.Request[gen.ExportDumpRequest]) (*connect.Response[gen.Dump], error)
+	RestoreDump(context.Context, *connect.Request[gen.RestoreDumpRequest]) (*connect.Response[emptypb.Empty], error)
+}
+
+// NewAdminServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewAdminServiceHandler(svc AdminServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	adminServiceCreateBackupHandler := connect.NewUnaryHandler(
+		AdminServiceCreateBackupProcedure,
+		svc.CreateBackup,
+		connect.WithSchema(adminServiceCreateBackupMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceExportDumpHandler := connect.NewUnaryHandler(
+		AdminServiceExportDumpProcedure,
+		svc.ExportDump,
+		connect.WithSchema(adminServiceExportDumpMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceRestoreDumpHandler := connect.NewUnaryHandler(
+		AdminServiceRestoreDumpProcedure,
+		svc.RestoreDump,
+		connect.WithSchema(adminServiceRestoreDumpMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/mgo.portfolio.v1.AdminService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case AdminServiceCreateBackupProcedure:
+			adminServiceCreateBackupHandler.ServeHTTP(w, r)
+		case AdminServiceExportDumpProcedure:
+			adminServiceExportDumpHandler.ServeHTTP(w, r)
+		case AdminServiceRestoreDumpProcedure:
+			adminServiceRestoreDumpHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedAdminServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedAdminServiceHandler struct{}
+
+func (UnimplementedAdminServiceHandler) CreateBackup(context.Context, *connect.Request[gen.CreateBackupRequest]) (*connect.Response[gen.CreateBackupResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgo.portfolio.v1.AdminService.CreateBackup is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ExportDump(context.Context, *connect.Request[gen.ExportDumpRequest]) (*connect.Response[gen.Dump], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgo.portfolio.v1.AdminService.ExportDump is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) RestoreDump(context.Context, *connect.Request[gen.RestoreDumpRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgo.portfolio.v1.AdminService.RestoreDump is not implemented"))
+}
+
+// TokenServiceClient is a client for the mgo.portfolio.v1.TokenService service.
+type TokenServiceClient interface {
+	CreateAccessToken(context.Context, *connect.Request[gen.CreateAccessTokenRequest]) (*connect.Response[gen.CreateAccessTokenResponse], error)
+	ListAccessTokens(context.Context, *connect.Request[gen.ListAccessTokensRequest]) (*connect.Response[gen.ListAccessTokensResponse], error)
+	DeleteAccessToken(context.Context, *connect.Request[gen.DeleteAccessTokenRequest]) (*connect.Response[emptypb.Empty], error)
+}
+
+// NewTokenServiceClient constructs a client for the mgo.portfolio.v1.TokenService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewTokenServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) TokenServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &tokenServiceClient{
+		createAccessToken: connect.NewClient[gen.CreateAccessTokenRequest, gen.CreateAccessTokenResponse](
+			httpClient,
+			baseURL+TokenServiceCreateAccessTokenProcedure,
+			connect.WithSchema(tokenServiceCreateAccessTokenMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listAccessTokens: connect.NewClient[gen.ListAccessTokensRequest, gen.ListAccessTokensResponse](
+			httpClient,
+			baseURL+TokenServiceListAccessTokensProcedure,
+			connect.WithSchema(tokenServiceListAccessTokensMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		deleteAccessToken: connect.NewClient[gen.DeleteAccessTokenRequest, emptypb.Empty](
+			httpClient,
+			baseURL+TokenServiceDeleteAccessTokenProcedure,
+			connect.WithSchema(tokenServiceDeleteAccessTokenMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// tokenServiceClient implements TokenServiceClient.
+type tokenServiceClient struct {
+	createAccessToken *connect.Client[gen.CreateAccessTokenRequest, gen.CreateAccessTokenResponse]
+	listAccessTokens  *connect.Client[gen.ListAccessTokensRequest, gen.ListAccessTokensResponse]
+	deleteAccessToken *connect.Client[gen.DeleteAccessTokenRequest, emptypb.Empty]
+}
+
+// CreateAccessToken calls mgo.portfolio.v1.TokenService.CreateAccessToken.
+func (c *tokenServiceClient) CreateAccessToken(ctx context.Context, req *connect.Request[gen.CreateAccessTokenRequest]) (*connect.Response[gen.CreateAccessTokenResponse], error) {
+	return c.createAccessToken.CallUnary(ctx, req)
+}
+
+// ListAccessTokens calls mgo.portfolio.v1.TokenService.ListAccessTokens.
+func (c *tokenServiceClient) ListAccessTokens(ctx context.Context, req *connect.Request[gen.ListAccessTokensRequest]) (*connect.Response[gen.ListAccessTokensResponse], error) {
+	return c.listAccessTokens.CallUnary(ctx, req)
+}
+
+// DeleteAccessToken calls mgo.portfolio.v1.TokenService.DeleteAccessToken.
+func (c *tokenServiceClient) DeleteAccessToken(ctx context.Context, req *connect.Request[gen.DeleteAccessTokenRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.deleteAccessToken.CallUnary(ctx, req)
+}
+
+// TokenServiceHandler is an implementation of the mgo.portfolio.v1.TokenService service.
+type TokenServiceHandler interface {
+	CreateAccessToken(context.Context, *connect.Request[gen.CreateAccessTokenRequest]) (*connect.Response[gen.CreateAccessTokenResponse], error)
+	ListAccessTokens(context.Context, *connect.Request[gen.ListAccessTokensRequest]) (*connect.Response[gen.ListAccessTokensResponse], error)
+	DeleteAccessToken(context.Context, *connect.Request[gen.DeleteAccessTokenRequest]) (*connect.Response[emptypb.Empty], error)
+}
+
+// NewTokenServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewTokenServiceHandler(svc TokenServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	tokenServiceCreateAccessTokenHandler := connect.NewUnaryHandler(
+		TokenServiceCreateAccessTokenProcedure,
+		svc.CreateAccessToken,
+		connect.WithSchema(tokenServiceCreateAccessTokenMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	tokenServiceListAccessTokensHandler := connect.NewUnaryHandler(
+		TokenServiceListAccessTokensProcedure,
+		svc.ListAccessTokens,
+		connect.WithSchema(tokenServiceListAccessTokensMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	tokenServiceDeleteAccessTokenHandler := connect.NewUnaryHandler(
+		TokenServiceDeleteAccessTokenProcedure,
+		svc.DeleteAccessToken,
+		connect.WithSchema(tokenServiceDeleteAccessTokenMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/mgo.portfolio.v1.TokenService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case TokenServiceCreateAccessTokenProcedure:
+			tokenServiceCreateAccessTokenHandler.ServeHTTP(w, r)
+		case TokenServiceListAccessTokensProcedure:
+			tokenServiceListAccessTokensHandler.ServeHTTP(w, r)
+		case TokenServiceDeleteAccessTokenProcedure:
+			tokenServiceDeleteAccessTokenHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedTokenServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedTokenServiceHandler struct{}
+
+func (UnimplementedTokenServiceHandler) CreateAccessToken(context.Context, *connect.Request[gen.CreateAccessTokenRequest]) (*connect.Response[gen.CreateAccessTokenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgo.portfolio.v1.TokenService.CreateAccessToken is not implemented"))
+}
+
+func (UnimplementedTokenServiceHandler) ListAccessTokens(context.Context, *connect.Request[gen.ListAccessTokensRequest]) (*connect.Response[gen.ListAccessTokensResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgo.portfolio.v1.TokenService.ListAccessTokens is not implemented"))
+}
+
+func (UnimplementedTokenServiceHandler) DeleteAccessToken(context.Context, *connect.Request[gen.DeleteAccessTokenRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgo.portfolio.v1.TokenService.DeleteAccessToken is not implemented"))
+}
+
+// AuditServiceClient is a client for the mgo.portfolio.v1.AuditService service.
+type AuditServiceClient interface {
+	ListAuditEvents(context.Context, *connect.Request[gen.ListAuditEventsRequest]) (*connect.Response[gen.ListAuditEventsResponse], error)
+}
+
+// NewAuditServiceClient constructs a client for the mgo.portfolio.v1.AuditService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewAuditServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) AuditServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &auditServiceClient{
+		listAuditEvents: connect.NewClient[gen.ListAuditEventsRequest, gen.ListAuditEventsResponse](
+			httpClient,
+			baseURL+AuditServiceListAuditEventsProcedure,
+			connect.WithSchema(auditServiceListAuditEventsMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// auditServiceClient implements AuditServiceClient.
+type auditServiceClient struct {
+	listAuditEvents *connect.Client[gen.ListAuditEventsRequest, gen.ListAuditEventsResponse]
+}
+
+// ListAuditEvents calls mgo.portfolio.v1.AuditService.ListAuditEvents.
+func (c *auditServiceClient) ListAuditEvents(ctx context.Context, req *connect.Request[gen.ListAuditEventsRequest]) (*connect.Response[gen.ListAuditEventsResponse], error) {
+	return c.listAuditEvents.CallUnary(ctx, req)
+}
+
+// AuditServiceHandler is an implementation of the mgo.portfolio.v1.AuditService service.
+type AuditServiceHandler interface {
+	ListAuditEvents(context.Context, *connect.Request[gen.ListAuditEventsRequest]) (*connect.Response[gen.ListAuditEventsResponse], error)
+}
+
+// NewAuditServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewAuditServiceHandler(svc AuditServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	auditServiceListAuditEventsHandler := connect.NewUnaryHandler(
+		AuditServiceListAuditEventsProcedure,
+		svc.ListAuditEvents,
+		connect.WithSchema(auditServiceListAuditEventsMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/mgo.portfolio.v1.AuditService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case AuditServiceListAuditEventsProcedure:
+			auditServiceListAuditEventsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedAuditServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedAuditServiceHandler struct{}
+
+func (UnimplementedAuditServiceHandler) ListAuditEvents(context.Context, *connect.Request[gen.ListAuditEventsRequest]) (*connect.Response[gen.ListAuditEventsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgo.portfolio.v1.AuditService.ListAuditEvents is not implemented"))
+}
+
+// AlertServiceClient is a client for the mgo.portfolio.v1.AlertService service.
+type AlertServiceClient interface {
+	CreateAlertRule(context.Context, *connect.Request[gen.CreateAlertRuleRequest]) (*connect.Response[gen.AlertRule], error)
+	ListAlertRules(context.Context, *connect.Request[gen.ListAlertRulesRequest]) (*connect.Response[gen.ListAlertRulesResponse], error)
+	DeleteAlertRule(context.Context, *connect.Request[gen.DeleteAlertRuleRequest]) (*connect.Response[emptypb.Empty], error)
+	ListTriggeredAlerts(context.Context, *connect.Request[gen.ListTriggeredAlertsRequest]) (*connect.Response[gen.ListTriggeredAlertsResponse], error)
+}
+
+// NewAlertServiceClient constructs a client for the mgo.portfolio.v1.AlertService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewAlertServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) AlertServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &alertServiceClient{
+		createAlertRule: connect.NewClient[gen.CreateAlertRuleRequest, gen.AlertRule](
+			httpClient,
+			baseURL+AlertServiceCreateAlertRuleProcedure,
+			connect.WithSchema(alertServiceCreateAlertRuleMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listAlertRules: connect.NewClient[gen.ListAlertRulesRequest, gen.ListAlertRulesResponse](
+			httpClient,
+			baseURL+AlertServiceListAlertRulesProcedure,
+			connect.WithSchema(alertServiceListAlertRulesMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		deleteAlertRule: connect.NewClient[gen.DeleteAlertRuleRequest, emptypb.Empty](
+			httpClient,
+			baseURL+AlertServiceDeleteAlertRuleProcedure,
+			connect.WithSchema(alertServiceDeleteAlertRuleMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listTriggeredAlerts: connect.NewClient[gen.ListTriggeredAlertsRequest, gen.ListTriggeredAlertsResponse](
+			httpClient,
+			baseURL+AlertServiceListTriggeredAlertsProcedure,
+			connect.WithSchema(alertServiceListTriggeredAlertsMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// alertServiceClient implements AlertServiceClient.
+type alertServiceClient struct {
+	createAlertRule     *connect.Client[gen.CreateAlertRuleRequest, gen.AlertRule]
+	listAlertRules      *connect.Client[gen.ListAlertRulesRequest, gen.ListAlertRulesResponse]
+	deleteAlertRule     *connect.Client[gen.DeleteAlertRuleRequest, emptypb.Empty]
+	listTriggeredAlerts *connect.Client[gen.ListTriggeredAlertsRequest, gen.ListTriggeredAlertsResponse]
+}
+
+// CreateAlertRule calls mgo.portfolio.v1.AlertService.CreateAlertRule.
+func (c *alertServiceClient) CreateAlertRule(ctx context.Context, req *connect.Request[gen.CreateAlertRuleRequest]) (*connect.Response[gen.AlertRule], error) {
+	return c.createAlertRule.CallUnary(ctx, req)
+}
+
+// ListAlertRules calls mgo.portfolio.v1.AlertService.ListAlertRules.
+func (c *alertServiceClient) ListAlertRules(ctx context.Context, req *connect.Request[gen.ListAlertRulesRequest]) (*connect.Response[gen.ListAlertRulesResponse], error) {
+	return c.listAlertRules.CallUnary(ctx, req)
+}
+
+// DeleteAlertRule calls mgo.portfolio.v1.AlertService.DeleteAlertRule.
+func (c *alertServiceClient) DeleteAlertRule(ctx context.Context, req *connect.Request[gen.DeleteAlertRuleRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.deleteAlertRule.CallUnary(ctx, req)
+}
+
+// ListTriggeredAlerts calls mgo.portfolio.v1.AlertService.ListTriggeredAlerts.
+func (c *alertServiceClient) ListTriggeredAlerts(ctx context.Context, req *connect.Request[gen.ListTriggeredAlertsRequest]) (*connect.Response[gen.ListTriggeredAlertsResponse], error) {
+	return c.listTriggeredAlerts.CallUnary(ctx, req)
+}
+
+// AlertServiceHandler is an implementation of the mgo.portfolio.v1.AlertService service.
+type AlertServiceHandler interface {
+	CreateAlertRule(context.Context, *connect.Request[gen.CreateAlertRuleRequest]) (*connect.Response[gen.AlertRule], error)
+	ListAlertRules(context.Context, *connect.Request[gen.ListAlertRulesRequest]) (*connect.Response[gen.ListAlertRulesResponse], error)
+	DeleteAlertRule(context.Context, *connect.Request[gen.DeleteAlertRuleRequest]) (*connect.Response[emptypb.Empty], error)
+	ListTriggeredAlerts(context.Context, *connect.Request[gen.ListTriggeredAlertsRequest]) (*connect.Response[gen.ListTriggeredAlertsResponse], error)
+}
+
+// NewAlertServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewAlertServiceHandler(svc AlertServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	alertServiceCreateAlertRuleHandler := connect.NewUnaryHandler(
+		AlertServiceCreateAlertRuleProcedure,
+		svc.CreateAlertRule,
+		connect.WithSchema(alertServiceCreateAlertRuleMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	alertServiceListAlertRulesHandler := connect.NewUnaryHandler(
+		AlertServiceListAlertRulesProcedure,
+		svc.ListAlertRules,
+		connect.WithSchema(alertServiceListAlertRulesMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	alertServiceDeleteAlertRuleHandler := connect.NewUnaryHandler(
+		AlertServiceDeleteAlertRuleProcedure,
+		svc.DeleteAlertRule,
+		connect.WithSchema(alertServiceDeleteAlertRuleMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	alertServiceListTriggeredAlertsHandler := connect.NewUnaryHandler(
+		AlertServiceListTriggeredAlertsProcedure,
+		svc.ListTriggeredAlerts,
+		connect.WithSchema(alertServiceListTriggeredAlertsMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/mgo.portfolio.v1.AlertService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case AlertServiceCreateAlertRuleProcedure:
+			alertServiceCreateAlertRuleHandler.ServeHTTP(w, r)
+		case AlertServiceListAlertRulesProcedure:
+			alertServiceListAlertRulesHandler.ServeHTTP(w, r)
+		case AlertServiceDeleteAlertRuleProcedure:
+			alertServiceDeleteAlertRuleHandler.ServeHTTP(w, r)
+		case AlertServiceListTriggeredAlertsProcedure:
+			alertServiceListTriggeredAlertsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedAlertServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedAlertServiceHandler struct{}
+
+func (UnimplementedAlertServiceHandler) CreateAlertRule(context.Context, *connect.Request[gen.CreateAlertRuleRequest]) (*connect.Response[gen.AlertRule], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgo.portfolio.v1.AlertService.CreateAlertRule is not implemented"))
+}
+
+func (UnimplementedAlertServiceHandler) ListAlertRules(context.Context, *connect.Request[gen.ListAlertRulesRequest]) (*connect.Response[gen.ListAlertRulesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgo.portfolio.v1.AlertService.ListAlertRules is not implemented"))
+}
+
+func (UnimplementedAlertServiceHandler) DeleteAlertRule(context.Context, *connect.Request[gen.DeleteAlertRuleRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgo.portfolio.v1.AlertService.DeleteAlertRule is not implemented"))
+}
+
+func (UnimplementedAlertServiceHandler) ListTriggeredAlerts(context.Context, *connect.Request[gen.ListTriggeredAlertsRequest]) (*connect.Response[gen.ListTriggeredAlertsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgo.portfolio.v1.AlertService.ListTriggeredAlerts is not implemented"))
+}
@@ -26,13 +26,16 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-var _ persistence.StorageObject = &Security{}
+var _ persistence.QueryableObject = &Security{}
 
-func (*Security) InitTables(db *persistence.DB) (err error) {
+func (*Security) InitTables(db persistence.Preparer) (err error) {
 	_, err = db.Exec(`CREATE TABLE IF NOT EXISTS securities (
 id TEXT PRIMARY KEY,
 display_name TEXT NOT NULL,
-quote_provider TEXT
+quote_provider TEXT,
+delete_time DATETIME,
+note TEXT NOT NULL DEFAULT '',
+tags TEXT NOT NULL DEFAULT '[]'
 );`)
 	if err != nil {
 		return err
@@ -41,13 +44,14 @@ quote_provider TEXT
 	return
 }
 
-func (*ListedSecurity) InitTables(db *persistence.DB) (err error) {
+func (*ListedSecurity) InitTables(db persistence.Preparer) (err error) {
 	_, err = db.Exec(`CREATE TABLE IF NOT EXISTS listed_securities (
 security_id TEXT,
 ticker TEXT NOT NULL,
 currency TEXT NOT NULL,
 latest_quote INTEGER,
 latest_quote_timestamp DATETIME,
+previous_quote INTEGER,
 PRIMARY KEY (security_id, ticker)
 );`)
 	if err != nil {
@@ -57,31 +61,50 @@ PRIMARY KEY (security_id, ticker)
 	return
 }
 
-func (*Security) PrepareReplace(db *persistence.DB) (stmt *sql.Stmt, err error) {
-	return db.Prepare(`REPLACE INTO securities (id, display_name, quote_provider) VALUES (?,?,?);`)
+// PrepareReplace prepares a query that creates or replaces a security. In
+// contrast to REPLACE, the existing row is updated instead of deleted, so that
+// the foreign key of its listings is not violated.
+func (*Security) PrepareReplace(db persistence.Preparer) (stmt *sql.Stmt, err error) {
+	return db.Prepare(`INSERT INTO securities (id, display_name, quote_provider, note, tags) VALUES (?,?,?,?,?)
+ON CONFLICT (id) DO UPDATE SET
+display_name = excluded.display_name,
+quote_provider = excluded.quote_provider,
+note = excluded.note,
+tags = excluded.tags,
+delete_time = NULL;`)
 }
 
-func (*ListedSecurity) PrepareReplace(db *persistence.DB) (stmt *sql.Stmt, err error) {
-	return db.Prepare(`REPLACE INTO listed_securities (security_id, ticker, currency, latest_quote, latest_quote_timestamp) VALUES (?,?,?,?,?);`)
+func (*ListedSecurity) PrepareReplace(db persistence.Preparer) (stmt *sql.Stmt, err error) {
+	return db.Prepare(`REPLACE INTO listed_securities (security_id, ticker, currency, latest_quote, latest_quote_timestamp, previous_quote) VALUES (?,?,?,?,?,?);`)
 }
 
-func (*Security) PrepareList(db *persistence.DB) (stmt *sql.Stmt, err error) {
-	return db.Prepare(`SELECT id, display_name, quote_provider FROM securities`)
+func (s *Security) PrepareList(db persistence.Preparer) (stmt *sql.Stmt, err error) {
+	return db.Prepare(s.ListQuery().Query)
 }
 
-func (*ListedSecurity) PrepareList(db *persistence.DB) (stmt *sql.Stmt, err error) {
-	return db.Prepare(`SELECT security_id, ticker, currency, latest_quote, latest_quote_timestamp FROM listed_securities WHERE security_id = ?`)
+func (*ListedSecurity) PrepareList(db persistence.Preparer) (stmt *sql.Stmt, err error) {
+	return db.Prepare(`SELECT security_id, ticker, currency, latest_quote, latest_quote_timestamp, previous_quote FROM listed_securities WHERE security_id = ?`)
 }
 
-func (*Security) PrepareGet(db *persistence.DB) (stmt *sql.Stmt, err error) {
-	return db.Prepare(`SELECT id, display_name, quote_provider FROM securities WHERE id = ?`)
+// ListQuery returns the query of [Security.PrepareList], which can be
+// filtered by ID and tag and sorted by display name.
+func (*Security) ListQuery() persistence.ListQuery {
+	return persistence.ListQuery{
+		Query:   `SELECT id, display_name, quote_provider, note, tags FROM securities WHERE delete_time IS NULL`,
+		Columns: []string{"id", "display_name", "tags"},
+		Key:     []string{"id"},
+	}
+}
+
+func (*Security) PrepareGet(db persistence.Preparer) (stmt *sql.Stmt, err error) {
+	return db.Prepare(`SELECT id, display_name, quote_provider, note, tags FROM securities WHERE id = ? AND delete_time IS NULL`)
 }
 
-func (*ListedSecurity) PrepareGet(db *persistence.DB) (stmt *sql.Stmt, err error) {
-	return db.Prepare(`SELECT * FROM listed_securities WHERE security_id = ? AND ticker = ?`)
+func (*ListedSecurity) PrepareGet(db persistence.Preparer) (stmt *sql.Stmt, err error) {
+	return db.Prepare(`SELECT security_id, ticker, currency, latest_quote, latest_quote_timestamp, previous_quote FROM listed_securities WHERE security_id = ? AND ticker = ?`)
 }
 
-func (*Security) PrepareUpdate(db *persistence.DB, columns []string) (stmt *sql.Stmt, err error) {
+func (*Security) PrepareUpdate(db persistence.Preparer, columns []string) (stmt *sql.Stmt, err error) {
 	// We need to make sure to quote columns here because they are potentially evil user input
 	var (
 		query string
@@ -98,7 +121,7 @@ func (*Security) PrepareUpdate(db *persistence.DB, columns []string) (stmt *sql.
 	return db.Prepare(query)
 }
 
-func (*ListedSecurity) PrepareUpdate(db *persistence.DB, columns []string) (stmt *sql.Stmt, err error) {
+func (*ListedSecurity) PrepareUpdate(db persistence.Preparer, columns []string) (stmt *sql.Stmt, err error) {
 	// We need to make sure to quote columns here because they are potentially evil user input
 	var (
 		query string
@@ -115,16 +138,18 @@ func (*ListedSecurity) PrepareUpdate(db *persistence.DB, columns []string) (stmt
 	return db.Prepare(query)
 }
 
-func (*Security) PrepareDelete(db *persistence.DB) (stmt *sql.Stmt, err error) {
-	return db.Prepare(`DELETE FROM securities WHERE id = ?`)
+// PrepareDelete prepares a query that moves a security to the trash. It is
+// only purged from the database once its retention expired.
+func (*Security) PrepareDelete(db persistence.Preparer) (stmt *sql.Stmt, err error) {
+	return db.Prepare(`UPDATE securities SET delete_time = CURRENT_TIMESTAMP WHERE id = ? AND delete_time IS NULL`)
 }
 
-func (*ListedSecurity) PrepareDelete(db *persistence.DB) (stmt *sql.Stmt, err error) {
+func (*ListedSecurity) PrepareDelete(db persistence.Preparer) (stmt *sql.Stmt, err error) {
 	return db.Prepare(`DELETE FROM listed_securities WHERE security_id = ? AND ticker = ?`)
 }
 
 func (s *Security) ReplaceIntoArgs() []any {
-	return []any{s.Id, s.DisplayName, s.QuoteProvider}
+	return []any{s.Id, s.DisplayName, s.QuoteProvider, s.Note, marshalTags(s.Tags)}
 }
 
 func (l *ListedSecurity) ReplaceIntoArgs() []any {
@@ -144,7 +169,17 @@ func (l *ListedSecurity) ReplaceIntoArgs() []any {
 		value.Valid = true
 	}
 
-	return []any{l.SecurityId, l.Ticker, l.Currency, value, pt}
+	return []any{l.SecurityId, l.Ticker, l.Currency, value, pt, nullValue(l.PreviousQuote)}
+}
+
+// nullValue returns the value of c for the database, which is NULL if c is
+// not set.
+func nullValue(c *Currency) sql.NullInt32 {
+	if c == nil {
+		return sql.NullInt32{}
+	}
+
+	return sql.NullInt32{Int32: c.Value, Valid: true}
 }
 
 func (s *Security) UpdateArgs(columns []string) (args []any) {
@@ -156,6 +191,10 @@ func (s *Security) UpdateArgs(columns []string) (args []any) {
 			args = append(args, s.DisplayName)
 		case "quote_provider":
 			args = append(args, s.QuoteProvider)
+		case "note":
+			args = append(args, s.Note)
+		case "tags":
+			args = append(args, marshalTags(s.Tags))
 		}
 	}
 
@@ -179,6 +218,8 @@ func (l *ListedSecurity) UpdateArgs(columns []string) (args []any) {
 			} else {
 				args = append(args, nil)
 			}
+		case "previous_quote":
+			args = append(args, nullValue(l.PreviousQuote))
 		}
 	}
 
@@ -187,10 +228,16 @@ func (l *ListedSecurity) UpdateArgs(columns []string) (args []any) {
 
 func (*Security) Scan(sc persistence.Scanner) (obj persistence.StorageObject, err error) {
 	var (
-		s Security
+		s    Security
+		tags string
 	)
 
-	err = sc.Scan(&s.Id, &s.DisplayName, &s.QuoteProvider)
+	err = sc.Scan(&s.Id, &s.DisplayName, &s.QuoteProvider, &s.Note, &tags)
+	if err != nil {
+		return nil, err
+	}
+
+	s.Tags, err = unmarshalTags(tags)
 	if err != nil {
 		return nil, err
 	}
@@ -200,12 +247,13 @@ func (*Security) Scan(sc persistence.Scanner) (obj persistence.StorageObject, er
 
 func (*ListedSecurity) Scan(sc persistence.Scanner) (obj persistence.StorageObject, err error) {
 	var (
-		l     ListedSecurity
-		t     sql.NullTime
-		value sql.NullInt32
+		l        ListedSecurity
+		t        sql.NullTime
+		value    sql.NullInt32
+		previous sql.NullInt32
 	)
 
-	err = sc.Scan(&l.SecurityId, &l.Ticker, &l.Currency, &value, &t)
+	err = sc.Scan(&l.SecurityId, &l.Ticker, &l.Currency, &value, &t, &previous)
 	if err != nil {
 		return nil, err
 	}
@@ -219,5 +267,10 @@ func (*ListedSecurity) Scan(sc persistence.Scanner) (obj persistence.StorageObje
 		l.LatestQuote.Symbol = l.Currency
 	}
 
+	if previous.Valid {
+		l.PreviousQuote = Value(previous.Int32)
+		l.PreviousQuote.Symbol = l.Currency
+	}
+
 	return &l, nil
 }
@@ -41,6 +41,7 @@ import (
 
 	moneygopher "github.com/oxisto/money-gopher"
 	portfoliov1 "github.com/oxisto/money-gopher/gen"
+	"github.com/oxisto/money-gopher/metrics"
 
 	"github.com/lmittmann/tint"
 	"github.com/oxisto/money-gopher/service/securities"
@@ -74,9 +75,12 @@ func Import(r io.Reader, pname string) (txs []*portfoliov1.PortfolioEvent, secs
 		} else if err != nil {
 			// Skip this transaction
 			slog.Warn("Could not parse line", tint.Err(err))
+			metrics.ImportRows.WithLabelValues("skipped").Inc()
 			continue
 		}
 
+		metrics.ImportRows.WithLabelValues("imported").Inc()
+
 		txs = append(txs, tx)
 		secs = append(secs, sec)
 	}
@@ -158,6 +162,11 @@ func readLine(cr *csv.Reader, pname string) (tx *portfoliov1.PortfolioEvent, sec
 		sec.QuoteProvider = moneygopher.Ref(securities.QuoteProviderING)
 	}
 
+	// The note column was added later, so older exports do not have it
+	if len(record) > 14 {
+		tx.Note = record[14]
+	}
+
 	tx.PortfolioId = pname
 	tx.SecurityId = sec.Id
 	tx.MakeUniqueID()
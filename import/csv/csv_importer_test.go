@@ -97,13 +97,14 @@ func Test_readLine(t *testing.T) {
 			name: "buy",
 			args: args{
 				cr: func() *csv.Reader {
-					cr := csv.NewReader(bytes.NewReader([]byte(`2020-01-01T00:00;Buy;2.151,85;EUR;;;;10,25;0,00;20;US0378331005;865985;APC.F;Apple Inc.;`)))
+					cr := csv.NewReader(bytes.NewReader([]byte(`2020-01-01T00:00;Buy;2.151,85;EUR;;;;10,25;0,00;20;US0378331005;865985;APC.F;Apple Inc.;Long-term`)))
 					cr.Comma = ';'
 					return cr
 				}(),
 			},
 			wantTx: &portfoliov1.PortfolioEvent{
 				Id:         "9e7b470b7566beca",
+				Note:       "Long-term",
 				SecurityId: "US0378331005",
 				Type:       portfoliov1.PortfolioEventType_PORTFOLIO_EVENT_TYPE_BUY,
 				Time:       timestamppb.New(time.Date(2020, 1, 1, 0, 0, 0, 0, time.Local)),
@@ -189,6 +190,38 @@ func Test_readLine(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "sell without note column",
+			args: args{
+				cr: func() *csv.Reader {
+					cr := csv.NewReader(bytes.NewReader([]byte(`2022-01-01T08:00:06;Sell;-1.580,26;EUR;;;;0,00;18,30;103;DE0005557508;;DTE.F;Deutsche Telekom AG`)))
+					cr.Comma = ';'
+					return cr
+				}(),
+			},
+			wantTx: &portfoliov1.PortfolioEvent{
+				Id:         "8bb43fed65b35685",
+				SecurityId: "DE0005557508",
+				Type:       portfoliov1.PortfolioEventType_PORTFOLIO_EVENT_TYPE_SELL,
+				Time:       timestamppb.New(time.Date(2022, 1, 1, 8, 0, 6, 0, time.Local)),
+				Amount:     103,
+				Fees:       portfoliov1.Zero(),
+				Taxes:      portfoliov1.Value(1830),
+				Price:      portfoliov1.Value(1552),
+			},
+			wantSec: &portfoliov1.Security{
+				Id:            "DE0005557508",
+				DisplayName:   "Deutsche Telekom AG",
+				QuoteProvider: moneygopher.Ref(securities.QuoteProviderYF),
+				ListedOn: []*portfoliov1.ListedSecurity{
+					{
+						SecurityId: "DE0005557508",
+						Ticker:     "DTE.F",
+						Currency:   "EUR",
+					},
+				},
+			},
+		},
 		{
 			name: "type-error",
 			args: args{
@@ -0,0 +1,45 @@
+// Copyright 2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package internal
+
+import (
+	"context"
+
+	"github.com/oxisto/money-gopher/auth"
+)
+
+// TestUser is the subject of the user that is used in tests.
+const TestUser = "money"
+
+// WithTestUser returns a context that carries [TestUser] as an administrator,
+// unless ctx already carries a user. A nil ctx is treated as
+// [context.Background].
+func WithTestUser(ctx context.Context) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	if _, ok := auth.FromContext(ctx); ok {
+		return ctx
+	}
+
+	return auth.NewContext(ctx, &auth.User{
+		Subject: TestUser,
+		Name:    TestUser,
+		Roles:   []auth.Role{auth.RoleAdmin},
+	})
+}
@@ -17,6 +17,7 @@
 package internal
 
 import (
+	"context"
 	"testing"
 
 	"github.com/oxisto/money-gopher/persistence"
@@ -63,22 +64,26 @@ func ErrOps[T persistence.StorageObject](err error) persistence.StorageOperation
 	return &errorOp[T]{err: err}
 }
 
-func (e *errorOp[T]) Replace(o persistence.StorageObject) (err error) {
+func (e *errorOp[T]) Replace(_ context.Context, o persistence.StorageObject) (err error) {
 	return e.err
 }
 
-func (e *errorOp[T]) List(args ...any) (list []T, err error) {
+func (e *errorOp[T]) List(_ context.Context, args ...any) (list []T, err error) {
 	return nil, e.err
 }
 
-func (e *errorOp[T]) Get(key any) (obj T, err error) {
+func (e *errorOp[T]) ListWithOptions(_ context.Context, opts persistence.ListOptions, args ...any) (list []T, err error) {
+	return nil, e.err
+}
+
+func (e *errorOp[T]) Get(_ context.Context, key any) (obj T, err error) {
 	return obj, e.err
 }
 
-func (e *errorOp[T]) Update(key any, in T, columns []string) (out T, err error) {
+func (e *errorOp[T]) Update(_ context.Context, key any, in T, columns []string) (out T, err error) {
 	return out, e.err
 }
 
-func (e *errorOp[T]) Delete(key any) (err error) {
+func (e *errorOp[T]) Delete(_ context.Context, key any) (err error) {
 	return e.err
 }
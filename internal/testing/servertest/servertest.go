@@ -1,14 +1,20 @@
 package servertest
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
 
 	"github.com/oxisto/money-gopher/gen/portfoliov1connect"
+	"github.com/oxisto/money-gopher/internal"
 	"github.com/oxisto/money-gopher/persistence"
+	"github.com/oxisto/money-gopher/service/alerts"
+	"github.com/oxisto/money-gopher/service/audit"
 	"github.com/oxisto/money-gopher/service/portfolio"
 	"github.com/oxisto/money-gopher/service/securities"
+	"github.com/oxisto/money-gopher/service/tokens"
 
+	"connectrpc.com/connect"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
 )
@@ -17,13 +23,38 @@ func NewServer(db *persistence.DB) *httptest.Server {
 	mux := http.NewServeMux()
 	srv := httptest.NewServer(h2c.NewHandler(mux, &http2.Server{}))
 
-	mux.Handle(portfoliov1connect.NewPortfolioServiceHandler(portfolio.NewService(
-		portfolio.Options{
-			DB:               db,
-			SecuritiesClient: portfoliov1connect.NewSecuritiesServiceClient(srv.Client(), srv.URL),
-		},
-	)))
-	mux.Handle(portfoliov1connect.NewSecuritiesServiceHandler(securities.NewService(db)))
+	// All requests are authenticated as our test user
+	q := persistence.New(db)
+	interceptors := connect.WithInterceptors(&testUserInterceptor{}, audit.NewInterceptor(audit.NewRecorder(q)))
+
+	portfolios := portfolio.NewService(portfolio.Options{DB: db})
+	mux.Handle(portfoliov1connect.NewPortfolioServiceHandler(portfolios, interceptors))
+	mux.Handle(portfoliov1connect.NewSecuritiesServiceHandler(securities.NewService(db, nil), interceptors))
+	mux.Handle(portfoliov1connect.NewTokenServiceHandler(tokens.NewService(q), interceptors))
+	mux.Handle(portfoliov1connect.NewAuditServiceHandler(audit.NewService(q), interceptors))
+	mux.Handle(portfoliov1connect.NewAlertServiceHandler(alerts.NewService(alerts.Options{
+		Queries:    q,
+		Portfolios: portfolios,
+	}), interceptors))
 
 	return srv
 }
+
+// testUserInterceptor authenticates all requests as [internal.TestUser].
+type testUserInterceptor struct{}
+
+func (*testUserInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		return next(internal.WithTestUser(ctx), req)
+	}
+}
+
+func (*testUserInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (*testUserInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		return next(internal.WithTestUser(ctx), conn)
+	}
+}
@@ -0,0 +1,158 @@
+// Copyright 2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+// package metrics contains the Prometheus metrics of moneyd.
+package metrics
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "mgo"
+
+var (
+	// RPCRequests counts the handled RPCs per procedure and status code.
+	RPCRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "rpc_requests_total",
+		Help:      "Number of handled RPCs per procedure and status code.",
+	}, []string{"procedure", "code"})
+
+	// RPCDuration observes the latency of RPCs per procedure.
+	RPCDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "rpc_duration_seconds",
+		Help:      "Latency of RPCs per procedure.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"procedure"})
+
+	// QuoteUpdates counts the quote updates per provider and result, which is
+	// either "success" or "failure".
+	QuoteUpdates = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "quote_updates_total",
+		Help:      "Number of quote updates per provider and result.",
+	}, []string{"provider", "result"})
+
+	// QuoteUpdateDuration observes the latency of quote providers.
+	QuoteUpdateDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "quote_update_duration_seconds",
+		Help:      "Latency of quote providers.",
+		Buckets:   []float64{.1, .25, .5, 1, 2.5, 5, 10, 30, 60},
+	}, []string{"provider"})
+
+	// DBQueryDuration observes the duration of database queries per query.
+	DBQueryDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "db_query_duration_seconds",
+		Help:      "Duration of database queries.",
+		Buckets:   []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1},
+	}, []string{"query"})
+
+	// ImportRows counts the rows of imported CSV files per result, which is
+	// either "imported" or "skipped".
+	ImportRows = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "import_rows_total",
+		Help:      "Number of rows of imported CSV files per result.",
+	}, []string{"result"})
+
+	// quoteAges holds the time of the latest quote per listing.
+	quoteAges = &quoteAgeCollector{
+		desc: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "", "quote_age_seconds"),
+			"Age of the latest quote per listing.",
+			[]string{"security_id", "ticker"}, nil,
+		),
+		times: make(map[listing]time.Time),
+	}
+)
+
+func init() {
+	prometheus.MustRegister(quoteAges)
+}
+
+// Handler returns an [http.Handler] that serves all metrics.
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// ObserveDuration observes the time since start in h.
+func ObserveDuration(h prometheus.Observer, start time.Time) {
+	h.Observe(time.Since(start).Seconds())
+}
+
+// SetQuoteTime records t as the time of the latest quote of the listing, which
+// is used to report the age of the quote.
+func SetQuoteTime(securityID string, ticker string, t time.Time) {
+	quoteAges.mu.Lock()
+	defer quoteAges.mu.Unlock()
+
+	quoteAges.times[listing{securityID, ticker}] = t
+}
+
+// DeleteQuoteTimes forgets the times of the latest quotes of all listings of
+// the security, so that the age of its quotes is no longer reported once it
+// is deleted.
+func DeleteQuoteTimes(securityID string) {
+	quoteAges.mu.Lock()
+	defer quoteAges.mu.Unlock()
+
+	for l := range quoteAges.times {
+		if l.securityID == securityID {
+			delete(quoteAges.times, l)
+		}
+	}
+}
+
+// listing identifies a security listed on an exchange.
+type listing struct {
+	securityID string
+	ticker     string
+}
+
+// quoteAgeCollector is a [prometheus.Collector] that reports the age of the
+// latest quote per listing at the time of collection.
+type quoteAgeCollector struct {
+	mu    sync.RWMutex
+	desc  *prometheus.Desc
+	times map[listing]time.Time
+}
+
+func (c *quoteAgeCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+func (c *quoteAgeCollector) Collect(ch chan<- prometheus.Metric) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	for l, t := range c.times {
+		ch <- prometheus.MustNewConstMetric(
+			c.desc,
+			prometheus.GaugeValue,
+			time.Since(t).Seconds(),
+			l.securityID, l.ticker,
+		)
+	}
+}
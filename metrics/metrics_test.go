@@ -0,0 +1,36 @@
+// Copyright 2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/oxisto/assert"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestDeleteQuoteTimes(t *testing.T) {
+	SetQuoteTime("US0378331005", "APC.F", time.Now())
+	SetQuoteTime("US0378331005", "AAPL", time.Now())
+	SetQuoteTime("DE0005190003", "BMW.F", time.Now())
+	assert.Equals(t, 3, testutil.CollectAndCount(quoteAges))
+
+	// Only the listings of the deleted security are no longer reported
+	DeleteQuoteTimes("US0378331005")
+	assert.Equals(t, 1, testutil.CollectAndCount(quoteAges))
+}
@@ -0,0 +1,54 @@
+// Copyright 2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"os"
+)
+
+// ErrBackupExists is returned by [Backup] if the target file already exists.
+var ErrBackupExists = errors.New("backup file already exists")
+
+// Backup creates a consistent copy of the database in the file specified by
+// path. It uses SQLite's VACUUM INTO, which is safe to use while the database
+// is in use by other connections.
+func Backup(ctx context.Context, db *DB, path string) (size int64, err error) {
+	var fi fs.FileInfo
+
+	if _, err = os.Stat(path); err == nil {
+		return 0, ErrBackupExists
+	}
+
+	_, err = db.ExecContext(ctx, "VACUUM INTO ?", path)
+	if err != nil {
+		return 0, fmt.Errorf("could not create backup: %w", err)
+	}
+
+	fi, err = os.Stat(path)
+	if err != nil {
+		return 0, fmt.Errorf("could not stat backup: %w", err)
+	}
+
+	slog.Info("Successfully created database backup", "path", path, "size", fi.Size())
+
+	return fi.Size(), nil
+}
@@ -0,0 +1,81 @@
+// Copyright 2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package persistence
+
+import (
+	"context"
+	"os"
+	"path"
+	"testing"
+)
+
+func TestBackup(t *testing.T) {
+	var (
+		dir      = t.TempDir()
+		existing = path.Join(dir, "existing.db")
+	)
+
+	if err := os.WriteFile(existing, []byte{}, 0600); err != nil {
+		t.Fatalf("could not create file: %v", err)
+	}
+
+	db, _, err := OpenDB(Options{DSN: path.Join(dir, "money.db")})
+	if err != nil {
+		t.Fatalf("could not open database: %v", err)
+	}
+
+	type args struct {
+		path string
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantSize bool
+		wantErr  error
+	}{
+		{
+			name:     "happy path",
+			args:     args{path: path.Join(dir, "backup.db")},
+			wantSize: true,
+		},
+		{
+			name:    "file exists",
+			args:    args{path: existing},
+			wantErr: ErrBackupExists,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotSize, err := Backup(context.Background(), db, tt.args.path)
+			if err != tt.wantErr {
+				t.Errorf("Backup() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantSize != (gotSize > 0) {
+				t.Errorf("Backup() = %v, want size %v", gotSize, tt.wantSize)
+			}
+
+			if tt.wantSize {
+				backup, _, err := OpenDB(Options{DSN: tt.args.path})
+				if err != nil {
+					t.Errorf("could not open backup: %v", err)
+				}
+				backup.Close()
+			}
+		})
+	}
+}
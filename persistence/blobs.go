@@ -0,0 +1,313 @@
+// Copyright 2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package persistence
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// ErrBlobNotFound is returned by [BlobStore.Open] if there is no content with
+// the requested hash.
+var ErrBlobNotFound = errors.New("blob not found")
+
+// BlobStore stores the content of attachments. The content is addressed by its
+// hex-encoded SHA-256 hash, so that the same content is only stored once.
+type BlobStore interface {
+	// Put stores the content of r and returns its hash and size.
+	Put(ctx context.Context, r io.Reader) (hash string, size int64, err error)
+
+	// Open returns the content with the specified hash.
+	Open(ctx context.Context, hash string) (io.ReadCloser, error)
+
+	// Delete removes the content with the specified hash. It is not an error
+	// if the content does not exist.
+	Delete(ctx context.Context, hash string) error
+
+	// WithTx returns a store whose database operations are part of tx. A
+	// store that does not keep its content in the database returns itself.
+	WithTx(tx *sql.Tx) BlobStore
+}
+
+// NewBlobStore creates a [FileBlobStore] in dir. If dir is empty, it creates a
+// [DBBlobStore] that uses q instead.
+func NewBlobStore(q *Queries, dir string) (BlobStore, error) {
+	if dir == "" {
+		return NewDBBlobStore(q), nil
+	}
+
+	return NewFileBlobStore(dir)
+}
+
+// FileBlobStore is a [BlobStore] that stores the content in files of a
+// directory in the local filesystem.
+type FileBlobStore struct {
+	dir string
+}
+
+// NewFileBlobStore creates a new [FileBlobStore] in dir, which is created if
+// it does not exist.
+func NewFileBlobStore(dir string) (*FileBlobStore, error) {
+	err := os.MkdirAll(dir, 0700)
+	if err != nil {
+		return nil, fmt.Errorf("could not create attachment directory: %w", err)
+	}
+
+	return &FileBlobStore{dir: dir}, nil
+}
+
+// path returns the path of the file with the specified hash. Files are spread
+// over sub-directories named after the first two characters of their hash.
+func (s *FileBlobStore) path(hash string) (string, error) {
+	if len(hash) != sha256.Size*2 {
+		return "", ErrBlobNotFound
+	} else if _, err := hex.DecodeString(hash); err != nil {
+		return "", ErrBlobNotFound
+	}
+
+	return filepath.Join(s.dir, hash[:2], hash), nil
+}
+
+func (s *FileBlobStore) Put(ctx context.Context, r io.Reader) (hash string, size int64, err error) {
+	var (
+		f    *os.File
+		h    = sha256.New()
+		path string
+	)
+
+	// We only know the hash at the end, so we write into a temporary file
+	// first and move it afterwards
+	f, err = os.CreateTemp(s.dir, "upload-*")
+	if err != nil {
+		return "", 0, err
+	}
+	defer os.Remove(f.Name())
+
+	size, err = io.Copy(io.MultiWriter(f, h), r)
+	if err != nil {
+		f.Close()
+		return "", 0, err
+	}
+
+	err = f.Close()
+	if err != nil {
+		return "", 0, err
+	}
+
+	hash = hex.EncodeToString(h.Sum(nil))
+	path, _ = s.path(hash)
+
+	err = os.MkdirAll(filepath.Dir(path), 0700)
+	if err != nil {
+		return "", 0, err
+	}
+
+	err = os.Rename(f.Name(), path)
+	if err != nil {
+		return "", 0, err
+	}
+
+	return hash, size, nil
+}
+
+func (s *FileBlobStore) Open(ctx context.Context, hash string) (io.ReadCloser, error) {
+	path, err := s.path(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrBlobNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+func (s *FileBlobStore) Delete(ctx context.Context, hash string) error {
+	path, err := s.path(hash)
+	if err != nil {
+		return nil
+	}
+
+	err = os.Remove(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+
+	return err
+}
+
+func (s *FileBlobStore) WithTx(tx *sql.Tx) BlobStore {
+	return s
+}
+
+// DBBlobStore is a [BlobStore] that stores the content in the database. This
+// way, it is part of backups and encrypted together with the database.
+type DBBlobStore struct {
+	q *Queries
+}
+
+// NewDBBlobStore creates a new [DBBlobStore] that uses q.
+func NewDBBlobStore(q *Queries) *DBBlobStore {
+	return &DBBlobStore{q: q}
+}
+
+func (s *DBBlobStore) Put(ctx context.Context, r io.Reader) (hash string, size int64, err error) {
+	var h = sha256.New()
+
+	// SQLite needs the whole content at once
+	content, err := io.ReadAll(io.TeeReader(r, h))
+	if err != nil {
+		return "", 0, err
+	}
+
+	hash = hex.EncodeToString(h.Sum(nil))
+
+	err = s.q.CreateAttachmentBlob(ctx, CreateAttachmentBlobParams{Hash: hash, Content: content})
+	if err != nil {
+		return "", 0, err
+	}
+
+	return hash, int64(len(content)), nil
+}
+
+func (s *DBBlobStore) Open(ctx context.Context, hash string) (io.ReadCloser, error) {
+	content, err := s.q.GetAttachmentBlob(ctx, hash)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrBlobNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return io.NopCloser(bytes.NewReader(content)), nil
+}
+
+func (s *DBBlobStore) Delete(ctx context.Context, hash string) error {
+	return s.q.DeleteAttachmentBlob(ctx, hash)
+}
+
+func (s *DBBlobStore) WithTx(tx *sql.Tx) BlobStore {
+	return &DBBlobStore{q: New(observedDB{tx})}
+}
+
+// StoreAttachment creates the attachment and stores its content in store. Both
+// happen within one transaction, which holds the write lock of the database
+// while the content is stored. This way, the content cannot be removed by
+// [RemoveAttachment] of another attachment with the same content in between.
+// If an attachment with the same ID exists, [sql.ErrNoRows] is returned.
+func StoreAttachment(ctx context.Context, db *DB, store BlobStore, arg CreateAttachmentParams, content []byte) (a *Attachment, err error) {
+	if sum := sha256.Sum256(content); hex.EncodeToString(sum[:]) != arg.Hash {
+		return nil, fmt.Errorf("content does not match hash %s", arg.Hash)
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	a, err = New(observedDB{tx}).CreateAttachment(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+
+	_, _, err = store.WithTx(tx).Put(ctx, bytes.NewReader(content))
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// RemoveAttachment removes the attachment with the specified ID and its
+// content in store, unless the content is still used by another attachment.
+// Both happen within one transaction, see [StoreAttachment].
+func RemoveAttachment(ctx context.Context, db *DB, store BlobStore, id string, hash string) (err error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	q := New(observedDB{tx})
+
+	_, err = q.DeleteAttachment(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	err = releaseBlob(ctx, q, store.WithTx(tx), hash)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// releaseBlob removes the content with the specified hash from store, unless
+// it is still used by an attachment. It must be called within the transaction
+// that removed the attachment.
+func releaseBlob(ctx context.Context, q *Queries, store BlobStore, hash string) error {
+	n, err := q.CountAttachmentsWithHash(ctx, hash)
+	if err != nil {
+		return err
+	} else if n > 0 {
+		return nil
+	}
+
+	return store.Delete(ctx, hash)
+}
+
+// PurgeTrashedAttachments permanently removes the attachments of all portfolio
+// events that were moved to the trash before the specified time, together with
+// their content in store. It needs to be called before [PurgeTrash], which
+// purges the events themselves. It returns the number of removed attachments.
+func PurgeTrashedAttachments(ctx context.Context, db *DB, store BlobStore, before time.Time) (n int64, err error) {
+	list, err := New(observedDB{db}).ListTrashedAttachments(ctx, sql.NullTime{Time: before.UTC(), Valid: true})
+	if err != nil {
+		return 0, err
+	}
+
+	for _, a := range list {
+		err = RemoveAttachment(ctx, db, store, a.ID, a.Hash)
+		if err != nil {
+			return n, fmt.Errorf("could not purge attachment: %w", err)
+		}
+
+		n++
+	}
+
+	return n, nil
+}
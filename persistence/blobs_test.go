@@ -0,0 +1,171 @@
+// Copyright 2023 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/oxisto/assert"
+)
+
+// helloHash is the SHA-256 hash of "hello".
+const helloHash = "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"
+
+func TestBlobStore(t *testing.T) {
+	db, _, err := OpenDB(Options{UseInMemory: true})
+	if err != nil {
+		t.Fatalf("could not open database: %v", err)
+	}
+
+	files, err := NewFileBlobStore(t.TempDir())
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		store BlobStore
+	}{
+		{name: "file", store: files},
+		{name: "db", store: NewDBBlobStore(New(db))},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			hash, size, err := tt.store.Put(ctx, strings.NewReader("hello"))
+			assert.NoError(t, err)
+			assert.Equals(t, helloHash, hash)
+			assert.Equals(t, int64(5), size)
+
+			// Storing the same content again is fine
+			_, _, err = tt.store.Put(ctx, strings.NewReader("hello"))
+			assert.NoError(t, err)
+
+			r, err := tt.store.Open(ctx, hash)
+			assert.NoError(t, err)
+			content, err := io.ReadAll(r)
+			assert.NoError(t, err)
+			assert.NoError(t, r.Close())
+			assert.Equals(t, "hello", string(content))
+
+			assert.NoError(t, tt.store.Delete(ctx, hash))
+			assert.NoError(t, tt.store.Delete(ctx, hash))
+
+			_, err = tt.store.Open(ctx, hash)
+			assert.ErrorIs(t, err, ErrBlobNotFound)
+
+			// Hashes must not be used to escape the directory
+			_, err = tt.store.Open(ctx, "../../etc/passwd")
+			assert.ErrorIs(t, err, ErrBlobNotFound)
+		})
+	}
+}
+
+func TestStoreAttachment(t *testing.T) {
+	db, _, err := OpenDB(Options{UseInMemory: true})
+	if err != nil {
+		t.Fatalf("could not open database: %v", err)
+	}
+
+	files, err := NewFileBlobStore(t.TempDir())
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		store BlobStore
+	}{
+		{name: "file", store: files},
+		{name: "db", store: NewDBBlobStore(New(db))},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			params := func(id string) CreateAttachmentParams {
+				return CreateAttachmentParams{ID: tt.name + id, EventID: "buy", FileName: "note.pdf", Size: 5, Hash: helloHash, CreateTime: time.Now()}
+			}
+
+			_, err := StoreAttachment(ctx, db, tt.store, params("-note"), []byte("hello"))
+			assert.NoError(t, err)
+			_, err = StoreAttachment(ctx, db, tt.store, params("-copy"), []byte("hello"))
+			assert.NoError(t, err)
+
+			// The same attachment cannot be stored twice
+			_, err = StoreAttachment(ctx, db, tt.store, params("-copy"), []byte("hello"))
+			assert.ErrorIs(t, err, sql.ErrNoRows)
+
+			// Content that does not match its hash is not stored
+			_, err = StoreAttachment(ctx, db, tt.store, params("-other"), []byte("world"))
+			assert.Equals(t, true, err != nil)
+
+			// The content is still used by the copy
+			assert.NoError(t, RemoveAttachment(ctx, db, tt.store, tt.name+"-note", helloHash))
+			_, err = tt.store.Open(ctx, helloHash)
+			assert.NoError(t, err)
+
+			assert.NoError(t, RemoveAttachment(ctx, db, tt.store, tt.name+"-copy", helloHash))
+			_, err = tt.store.Open(ctx, helloHash)
+			assert.ErrorIs(t, err, ErrBlobNotFound)
+		})
+	}
+}
+
+func TestPurgeTrashedAttachments(t *testing.T) {
+	var (
+		ctx = context.Background()
+		q   *Queries
+	)
+
+	db, _, err := OpenDB(Options{UseInMemory: true})
+	if err != nil {
+		t.Fatalf("could not open database: %v", err)
+	}
+	q = New(db)
+	store := NewDBBlobStore(q)
+
+	hash, _, err := store.Put(ctx, strings.NewReader("hello"))
+	assert.NoError(t, err)
+
+	_, err = db.Exec(`INSERT INTO portfolio_events (id, type, time, portfolio_id, security_id) VALUES ('old-buy', 1, '2020-01-01', 'old', 'US0378331005'), ('recent-buy', 1, '2020-01-01', 'old', 'US0378331005');
+INSERT INTO attachments (id, event_id, file_name, content_type, size, hash, create_time) VALUES ('old-note', 'old-buy', 'note.pdf', 'application/pdf', 5, ?1, '2020-01-01'), ('recent-note', 'recent-buy', 'note.pdf', 'application/pdf', 5, ?1, '2020-01-01');
+UPDATE portfolio_events SET delete_time = '2020-01-01 00:00:00' WHERE id = 'old-buy';`, hash)
+	if err != nil {
+		t.Fatalf("could not insert data: %v", err)
+	}
+
+	// The content is still used by the attachment of the other event
+	n, err := PurgeTrashedAttachments(ctx, db, store, time.Now().AddDate(0, 0, -30))
+	assert.NoError(t, err)
+	assert.Equals(t, int64(1), n)
+
+	_, err = store.Open(ctx, hash)
+	assert.NoError(t, err)
+
+	// Once the other event is purged as well, so is the content
+	_, err = db.Exec("UPDATE portfolio_events SET delete_time = '2020-01-01 00:00:00' WHERE id = 'recent-buy'")
+	assert.NoError(t, err)
+
+	n, err = PurgeTrashedAttachments(ctx, db, store, time.Now().AddDate(0, 0, -30))
+	assert.NoError(t, err)
+	assert.Equals(t, int64(1), n)
+
+	_, err = store.Open(ctx, hash)
+	assert.ErrorIs(t, err, ErrBlobNotFound)
+}
@@ -0,0 +1,521 @@
+// Copyright 2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package persistence
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/lmittmann/tint"
+	"github.com/mattn/go-sqlite3"
+)
+
+var (
+	// ErrInvalidKey is returned if an encrypted database cannot be decrypted
+	// with the supplied passphrase or key file.
+	ErrInvalidKey = errors.New("invalid key or corrupted database")
+
+	// ErrNotADatabase is returned if a file is neither an encrypted nor a
+	// plaintext SQLite database.
+	ErrNotADatabase = errors.New("file is not a database")
+
+	// ErrDatabaseLocked is returned if an encrypted database is already opened
+	// by another process.
+	ErrDatabaseLocked = errors.New("database is used by another process")
+)
+
+const (
+	// encryptionMagic is the header of an encrypted database file. It is
+	// followed by the salt, the nonce and the sealed database.
+	encryptionMagic = "MGOENC01"
+
+	// sqliteMagic is the header of a plaintext SQLite database file.
+	sqliteMagic = "SQLite format 3\x00"
+
+	saltSize = 16
+
+	// kdfIterations is the number of PBKDF2 iterations, as recommended by
+	// OWASP for PBKDF2-HMAC-SHA256.
+	kdfIterations = 600_000
+)
+
+// DefaultFlushDelay is the default of [Options.FlushDelay].
+const DefaultFlushDelay = time.Second
+
+// vaults holds the [vault] of every encrypted [DB], so that [Close] can
+// write it to disk one last time.
+var vaults sync.Map
+
+// vault keeps an encrypted database in memory and writes it to disk whenever
+// it changes. While a vault is open, it holds an exclusive lock on the
+// database, so that no other process can open the database and overwrite our
+// changes with its own copy.
+type vault struct {
+	db   *DB
+	path string
+	salt []byte
+	aead cipher.AEAD
+	lock *os.File
+
+	// delay is the time we wait after a change before we write the database
+	// to disk.
+	delay time.Duration
+
+	// dirty is true, if the database has changed since it was last written
+	// to disk.
+	dirty   atomic.Bool
+	changed chan struct{}
+	done    chan struct{}
+	wg      sync.WaitGroup
+}
+
+// encrypted returns true, if the database should be encrypted at rest.
+func (opts Options) encrypted() bool {
+	return !opts.UseInMemory && (opts.Passphrase != "" || opts.KeyFile != "")
+}
+
+// secret returns the secret from which the encryption key is derived.
+func (opts Options) secret() (secret []byte, err error) {
+	if opts.KeyFile != "" {
+		secret, err = os.ReadFile(opts.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("could not read key file: %w", err)
+		}
+
+		secret = bytes.TrimSpace(secret)
+	} else {
+		secret = []byte(opts.Passphrase)
+	}
+
+	if len(secret) == 0 {
+		return nil, fmt.Errorf("%w: key is empty", ErrInvalidKey)
+	}
+
+	return
+}
+
+// newAEAD derives an AES-256 key from the secret and returns an AES-GCM
+// cipher for it.
+func newAEAD(secret []byte, salt []byte) (aead cipher.AEAD, err error) {
+	key, err := pbkdf2.Key(sha256.New, string(secret), salt, kdfIterations, 32)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// seal encrypts a plaintext database. The header is used as additional data,
+// so that it cannot be tampered with.
+func seal(aead cipher.AEAD, salt []byte, plaintext []byte) []byte {
+	var (
+		nonce  = make([]byte, aead.NonceSize())
+		header []byte
+	)
+
+	rand.Read(nonce)
+
+	header = append([]byte(encryptionMagic), salt...)
+	header = append(header, nonce...)
+
+	return aead.Seal(header, nonce, plaintext, header)
+}
+
+// unseal decrypts a database that was encrypted with [seal].
+func unseal(secret []byte, data []byte) (plaintext []byte, salt []byte, aead cipher.AEAD, err error) {
+	var (
+		nonce  []byte
+		header []byte
+	)
+
+	if len(data) < len(encryptionMagic)+saltSize || string(data[:len(encryptionMagic)]) != encryptionMagic {
+		return nil, nil, nil, ErrNotADatabase
+	}
+
+	salt = data[len(encryptionMagic) : len(encryptionMagic)+saltSize]
+
+	aead, err = newAEAD(secret, salt)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	header = data[:len(encryptionMagic)+saltSize+aead.NonceSize()]
+	nonce = header[len(encryptionMagic)+saltSize:]
+
+	plaintext, err = aead.Open(nil, nonce, data[len(header):], header)
+	if err != nil {
+		return nil, nil, nil, ErrInvalidKey
+	}
+
+	return
+}
+
+// openEncryptedDB opens an in-memory database and loads the content of the
+// encrypted database file specified in the DSN into it. Afterwards, all
+// changes are written back to the encrypted file.
+func openEncryptedDB(opts Options) (db *DB, err error) {
+	var lock *os.File
+
+	lock, err = lockFile(opts.DSN + ".lock")
+	if err != nil {
+		return nil, err
+	}
+
+	db, err = openVault(opts, lock)
+	if err != nil {
+		return nil, errors.Join(err, lock.Close())
+	}
+
+	return db, nil
+}
+
+// openVault opens the in-memory database of an encrypted database, whose lock
+// file we already hold.
+func openVault(opts Options, lock *os.File) (db *DB, err error) {
+	var (
+		v         vault
+		secret    []byte
+		data      []byte
+		plaintext []byte
+	)
+
+	secret, err = opts.secret()
+	if err != nil {
+		return nil, err
+	}
+
+	db, err = sql.Open("sqlite3", ":memory:?_foreign_keys=1")
+	if err != nil {
+		return nil, fmt.Errorf("could not open database: %w", err)
+	}
+	defer func(db *DB) {
+		if err != nil {
+			db.Close()
+		}
+	}(db)
+
+	// Every connection to an in-memory database has its own database, so we
+	// must make sure that there is exactly one that is never closed.
+	db.SetMaxOpenConns(1)
+	db.SetConnMaxLifetime(0)
+	db.SetConnMaxIdleTime(0)
+
+	v = vault{
+		db:      db,
+		path:    opts.DSN,
+		lock:    lock,
+		delay:   opts.FlushDelay,
+		changed: make(chan struct{}, 1),
+		done:    make(chan struct{}),
+	}
+
+	data, err = os.ReadFile(opts.DSN)
+	if errors.Is(err, fs.ErrNotExist) {
+		// This is a new database, so we need a new salt and need to write it
+		// to disk at least once
+		v.dirty.Store(true)
+		v.salt = make([]byte, saltSize)
+		rand.Read(v.salt)
+
+		v.aead, err = newAEAD(secret, v.salt)
+		if err != nil {
+			return nil, err
+		}
+	} else if err != nil {
+		return nil, fmt.Errorf("could not read database: %w", err)
+	} else {
+		plaintext, v.salt, v.aead, err = unseal(secret, data)
+		if err != nil {
+			return nil, err
+		}
+
+		err = v.load(plaintext)
+		if err != nil {
+			return nil, fmt.Errorf("could not load database: %w", err)
+		}
+	}
+
+	// The commit hook is only called for transactions that actually change
+	// something, including schema changes
+	err = v.raw(func(c *sqlite3.SQLiteConn) error {
+		c.RegisterCommitHook(func() int {
+			v.markDirty()
+			return 0
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	v.wg.Add(1)
+	go v.run()
+
+	vaults.Store(db, &v)
+
+	return db, nil
+}
+
+// raw executes f on the underlying connection of our in-memory database.
+func (v *vault) raw(f func(c *sqlite3.SQLiteConn) error) (err error) {
+	conn, err := v.db.Conn(context.Background())
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	return conn.Raw(func(driverConn any) error {
+		return f(driverConn.(*sqlite3.SQLiteConn))
+	})
+}
+
+// load copies a plaintext database into our in-memory database. A
+// deserialized database cannot grow, so we deserialize it into a temporary
+// connection and use the backup API to copy it.
+func (v *vault) load(plaintext []byte) (err error) {
+	tmp, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		return err
+	}
+	defer tmp.Close()
+
+	conn, err := tmp.Conn(context.Background())
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	return conn.Raw(func(srcConn any) error {
+		src := srcConn.(*sqlite3.SQLiteConn)
+
+		err := src.Deserialize(plaintext, "main")
+		if err != nil {
+			return err
+		}
+
+		return v.raw(func(dest *sqlite3.SQLiteConn) error {
+			b, err := dest.Backup("main", src, "main")
+			if err != nil {
+				return err
+			}
+
+			_, err = b.Step(-1)
+			return errors.Join(err, b.Finish())
+		})
+	})
+}
+
+// markDirty signals that the database has changed. It must not block, since
+// it is called from within the commit hook of SQLite.
+func (v *vault) markDirty() {
+	v.dirty.Store(true)
+
+	select {
+	case v.changed <- struct{}{}:
+	default:
+	}
+}
+
+// run writes the database to disk whenever it was changed, until the vault is
+// closed.
+func (v *vault) run() {
+	defer v.wg.Done()
+
+	for {
+		select {
+		case <-v.done:
+			return
+		case <-v.changed:
+		}
+
+		if v.delay > 0 {
+			select {
+			case <-v.done:
+				return
+			case <-time.After(v.delay):
+			}
+		}
+
+		if err := v.flush(); err != nil {
+			slog.Error("Could not write encrypted database", tint.Err(err))
+		}
+	}
+}
+
+// flush encrypts the in-memory database and atomically replaces the file on
+// disk, if the database has changed since it was last written.
+func (v *vault) flush() (err error) {
+	var (
+		plaintext []byte
+		dirty     bool
+	)
+
+	// We hold the only connection while serializing, so no commit can happen
+	// in between resetting the flag and serializing the database
+	err = v.raw(func(c *sqlite3.SQLiteConn) error {
+		if dirty = v.dirty.Swap(false); !dirty {
+			return nil
+		}
+
+		plaintext, err = c.Serialize("main")
+		return err
+	})
+	if err != nil {
+		v.dirty.Store(dirty)
+		return fmt.Errorf("could not serialize database: %w", err)
+	} else if !dirty {
+		return nil
+	}
+
+	err = writeFileAtomic(v.path, seal(v.aead, v.salt, plaintext))
+	if err != nil {
+		// We need to try again next time
+		v.markDirty()
+		return err
+	}
+
+	return nil
+}
+
+// close stops the background writer, writes all pending changes to disk and
+// releases the lock of the database.
+func (v *vault) close() error {
+	close(v.done)
+	v.wg.Wait()
+
+	return errors.Join(v.flush(), v.lock.Close())
+}
+
+// Encrypted returns true, if db is encrypted at rest.
+func Encrypted(db *DB) bool {
+	_, ok := vaults.Load(db)
+	return ok
+}
+
+// Close closes the database. If the database is encrypted at rest, all
+// pending changes are written to disk before.
+func Close(db *DB) (err error) {
+	if v, ok := vaults.LoadAndDelete(db); ok {
+		err = v.(*vault).close()
+	}
+
+	return errors.Join(err, db.Close())
+}
+
+// RotateKey re-encrypts the database file specified by the DSN in opts with
+// the passphrase or key file specified in next. If opts does not specify a
+// key, a plaintext database is encrypted. If next does not specify a key, the
+// database is decrypted. The server must not be running while doing this.
+func RotateKey(opts Options, next Options) (err error) {
+	var (
+		data      []byte
+		plaintext []byte
+		secret    []byte
+		salt      []byte
+		aead      cipher.AEAD
+	)
+
+	if opts.DSN == "" {
+		opts.DSN = "money.db"
+	}
+
+	lock, err := lockFile(opts.DSN + ".lock")
+	if err != nil {
+		return err
+	}
+	defer lock.Close()
+
+	data, err = os.ReadFile(opts.DSN)
+	if err != nil {
+		return fmt.Errorf("could not read database: %w", err)
+	}
+
+	if opts.encrypted() {
+		secret, err = opts.secret()
+		if err != nil {
+			return err
+		}
+
+		plaintext, _, _, err = unseal(secret, data)
+		if err != nil {
+			return err
+		}
+	} else if bytes.HasPrefix(data, []byte(sqliteMagic)) {
+		plaintext = data
+	} else {
+		return ErrNotADatabase
+	}
+
+	if !next.encrypted() {
+		return writeFileAtomic(opts.DSN, plaintext)
+	}
+
+	secret, err = next.secret()
+	if err != nil {
+		return err
+	}
+
+	salt = make([]byte, saltSize)
+	rand.Read(salt)
+
+	aead, err = newAEAD(secret, salt)
+	if err != nil {
+		return err
+	}
+
+	return writeFileAtomic(opts.DSN, seal(aead, salt, plaintext))
+}
+
+// writeFileAtomic writes data to a temporary file and renames it, so that we
+// never end up with a partially written database.
+func writeFileAtomic(path string, data []byte) (err error) {
+	f, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	_, err = f.Write(data)
+	if err == nil {
+		err = f.Sync()
+	}
+	if err = errors.Join(err, f.Close()); err != nil {
+		return err
+	}
+
+	return os.Rename(f.Name(), path)
+}
@@ -0,0 +1,266 @@
+// Copyright 2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package persistence
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path"
+	"testing"
+	"time"
+)
+
+func TestOpenDB_encrypted(t *testing.T) {
+	var (
+		dir  = t.TempDir()
+		dsn  = path.Join(dir, "money.db")
+		opts = Options{DSN: dsn, Passphrase: "moneymoneymoney"}
+	)
+
+	db, q, err := OpenDB(opts)
+	if err != nil {
+		t.Fatalf("OpenDB() error = %v", err)
+	}
+
+	_, err = q.CreateSecurity(context.Background(), CreateSecurityParams{ID: "US0378331005", DisplayName: "Apple Inc."})
+	if err != nil {
+		t.Fatalf("CreateSecurity() error = %v", err)
+	}
+
+	if err = Close(db); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	data, err := os.ReadFile(dsn)
+	if err != nil {
+		t.Fatalf("could not read database: %v", err)
+	}
+
+	if bytes.Contains(data, []byte("Apple Inc.")) || bytes.HasPrefix(data, []byte(sqliteMagic)) {
+		t.Fatalf("database is not encrypted")
+	}
+
+	type args struct {
+		opts Options
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr error
+	}{
+		{
+			name: "correct passphrase",
+			args: args{opts},
+		},
+		{
+			name:    "wrong passphrase",
+			args:    args{Options{DSN: dsn, Passphrase: "nomoney"}},
+			wantErr: ErrInvalidKey,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, q, err := OpenDB(tt.args.opts)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("OpenDB() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			defer Close(db)
+
+			sec, err := q.GetSecurity(context.Background(), "US0378331005")
+			if err != nil {
+				t.Fatalf("GetSecurity() error = %v", err)
+			}
+			if sec.DisplayName != "Apple Inc." {
+				t.Errorf("GetSecurity() = %v, want Apple Inc.", sec.DisplayName)
+			}
+		})
+	}
+}
+
+func TestOpenDB_encryptedLocked(t *testing.T) {
+	var (
+		dir  = t.TempDir()
+		dsn  = path.Join(dir, "money.db")
+		opts = Options{DSN: dsn, Passphrase: "moneymoneymoney"}
+	)
+
+	db, _, err := OpenDB(opts)
+	if err != nil {
+		t.Fatalf("OpenDB() error = %v", err)
+	}
+
+	// A second process must not be able to open the database, since it would
+	// overwrite our changes with its own copy
+	_, _, err = OpenDB(opts)
+	if !errors.Is(err, ErrDatabaseLocked) {
+		t.Fatalf("OpenDB() error = %v, wantErr %v", err, ErrDatabaseLocked)
+	}
+
+	if err = Close(db); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	before, err := os.ReadFile(dsn)
+	if err != nil {
+		t.Fatalf("could not read database: %v", err)
+	}
+
+	// Once the lock is released, the database can be opened again. Since
+	// nothing changes, it must not be written again.
+	db, _, err = OpenDB(opts)
+	if err != nil {
+		t.Fatalf("OpenDB() error = %v", err)
+	}
+
+	if err = Close(db); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	after, err := os.ReadFile(dsn)
+	if err != nil {
+		t.Fatalf("could not read database: %v", err)
+	}
+
+	if !bytes.Equal(before, after) {
+		t.Errorf("database was written although nothing changed")
+	}
+}
+
+func TestOpenDB_encryptedFlushDelay(t *testing.T) {
+	var (
+		dir  = t.TempDir()
+		dsn  = path.Join(dir, "money.db")
+		opts = Options{DSN: dsn, Passphrase: "moneymoneymoney", FlushDelay: 0}
+	)
+
+	db, q, err := OpenDB(opts)
+	if err != nil {
+		t.Fatalf("OpenDB() error = %v", err)
+	}
+	defer Close(db)
+
+	_, err = q.CreateSecurity(context.Background(), CreateSecurityParams{ID: "US0378331005", DisplayName: "Apple Inc."})
+	if err != nil {
+		t.Fatalf("CreateSecurity() error = %v", err)
+	}
+
+	// Without a delay, the change must be written to disk without closing the
+	// database
+	for deadline := time.Now().Add(5 * time.Second); ; {
+		data, err := os.ReadFile(dsn)
+		if err != nil {
+			t.Fatalf("could not read database: %v", err)
+		}
+
+		plaintext, _, _, err := unseal([]byte(opts.Passphrase), data)
+		if err != nil {
+			t.Fatalf("unseal() error = %v", err)
+		}
+
+		if bytes.Contains(plaintext, []byte("Apple Inc.")) {
+			break
+		} else if time.Now().After(deadline) {
+			t.Fatalf("change was not written to disk")
+		}
+
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestRotateKey(t *testing.T) {
+	var (
+		dir     = t.TempDir()
+		dsn     = path.Join(dir, "money.db")
+		keyFile = path.Join(dir, "money.key")
+	)
+
+	if err := os.WriteFile(keyFile, []byte("very-secret-key\n"), 0600); err != nil {
+		t.Fatalf("could not write key file: %v", err)
+	}
+
+	// Start with a plaintext database
+	db, _, err := OpenDB(Options{DSN: dsn})
+	if err != nil {
+		t.Fatalf("OpenDB() error = %v", err)
+	}
+	db.Close()
+
+	type args struct {
+		opts Options
+		next Options
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr error
+	}{
+		{
+			name: "encrypt plaintext",
+			args: args{
+				opts: Options{DSN: dsn},
+				next: Options{Passphrase: "moneymoneymoney"},
+			},
+		},
+		{
+			name: "wrong key",
+			args: args{
+				opts: Options{DSN: dsn, Passphrase: "nomoney"},
+				next: Options{KeyFile: keyFile},
+			},
+			wantErr: ErrInvalidKey,
+		},
+		{
+			name: "passphrase to key file",
+			args: args{
+				opts: Options{DSN: dsn, Passphrase: "moneymoneymoney"},
+				next: Options{KeyFile: keyFile},
+			},
+		},
+		{
+			name: "decrypt",
+			args: args{
+				opts: Options{DSN: dsn, KeyFile: keyFile},
+				next: Options{},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := RotateKey(tt.args.opts, tt.args.next)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("RotateKey() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			next := tt.args.next
+			next.DSN = dsn
+
+			db, _, err := OpenDB(next)
+			if err != nil {
+				t.Fatalf("OpenDB() with new key error = %v", err)
+			}
+			Close(db)
+		})
+	}
+}
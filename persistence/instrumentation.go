@@ -0,0 +1,108 @@
+// Copyright 2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/oxisto/money-gopher/metrics"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracer creates the spans of all database queries.
+var tracer = otel.Tracer("github.com/oxisto/money-gopher/persistence")
+
+// observedDB is a [DBTX] that creates a span for every query and records its
+// duration in [metrics.DBQueryDuration].
+type observedDB struct {
+	DBTX
+}
+
+func (db observedDB) ExecContext(ctx context.Context, query string, args ...interface{}) (res sql.Result, err error) {
+	ctx, end := instrument(ctx, queryName(query))
+	defer func() { end(err) }()
+
+	return db.DBTX.ExecContext(ctx, query, args...)
+}
+
+func (db observedDB) QueryContext(ctx context.Context, query string, args ...interface{}) (rows *sql.Rows, err error) {
+	ctx, end := instrument(ctx, queryName(query))
+	defer func() { end(err) }()
+
+	return db.DBTX.QueryContext(ctx, query, args...)
+}
+
+func (db observedDB) QueryRowContext(ctx context.Context, query string, args ...interface{}) (row *sql.Row) {
+	ctx, end := instrument(ctx, queryName(query))
+	defer func() { end(row.Err()) }()
+
+	return db.DBTX.QueryRowContext(ctx, query, args...)
+}
+
+// queryName returns the name of a query generated by sqlc, which starts with a
+// comment such as "-- name: GetSecurity :one". Other queries are named
+// "unknown".
+func queryName(query string) string {
+	rest, ok := strings.CutPrefix(query, "-- name: ")
+	if !ok {
+		return "unknown"
+	}
+
+	name, _, _ := strings.Cut(rest, " ")
+	return name
+}
+
+// instrument starts a span for the query and returns a function that ends it
+// with the resulting error and records the duration of the query.
+func instrument(ctx context.Context, query string) (context.Context, func(err error)) {
+	var (
+		start = time.Now()
+		span  trace.Span
+	)
+
+	ctx, span = tracer.Start(ctx, query,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(semconv.DBSystemSqlite),
+	)
+
+	return ctx, func(err error) {
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+
+		span.End()
+		metrics.ObserveDuration(metrics.DBQueryDuration.WithLabelValues(query), start)
+	}
+}
+
+// instrument instruments the operation op, see [instrument]. The query is
+// named after the operation and the storage object, e.g., "ListPortfolio".
+func (ops *ops[T]) instrument(ctx context.Context, op string) (context.Context, func(err error)) {
+	var t T
+
+	return instrument(ctx, op+reflect.TypeOf(t).Elem().Name())
+}
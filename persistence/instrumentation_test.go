@@ -0,0 +1,75 @@
+// Copyright 2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package persistence
+
+import (
+	"context"
+	"testing"
+
+	"github.com/oxisto/assert"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+func Test_queryName(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  string
+	}{
+		{
+			name:  "sqlc",
+			query: getAccessTokenByHash,
+			want:  "GetAccessTokenByHash",
+		},
+		{
+			name:  "other",
+			query: "SELECT 1",
+			want:  "unknown",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equals(t, tt.want, queryName(tt.query))
+		})
+	}
+}
+
+func Test_observedDB(t *testing.T) {
+	var (
+		recorder = tracetest.NewSpanRecorder()
+		tp       = sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	)
+
+	otel.SetTracerProvider(tp)
+	defer otel.SetTracerProvider(noop.NewTracerProvider())
+
+	_, q, err := OpenDB(Options{UseInMemory: true})
+	assert.NoError(t, err)
+
+	ctx, parent := tp.Tracer("test").Start(context.Background(), "parent")
+	_, err = q.ListAccessTokensByOwner(ctx, "money")
+	assert.NoError(t, err)
+	parent.End()
+
+	spans := recorder.Ended()
+	assert.Equals(t, 2, len(spans))
+	assert.Equals(t, "ListAccessTokensByOwner", spans[0].Name())
+	assert.Equals(t, parent.SpanContext().SpanID(), spans[0].Parent().SpanID())
+}
@@ -0,0 +1,198 @@
+// Copyright 2023 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+var (
+	ErrUnsupportedList   = errors.New("list of this type cannot be filtered or sorted")
+	ErrInvalidColumn     = errors.New("invalid column")
+	ErrInvalidOperator   = errors.New("invalid operator")
+	ErrInvalidFilterList = errors.New("the IN operator requires a list of values")
+)
+
+// Operator is the comparison of a [Filter].
+type Operator string
+
+const (
+	OperatorEquals         Operator = "="
+	OperatorGreaterOrEqual Operator = ">="
+	OperatorLess           Operator = "<"
+	OperatorIn             Operator = "IN"
+
+	// OperatorContains matches a column that contains a JSON array with the
+	// value as one of its elements.
+	OperatorContains Operator = "CONTAINS"
+)
+
+// Filter restricts a list to the objects whose column compares to value.
+type Filter struct {
+	Column   string
+	Operator Operator
+
+	// Value is compared to the column. It needs to be a []any for
+	// [OperatorIn].
+	Value any
+}
+
+// ListOptions filter, sort and paginate the result of
+// [StorageOperations.ListWithOptions].
+type ListOptions struct {
+	Filters []Filter
+
+	// OrderBy contains the column the list is sorted by. If it is empty, the
+	// list is sorted by its key.
+	OrderBy    string
+	Descending bool
+
+	// Limit is the maximum number of objects. If it is 0, all objects are
+	// returned.
+	Limit  int64
+	Offset int64
+}
+
+// ListQuery describes the list query of a [QueryableObject], which is
+// extended with filters, a sort order and a limit.
+type ListQuery struct {
+	// Query selects all objects. It needs to end with a WHERE clause, so that
+	// filters can be appended.
+	Query string
+
+	// Columns contains the columns that can be used to filter and to sort.
+	Columns []string
+
+	// Key contains the columns that identify an object. They are appended to
+	// every sort order, so that pages are stable.
+	Key []string
+}
+
+// QueryableObject is a [StorageObject] whose list can be filtered, sorted and
+// paginated in the database.
+type QueryableObject interface {
+	StorageObject
+	ListQuery() ListQuery
+}
+
+// build builds the query of opts and appends the arguments of its filters to
+// args.
+func (lq ListQuery) build(opts ListOptions, args []any) (query string, _ []any, err error) {
+	var b strings.Builder
+
+	b.WriteString(lq.Query)
+
+	for _, f := range opts.Filters {
+		if !slices.Contains(lq.Columns, f.Column) {
+			return "", nil, fmt.Errorf("%w: %s", ErrInvalidColumn, f.Column)
+		}
+
+		switch f.Operator {
+		case OperatorEquals, OperatorGreaterOrEqual, OperatorLess:
+			fmt.Fprintf(&b, " AND %s %s ?", Quote(f.Column), f.Operator)
+			args = append(args, f.Value)
+		case OperatorIn:
+			values, ok := f.Value.([]any)
+			if !ok || len(values) == 0 {
+				return "", nil, ErrInvalidFilterList
+			}
+
+			fmt.Fprintf(&b, " AND %s IN (?%s)", Quote(f.Column), strings.Repeat(",?", len(values)-1))
+			args = append(args, values...)
+		case OperatorContains:
+			fmt.Fprintf(&b, " AND EXISTS (SELECT 1 FROM json_each(%s) WHERE value = ?)", Quote(f.Column))
+			args = append(args, f.Value)
+		default:
+			return "", nil, fmt.Errorf("%w: %s", ErrInvalidOperator, f.Operator)
+		}
+	}
+
+	order := lq.Key
+	if opts.OrderBy != "" {
+		if !slices.Contains(lq.Columns, opts.OrderBy) {
+			return "", nil, fmt.Errorf("%w: %s", ErrInvalidColumn, opts.OrderBy)
+		}
+
+		order = append([]string{opts.OrderBy}, lq.Key...)
+	}
+
+	for i, col := range order {
+		if i == 0 {
+			b.WriteString(" ORDER BY ")
+		} else {
+			b.WriteString(", ")
+		}
+
+		b.WriteString(Quote(col))
+		if opts.Descending {
+			b.WriteString(" DESC")
+		}
+	}
+
+	// A negative limit returns all rows
+	b.WriteString(" LIMIT ? OFFSET ?")
+	if opts.Limit > 0 {
+		args = append(args, opts.Limit, opts.Offset)
+	} else {
+		args = append(args, -1, opts.Offset)
+	}
+
+	return b.String(), args, nil
+}
+
+// ListWithOptions lists the objects like [ops.List], but filters, sorts and
+// paginates them according to opts in the database. T needs to be a
+// [QueryableObject].
+func (ops *ops[T]) ListWithOptions(ctx context.Context, opts ListOptions, args ...any) (list []T, err error) {
+	ctx, end := ops.instrument(ctx, "List")
+	defer func() { end(err) }()
+
+	var t T
+
+	qo, ok := any(t).(QueryableObject)
+	if !ok {
+		return nil, ErrUnsupportedList
+	}
+
+	query, args, err := qo.ListQuery().build(opts, args)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := ops.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("could not execute query: %w", err)
+	}
+	defer rows.Close()
+
+	list = make([]T, 0)
+	for rows.Next() {
+		var obj StorageObject
+		obj, err = t.Scan(rows)
+		if err != nil {
+			return nil, fmt.Errorf("could not scan object: %w", err)
+		}
+
+		list = append(list, obj.(T))
+	}
+
+	return list, rows.Err()
+}
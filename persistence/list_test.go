@@ -0,0 +1,100 @@
+// Copyright 2023 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package persistence
+
+import (
+	"testing"
+
+	"github.com/oxisto/assert"
+)
+
+func TestListQuery_build(t *testing.T) {
+	lq := ListQuery{
+		Query:   "SELECT id FROM things WHERE owner = ?",
+		Columns: []string{"time", "type", "tags"},
+		Key:     []string{"id"},
+	}
+
+	type args struct {
+		opts ListOptions
+	}
+	tests := []struct {
+		name      string
+		args      args
+		wantQuery string
+		wantArgs  []any
+		wantErr   error
+	}{
+		{
+			name:      "all",
+			args:      args{},
+			wantQuery: `SELECT id FROM things WHERE owner = ? ORDER BY "id" LIMIT ? OFFSET ?`,
+			wantArgs:  []any{"money", -1, int64(0)},
+		},
+		{
+			name: "filtered, sorted and paginated",
+			args: args{opts: ListOptions{
+				Filters: []Filter{
+					{Column: "time", Operator: OperatorGreaterOrEqual, Value: "2020-01-01"},
+					{Column: "type", Operator: OperatorIn, Value: []any{1, 2}},
+				},
+				OrderBy:    "time",
+				Descending: true,
+				Limit:      11,
+				Offset:     10,
+			}},
+			wantQuery: `SELECT id FROM things WHERE owner = ? AND "time" >= ? AND "type" IN (?,?) ORDER BY "time" DESC, "id" DESC LIMIT ? OFFSET ?`,
+			wantArgs:  []any{"money", "2020-01-01", 1, 2, int64(11), int64(10)},
+		},
+		{
+			name:      "filtered by tag",
+			args:      args{opts: ListOptions{Filters: []Filter{{Column: "tags", Operator: OperatorContains, Value: "growth"}}}},
+			wantQuery: `SELECT id FROM things WHERE owner = ? AND EXISTS (SELECT 1 FROM json_each("tags") WHERE value = ?) ORDER BY "id" LIMIT ? OFFSET ?`,
+			wantArgs:  []any{"money", "growth", -1, int64(0)},
+		},
+		{
+			name:    "unknown filter column",
+			args:    args{opts: ListOptions{Filters: []Filter{{Column: "owner", Operator: OperatorEquals}}}},
+			wantErr: ErrInvalidColumn,
+		},
+		{
+			name:    "unknown order column",
+			args:    args{opts: ListOptions{OrderBy: "owner"}},
+			wantErr: ErrInvalidColumn,
+		},
+		{
+			name:    "invalid operator",
+			args:    args{opts: ListOptions{Filters: []Filter{{Column: "type", Operator: "LIKE"}}}},
+			wantErr: ErrInvalidOperator,
+		},
+		{
+			name:    "empty list",
+			args:    args{opts: ListOptions{Filters: []Filter{{Column: "type", Operator: OperatorIn, Value: []any{}}}}},
+			wantErr: ErrInvalidFilterList,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotQuery, gotArgs, err := lq.build(tt.args.opts, []any{"money"})
+			assert.ErrorIs(t, tt.wantErr, err)
+			assert.Equals(t, tt.wantQuery, gotQuery)
+			if tt.wantErr == nil {
+				assert.Equals(t, tt.wantArgs, gotArgs)
+			}
+		})
+	}
+}
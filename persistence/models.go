@@ -6,8 +6,123 @@ package persistence
 
 import (
 	"database/sql"
+	"time"
 )
 
+// AccessToken is a personal access token of a user.
+type AccessToken struct {
+	// ID is the primary identifier for an access token.
+	ID string
+	// DisplayName is the human-readable name of the access token.
+	DisplayName string
+	// Owner is the subject of the user that owns the access token.
+	Owner string
+	// Scopes contains the space-separated permissions that are granted to the access token.
+	Scopes string
+	// Hash is the SHA-256 hash of the secret token.
+	Hash string
+	// CreateTime is the time when the access token was created.
+	CreateTime time.Time
+	// ExpireTime is the time when the access token expires.
+	ExpireTime sql.NullTime
+	// LastUsedTime is the time when the access token was last used.
+	LastUsedTime sql.NullTime
+}
+
+// AlertRule describes a condition of a portfolio that a user wants to be notified about.
+type AlertRule struct {
+	// ID is the primary identifier for an alert rule.
+	ID string
+	// DisplayName is the human-readable name of the alert rule.
+	DisplayName string
+	// Owner is the subject of the user that owns the alert rule.
+	Owner string
+	// Type is the condition of the alert rule.
+	Type int64
+	// PortfolioID is the ID of the portfolio whose positions are checked.
+	PortfolioID string
+	// SecurityID restricts the alert rule to the position of this security, if it is not empty.
+	SecurityID string
+	// Percentage is the relative loss of a position drop.
+	Percentage float64
+	// Threshold is the total portfolio value for the portfolio value conditions.
+	Threshold sql.NullInt64
+	// ThresholdCurrency is the currency of the threshold.
+	ThresholdCurrency sql.NullString
+	// MaxQuoteAge is the age in seconds after which a quote is stale.
+	MaxQuoteAge int64
+	// Channels contains the comma-separated names of the notification channels.
+	Channels string
+	// Active is true while the condition of the alert rule is met.
+	Active bool
+}
+
+// Attachment is a document that belongs to a portfolio event.
+type Attachment struct {
+	// ID is the primary identifier for an attachment.
+	ID string
+	// EventID is the ID of the portfolio event of the attachment.
+	EventID string
+	// FileName is the name of the attached file.
+	FileName string
+	// ContentType is the media type of the content.
+	ContentType string
+	// Size is the size of the content in bytes.
+	Size int64
+	// Hash is the hex-encoded SHA-256 hash of the content.
+	Hash string
+	// CreateTime is the time when the attachment was uploaded.
+	CreateTime time.Time
+}
+
+// AttachmentBlob is the content of attachments that are stored in the database.
+type AttachmentBlob struct {
+	// Hash is the hex-encoded SHA-256 hash of the content.
+	Hash string
+	// Content is the content of the attachments.
+	Content []byte
+}
+
+// AuditEvent records a change of a resource by a user.
+type AuditEvent struct {
+	// ID is the primary identifier for an audit event.
+	ID int64
+	// Time is the time when the change was made.
+	Time time.Time
+	// Subject is the subject of the user that made the change.
+	Subject string
+	// Procedure is the RPC procedure that made the change.
+	Procedure string
+	// Action is the kind of change, e.g., create, update, delete or import.
+	Action string
+	// ResourceType is the name of the message of the changed resource.
+	ResourceType string
+	// ResourceID is the identifier of the changed resource.
+	ResourceID string
+	// UpdateMask contains the comma-separated paths of updated fields.
+	UpdateMask string
+	// Before contains the JSON representation of the resource before the change.
+	Before sql.NullString
+	// After contains the JSON representation of the resource after the change.
+	After sql.NullString
+}
+
+// BankAccount represents a bank account of a user.
+type BankAccount struct {
+	// ID is the primary identifier for a bank account.
+	ID string
+	// DisplayName is the human-readable name of the bank account.
+	DisplayName string
+	// Owner is the subject of the user that owns the bank account.
+	Owner string
+	// Currency is the ISO 4217 code of the currency of the bank account.
+	Currency string
+	// IBAN is the international bank account number of the bank account.
+	Iban string
+	// Institution is the name of the bank that holds the bank account.
+	Institution string
+}
+
 // ListedSecurity represents a security that is listed on a particular exchange.
 type ListedSecurity struct {
 	// SecurityID is the ID of the security.
@@ -20,6 +135,105 @@ type ListedSecurity struct {
 	LatestQuote sql.NullInt64
 	// LatestQuoteTimestamp is the timestamp of the latest quote.
 	LatestQuoteTimestamp sql.NullTime
+	// PreviousQuote is the last quote of the day before the latest quote.
+	PreviousQuote sql.NullInt64
+}
+
+// Portfolio represents a portfolio of a user.
+type Portfolio struct {
+	// ID is the primary identifier for a portfolio.
+	ID string
+	// DisplayName is the human-readable name of the portfolio.
+	DisplayName string
+	// Owner is the subject of the user that owns the portfolio.
+	Owner string
+	// DeleteTime is the time when the portfolio was moved to the trash.
+	DeleteTime sql.NullTime
+	// BankAccountID is the ID of the bank account that the transactions of the portfolio settle against.
+	BankAccountID string
+	// Note is a free-form note about the portfolio.
+	Note string
+	// Tags is a JSON array of the tags of the portfolio.
+	Tags string
+}
+
+// PortfolioEvent represents a transaction or another event of a portfolio.
+type PortfolioEvent struct {
+	// ID is the primary identifier for a portfolio event.
+	ID string
+	// Type is the type of the event, e.g., buy or sell.
+	Type int64
+	// Time is the time when the event happened.
+	Time time.Time
+	// PortfolioID is the ID of the portfolio of the event.
+	PortfolioID string
+	// SecurityID is the ID of the security of the event.
+	SecurityID string
+	// Amount is the amount of shares.
+	Amount sql.NullFloat64
+	// Price is the price of one share.
+	Price sql.NullInt64
+	// Fees are the fees of the event.
+	Fees sql.NullInt64
+	// Taxes are the taxes of the event.
+	Taxes sql.NullInt64
+	// DeleteTime is the time when the portfolio event was moved to the trash.
+	DeleteTime sql.NullTime
+	// DeletedWithPortfolio is true if the portfolio event was moved to the trash together with its portfolio.
+	DeletedWithPortfolio bool
+	// TransferID links the events of both sides of a transfer between portfolios.
+	TransferID string
+	// PurchaseTime is the time when the shares of an inbound transfer were originally bought.
+	PurchaseTime sql.NullTime
+	// Note is a free-form note about the portfolio event, e.g., why a security
+	// was bought.
+	Note string
+	// Tags is a JSON array of the tags of the portfolio event.
+	Tags string
+	// SavingsPlanID is the ID of the savings plan that created the event.
+	SavingsPlanID string
+	// Pending is set for expected executions of a savings plan.
+	Pending bool
+}
+
+// PortfolioShare shares a portfolio with another user.
+type PortfolioShare struct {
+	// PortfolioID is the ID of the shared portfolio.
+	PortfolioID string
+	// Subject is the subject of the user the portfolio is shared with.
+	Subject string
+	// Access is the portfolio access of the user, e.g., read or write.
+	Access int64
+}
+
+// SavingsPlan buys a security for a portfolio in regular intervals.
+type SavingsPlan struct {
+	// ID is the primary identifier for a savings plan.
+	ID string
+	// PortfolioID is the ID of the portfolio the security is bought for.
+	PortfolioID string
+	// SecurityID is the ID of the security that is bought.
+	SecurityID string
+	// DisplayName is the human-readable name of the savings plan.
+	DisplayName string
+	// Amount is the number of shares that are bought on each execution.
+	Amount float64
+	// Value is the amount of money that is invested on each execution.
+	Value int64
+	// IntervalMonths is the number of months between two executions.
+	IntervalMonths int64
+	// StartTime is the time of the first execution.
+	StartTime time.Time
+	// EndTime is the time after which the plan is not executed anymore.
+	EndTime sql.NullTime
+	// FixedFee is the fee that applies to each execution.
+	FixedFee int64
+	// FeePercentage is the part of the invested value that applies as a fee.
+	FeePercentage float64
+	// LastExecutionTime is the time of the last execution that was created.
+	LastExecutionTime sql.NullTime
+	// CreateTime is the time when the savings plan was created.
+	CreateTime time.Time
 }
 
 // Security represents a security that can be traded on an exchange.
@@ -30,4 +244,56 @@ type Security struct {
 	DisplayName string
 	// QuoteProvider is the name of the provider that provides quotes for this security.
 	QuoteProvider sql.NullString
+	// DeleteTime is the time when the security was moved to the trash.
+	DeleteTime sql.NullTime
+	// Note is a free-form note about the security.
+	Note string
+	// Tags is a JSON array of the tags of the security.
+	Tags string
+}
+
+// TokenOwner contains the roles of a user the last time it authenticated with the identity provider, which restrict the permissions of its access tokens.
+type TokenOwner struct {
+	// Owner is the subject of the user.
+	Owner string
+	// Roles contains the space-separated roles of the user.
+	Roles string
+	// UpdateTime is the time when the roles were last updated.
+	UpdateTime time.Time
+}
+
+// TriggeredAlert records that the condition of an alert rule was met.
+type TriggeredAlert struct {
+	// ID is the primary identifier for a triggered alert.
+	ID int64
+	// RuleID is the ID of the alert rule that was triggered.
+	RuleID string
+	// Owner is the subject of the user that owns the alert rule.
+	Owner string
+	// Time is the time when the alert rule was triggered.
+	Time time.Time
+	// Message describes why the alert rule was triggered.
+	Message string
+}
+
+// Watchlist contains securities that a user follows without holding them.
+type Watchlist struct {
+	// ID is the primary identifier for a watchlist.
+	ID string
+	// DisplayName is the human-readable name of the watchlist.
+	DisplayName string
+	// Owner is the subject of the user that owns the watchlist.
+	Owner string
+}
+
+// WatchlistEntry is a security on a watchlist.
+type WatchlistEntry struct {
+	// WatchlistID is the ID of the watchlist.
+	WatchlistID string
+	// SecurityID is the ID of the security.
+	SecurityID string
+	// AddTime is the time when the security was added to the watchlist.
+	AddTime time.Time
+	// AddedQuote is the latest quote of the security at the time it was added.
+	AddedQuote sql.NullInt64
 }
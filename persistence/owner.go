@@ -0,0 +1,62 @@
+// Copyright 2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+)
+
+// ErrMissingOwner is returned by [AssignOwner] if no owner is specified.
+var ErrMissingOwner = errors.New("an owner is required")
+
+// AssignOwner assigns all portfolios and bank accounts that do not have an
+// owner yet to the specified owner. This is needed for databases that were
+// created before entities were owned by users, since unowned entities are not
+// visible to anyone.
+func AssignOwner(ctx context.Context, db *DB, owner string) (n int64, err error) {
+	if owner == "" {
+		return 0, ErrMissingOwner
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	for _, table := range []string{"portfolios", "bank_accounts"} {
+		res, err := tx.ExecContext(ctx, "UPDATE "+table+" SET owner = ? WHERE owner = ''", owner)
+		if err != nil {
+			return 0, fmt.Errorf("could not assign owner to %s: %w", table, err)
+		}
+
+		affected, _ := res.RowsAffected()
+		n += affected
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return 0, err
+	}
+
+	slog.Info("Assigned owner to unowned entities", "owner", owner, "count", n)
+
+	return n, nil
+}
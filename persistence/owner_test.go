@@ -0,0 +1,76 @@
+// Copyright 2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package persistence
+
+import (
+	"context"
+	"testing"
+
+	"github.com/oxisto/assert"
+)
+
+func TestAssignOwner(t *testing.T) {
+	db, _, err := OpenDB(Options{UseInMemory: true})
+	if err != nil {
+		t.Fatalf("could not open database: %v", err)
+	}
+
+	_, err = db.Exec(`INSERT INTO portfolios (id, display_name) VALUES ('legacy', 'Legacy'), ('other', 'Other');
+UPDATE portfolios SET owner = 'gopher' WHERE id = 'other';
+INSERT INTO bank_accounts (id, display_name) VALUES ('cash', 'Cash');`)
+	if err != nil {
+		t.Fatalf("could not insert data: %v", err)
+	}
+
+	type args struct {
+		owner string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantN   int64
+		wantErr error
+	}{
+		{
+			name:    "missing owner",
+			args:    args{owner: ""},
+			wantErr: ErrMissingOwner,
+		},
+		{
+			name:  "happy path",
+			args:  args{owner: "money"},
+			wantN: 2,
+		},
+		{
+			name:  "nothing left to assign",
+			args:  args{owner: "money"},
+			wantN: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotN, err := AssignOwner(context.Background(), db, tt.args.owner)
+			assert.ErrorIs(t, tt.wantErr, err)
+			assert.Equals(t, tt.wantN, gotN)
+		})
+	}
+
+	var owner string
+	err = db.QueryRow("SELECT owner FROM portfolios WHERE id = 'other'").Scan(&owner)
+	assert.NoError(t, err)
+	assert.Equals(t, "gopher", owner)
+}
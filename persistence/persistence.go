@@ -22,9 +22,9 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
-	"log"
 	"log/slog"
 	"strings"
+	"time"
 
 	_ "github.com/mattn/go-sqlite3"
 	"github.com/oxisto/money-gopher/persistence/sql/migrations"
@@ -39,36 +39,63 @@ type Options struct {
 
 	// DSN contains the DSN, such as the file name of our sqlite database
 	DSN string
+
+	// Passphrase is used to derive the key that encrypts the database at rest.
+	// If either this or KeyFile is set, the database is held in memory and
+	// written encrypted to the file specified by DSN FlushDelay after a change.
+	// Only one process can open an encrypted database at the same time.
+	Passphrase string
+
+	// KeyFile is the path to a file that contains the secret that is used to
+	// derive the key that encrypts the database at rest. It takes precedence
+	// over Passphrase.
+	KeyFile string
+
+	// FlushDelay is the time an encrypted database waits after a change before
+	// it is written to disk, so that several changes are written together.
+	// Committed changes within this time are lost if the process crashes. If
+	// it is zero, the database is written right after every change.
+	FlushDelay time.Duration
 }
 
 // LogValue implements slog.LogValuer.
 func (o Options) LogValue() slog.Value {
 	return slog.GroupValue(
 		slog.Bool("in-memory", o.UseInMemory),
-		slog.String("dsn", o.DSN))
+		slog.String("dsn", o.DSN),
+		slog.Bool("encrypted", o.encrypted()))
 }
 
 // DB is a type alias around [sql.DB] to avoid importing the [database/sql] package.
 type DB = sql.DB
 
+// Preparer prepares and executes queries. It is implemented by [DB] and
+// [sql.Tx], so that storage operations can also run within a transaction.
+type Preparer interface {
+	Prepare(query string) (*sql.Stmt, error)
+	Exec(query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+}
+
 type StorageObject interface {
-	InitTables(db *DB) (err error)
-	PrepareReplace(db *DB) (stmt *sql.Stmt, err error)
-	PrepareList(db *DB) (stmt *sql.Stmt, err error)
-	PrepareGet(db *DB) (stmt *sql.Stmt, err error)
-	PrepareUpdate(db *DB, columns []string) (stmt *sql.Stmt, err error)
-	PrepareDelete(db *DB) (stmt *sql.Stmt, err error)
+	InitTables(db Preparer) (err error)
+	PrepareReplace(db Preparer) (stmt *sql.Stmt, err error)
+	PrepareList(db Preparer) (stmt *sql.Stmt, err error)
+	PrepareGet(db Preparer) (stmt *sql.Stmt, err error)
+	PrepareUpdate(db Preparer, columns []string) (stmt *sql.Stmt, err error)
+	PrepareDelete(db Preparer) (stmt *sql.Stmt, err error)
 	ReplaceIntoArgs() []any
 	UpdateArgs([]string) []any
 	Scan(sc Scanner) (StorageObject, error)
 }
 
 type StorageOperations[T StorageObject] interface {
-	Replace(o StorageObject) (err error)
-	List(args ...any) (list []T, err error)
-	Get(key any) (obj T, err error)
-	Update(key any, in T, columns []string) (out T, err error)
-	Delete(key any) (err error)
+	Replace(ctx context.Context, o StorageObject) (err error)
+	List(ctx context.Context, args ...any) (list []T, err error)
+	ListWithOptions(ctx context.Context, opts ListOptions, args ...any) (list []T, err error)
+	Get(ctx context.Context, key any) (obj T, err error)
+	Update(ctx context.Context, key any, in T, columns []string) (out T, err error)
+	Delete(ctx context.Context, key any) (err error)
 }
 
 type Scanner interface {
@@ -76,18 +103,22 @@ type Scanner interface {
 }
 
 type ops[T StorageObject] struct {
-	*DB
+	db Preparer
 }
 
 // OpenDB opens a connection to our database.
 func OpenDB(opts Options) (db *DB, q *Queries, err error) {
 	if opts.UseInMemory {
-		opts.DSN = ":memory:?_pragma=foreign_keys(1)"
+		opts.DSN = ":memory:?_foreign_keys=1"
 	} else if opts.DSN == "" {
 		opts.DSN = "money.db"
 	}
 
-	db, err = sql.Open("sqlite3", opts.DSN)
+	if opts.encrypted() {
+		db, err = openEncryptedDB(opts)
+	} else {
+		db, err = sql.Open("sqlite3", opts.DSN)
+	}
 	if err != nil {
 		return nil, nil, fmt.Errorf("could not open database: %w", err)
 	}
@@ -97,47 +128,99 @@ func OpenDB(opts Options) (db *DB, q *Queries, err error) {
 	// Prepare database migrations with goose
 	provider, err := goose.NewProvider(database.DialectSQLite3, db, migrations.Embed)
 	if err != nil {
-		log.Fatal(err)
+		return nil, nil, errors.Join(fmt.Errorf("could not prepare migrations: %w", err), Close(db))
 	}
 
 	// Apply all migrations
 	results, err := provider.Up(context.Background())
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, errors.Join(fmt.Errorf("could not apply migrations: %w", err), Close(db))
 	}
 
 	for _, result := range results {
 		slog.Debug("Applied migration.", "migration", result)
 	}
 
-	// Create a new query object
-	q = New(db)
+	// Write the changes of the migrations of an encrypted database to disk
+	// right away
+	if v, ok := vaults.Load(db); ok {
+		err = v.(*vault).flush()
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not write encrypted database: %w", err)
+		}
+	}
+
+	// Create a new query object, which records the duration of all queries
+	q = New(observedDB{db})
 
 	return
 }
 
-func Ops[T StorageObject](db *DB) StorageOperations[T] {
-	return &ops[T]{DB: db}
+// Ops returns the storage operations for T. If db is a transaction, the
+// operations are bound to it.
+func Ops[T StorageObject](db Preparer) StorageOperations[T] {
+	return &ops[T]{db: db}
 }
 
 func Relationship[T StorageObject, S StorageObject](op StorageOperations[S]) StorageOperations[T] {
-	return &ops[T]{DB: op.(*ops[S]).DB}
+	return &ops[T]{db: op.(*ops[S]).db}
+}
+
+// ErrNestedTransaction is returned by [InTx], if the storage operations are
+// already bound to a transaction.
+var ErrNestedTransaction = errors.New("storage operations are already part of a transaction")
+
+// InTx runs f within a database transaction. The storage operations passed to
+// f are bound to the transaction, which is committed if f returns no error and
+// rolled back otherwise. Other storage operations must not be used within f,
+// since they would not see the changes of the transaction.
+func InTx[T StorageObject](ctx context.Context, op StorageOperations[T], f func(op StorageOperations[T]) error) (err error) {
+	o, ok := op.(*ops[T])
+	if !ok {
+		return ErrNestedTransaction
+	}
+
+	db, ok := o.db.(*DB)
+	if !ok {
+		return ErrNestedTransaction
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("could not begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	err = f(&ops[T]{db: tx})
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("could not commit transaction: %w", err)
+	}
+
+	return nil
 }
 
-func (ops *ops[T]) Replace(o StorageObject) (err error) {
+func (ops *ops[T]) Replace(ctx context.Context, o StorageObject) (err error) {
+	ctx, end := ops.instrument(ctx, "Replace")
+	defer func() { end(err) }()
+
 	// TODO(oxisto): Move to db.initTables
-	err = o.InitTables(ops.DB)
+	err = o.InitTables(ops.db)
 	if err != nil {
 		return fmt.Errorf("could not init table: %w", err)
 	}
 
 	// TODO: "prepare" it somewhere else
-	stmt, err := o.PrepareReplace(ops.DB)
+	stmt, err := o.PrepareReplace(ops.db)
 	if err != nil {
 		return fmt.Errorf("could not prepare query: %w", err)
 	}
 
-	res, err := stmt.Exec(o.ReplaceIntoArgs()...)
+	res, err := stmt.ExecContext(ctx, o.ReplaceIntoArgs()...)
 	if err != nil {
 		return fmt.Errorf("could not execute query: %w", err)
 	}
@@ -153,7 +236,10 @@ func (ops *ops[T]) Replace(o StorageObject) (err error) {
 // List lists stuff. Because methods cannot have type parameters (unless the
 // struct has one), we need to make this a function (for now) and pass the [DB]
 // as the first parameter.
-func (ops *ops[T]) List(args ...any) (list []T, err error) {
+func (ops *ops[T]) List(ctx context.Context, args ...any) (list []T, err error) {
+	ctx, end := ops.instrument(ctx, "List")
+	defer func() { end(err) }()
+
 	// We need to construct a pointer to the underlying type that fulfills T in
 	// order to access some of the database functions.
 	var t T
@@ -161,12 +247,12 @@ func (ops *ops[T]) List(args ...any) (list []T, err error) {
 	list = make([]T, 0)
 
 	// TODO(oxisto): prepare query at DB init
-	stmt, err := t.PrepareList(ops.DB)
+	stmt, err := t.PrepareList(ops.db)
 	if err != nil {
 		return nil, fmt.Errorf("could not prepare query: %w", err)
 	}
 
-	rows, err := stmt.Query(args...)
+	rows, err := stmt.QueryContext(ctx, args...)
 	if err != nil {
 		return nil, fmt.Errorf("could not execute query: %w", err)
 	}
@@ -185,7 +271,10 @@ func (ops *ops[T]) List(args ...any) (list []T, err error) {
 	return
 }
 
-func (ops *ops[T]) Get(key any) (obj T, err error) {
+func (ops *ops[T]) Get(ctx context.Context, key any) (obj T, err error) {
+	ctx, end := ops.instrument(ctx, "Get")
+	defer func() { end(err) }()
+
 	var (
 		row  *sql.Row
 		tmp  StorageObject
@@ -194,7 +283,7 @@ func (ops *ops[T]) Get(key any) (obj T, err error) {
 	)
 
 	// TODO(oxisto): prepare query at DB init
-	stmt, err := obj.PrepareGet(ops.DB)
+	stmt, err := obj.PrepareGet(ops.db)
 	if err != nil {
 		return obj, fmt.Errorf("could not prepare query: %w", err)
 	}
@@ -205,7 +294,7 @@ func (ops *ops[T]) Get(key any) (obj T, err error) {
 		args = []any{key}
 	}
 
-	row = stmt.QueryRow(args...)
+	row = stmt.QueryRowContext(ctx, args...)
 	tmp, err = obj.Scan(row)
 	if err != nil && errors.Is(err, sql.ErrNoRows) {
 		return obj, nil
@@ -218,14 +307,17 @@ func (ops *ops[T]) Get(key any) (obj T, err error) {
 	return
 }
 
-func (ops *ops[T]) Update(key any, in T, columns []string) (out T, err error) {
+func (ops *ops[T]) Update(ctx context.Context, key any, in T, columns []string) (out T, err error) {
+	ctx, end := ops.instrument(ctx, "Update")
+	defer func() { end(err) }()
+
 	var (
 		args []any
 		keys []any
 		ok   bool
 	)
 	// TODO(oxisto): cache somehow
-	stmt, err := in.PrepareUpdate(ops.DB, columns)
+	stmt, err := in.PrepareUpdate(ops.db, columns)
 	if err != nil {
 		return out, fmt.Errorf("could not prepare query: %w", err)
 	}
@@ -239,7 +331,7 @@ func (ops *ops[T]) Update(key any, in T, columns []string) (out T, err error) {
 		args = append(args, key)
 	}
 
-	res, err := stmt.Exec(args...)
+	res, err := stmt.ExecContext(ctx, args...)
 	if err != nil {
 		return out, fmt.Errorf("could not execute query: %w", err)
 	}
@@ -250,21 +342,32 @@ func (ops *ops[T]) Update(key any, in T, columns []string) (out T, err error) {
 	}
 
 	// Need to fetch it again
-	return ops.Get(key)
+	return ops.Get(ctx, key)
 }
 
-func (ops *ops[T]) Delete(key any) (err error) {
+func (ops *ops[T]) Delete(ctx context.Context, key any) (err error) {
+	ctx, end := ops.instrument(ctx, "Delete")
+	defer func() { end(err) }()
+
 	var (
-		t T
+		t    T
+		args []any
+		ok   bool
 	)
 
 	// TODO(oxisto): cache somehow
-	stmt, err := t.PrepareDelete(ops.DB)
+	stmt, err := t.PrepareDelete(ops.db)
 	if err != nil {
 		return fmt.Errorf("could not prepare query: %w", err)
 	}
 
-	res, err := stmt.Exec(key)
+	// split composite keys
+	if args, ok = key.([]any); !ok {
+		// otherwise, use single key
+		args = []any{key}
+	}
+
+	res, err := stmt.ExecContext(ctx, args...)
 	if err != nil {
 		return fmt.Errorf("could not execute query: %w", err)
 	}
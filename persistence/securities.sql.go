@@ -14,7 +14,7 @@ const createSecurity = `-- name: CreateSecurity :one
 INSERT INTO
     securities (id, display_name)
 VALUES
-    (?, ?) RETURNING id, display_name, quote_provider
+    (?, ?) RETURNING id, display_name, quote_provider, delete_time, note, tags
 `
 
 type CreateSecurityParams struct {
@@ -25,7 +25,14 @@ type CreateSecurityParams struct {
 func (q *Queries) CreateSecurity(ctx context.Context, arg CreateSecurityParams) (*Security, error) {
 	row := q.db.QueryRowContext(ctx, createSecurity, arg.ID, arg.DisplayName)
 	var i Security
-	err := row.Scan(&i.ID, &i.DisplayName, &i.QuoteProvider)
+	err := row.Scan(
+		&i.ID,
+		&i.DisplayName,
+		&i.QuoteProvider,
+		&i.DeleteTime,
+		&i.Note,
+		&i.Tags,
+	)
 	return &i, err
 }
 
@@ -33,7 +40,7 @@ const deleteListedSecurity = `-- name: DeleteListedSecurity :one
 DELETE FROM listed_securities
 WHERE
     security_id = ?
-    AND ticker = ? RETURNING security_id, ticker, currency, latest_quote, latest_quote_timestamp
+    AND ticker = ? RETURNING security_id, ticker, currency, latest_quote, latest_quote_timestamp, previous_quote
 `
 
 type DeleteListedSecurityParams struct {
@@ -50,29 +57,38 @@ func (q *Queries) DeleteListedSecurity(ctx context.Context, arg DeleteListedSecu
 		&i.Currency,
 		&i.LatestQuote,
 		&i.LatestQuoteTimestamp,
+		&i.PreviousQuote,
 	)
 	return &i, err
 }
 
 const getSecurity = `-- name: GetSecurity :one
 SELECT
-    id, display_name, quote_provider
+    id, display_name, quote_provider, delete_time, note, tags
 FROM
     securities
 WHERE
     id = ?
+    AND delete_time IS NULL
 `
 
 func (q *Queries) GetSecurity(ctx context.Context, id string) (*Security, error) {
 	row := q.db.QueryRowContext(ctx, getSecurity, id)
 	var i Security
-	err := row.Scan(&i.ID, &i.DisplayName, &i.QuoteProvider)
+	err := row.Scan(
+		&i.ID,
+		&i.DisplayName,
+		&i.QuoteProvider,
+		&i.DeleteTime,
+		&i.Note,
+		&i.Tags,
+	)
 	return &i, err
 }
 
 const listListedSecuritiesBySecurityID = `-- name: ListListedSecuritiesBySecurityID :many
 SELECT
-    listed_securities.security_id, listed_securities.ticker, listed_securities.currency, listed_securities.latest_quote, listed_securities.latest_quote_timestamp
+    listed_securities.security_id, listed_securities.ticker, listed_securities.currency, listed_securities.latest_quote, listed_securities.latest_quote_timestamp, listed_securities.previous_quote
 FROM
     listed_securities,
     securities
@@ -96,6 +112,7 @@ func (q *Queries) ListListedSecuritiesBySecurityID(ctx context.Context, id strin
 			&i.Currency,
 			&i.LatestQuote,
 			&i.LatestQuoteTimestamp,
+			&i.PreviousQuote,
 		); err != nil {
 			return nil, err
 		}
@@ -112,9 +129,11 @@ func (q *Queries) ListListedSecuritiesBySecurityID(ctx context.Context, id strin
 
 const listSecurities = `-- name: ListSecurities :many
 SELECT
-    id, display_name, quote_provider
+    id, display_name, quote_provider, delete_time, note, tags
 FROM
     securities
+WHERE
+    delete_time IS NULL
 ORDER BY
     id
 `
@@ -128,7 +147,14 @@ func (q *Queries) ListSecurities(ctx context.Context) ([]*Security, error) {
 	var items []*Security
 	for rows.Next() {
 		var i Security
-		if err := rows.Scan(&i.ID, &i.DisplayName, &i.QuoteProvider); err != nil {
+		if err := rows.Scan(
+			&i.ID,
+			&i.DisplayName,
+			&i.QuoteProvider,
+			&i.DeleteTime,
+			&i.Note,
+			&i.Tags,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -148,7 +174,7 @@ SET
     display_name = ?,
     quote_provider = ?
 WHERE
-    id = ? RETURNING id, display_name, quote_provider
+    id = ? RETURNING id, display_name, quote_provider, delete_time, note, tags
 `
 
 type UpdateSecurityParams struct {
@@ -160,7 +186,14 @@ type UpdateSecurityParams struct {
 func (q *Queries) UpdateSecurity(ctx context.Context, arg UpdateSecurityParams) (*Security, error) {
 	row := q.db.QueryRowContext(ctx, updateSecurity, arg.DisplayName, arg.QuoteProvider, arg.ID)
 	var i Security
-	err := row.Scan(&i.ID, &i.DisplayName, &i.QuoteProvider)
+	err := row.Scan(
+		&i.ID,
+		&i.DisplayName,
+		&i.QuoteProvider,
+		&i.DeleteTime,
+		&i.Note,
+		&i.Tags,
+	)
 	return &i, err
 }
 
@@ -172,7 +205,7 @@ VALUES
 UPDATE
 SET
     ticker = excluded.ticker,
-    currency = excluded.currency RETURNING security_id, ticker, currency, latest_quote, latest_quote_timestamp
+    currency = excluded.currency RETURNING security_id, ticker, currency, latest_quote, latest_quote_timestamp, previous_quote
 `
 
 type UpsertListedSecurityParams struct {
@@ -190,6 +223,7 @@ func (q *Queries) UpsertListedSecurity(ctx context.Context, arg UpsertListedSecu
 		&i.Currency,
 		&i.LatestQuote,
 		&i.LatestQuoteTimestamp,
+		&i.PreviousQuote,
 	)
 	return &i, err
 }
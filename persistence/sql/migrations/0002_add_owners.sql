@@ -0,0 +1,39 @@
+-- +goose Up
+-- Portfolios and bank accounts were created on demand before, so we need to
+-- make sure they exist before we can add the owner.
+CREATE TABLE
+    IF NOT EXISTS portfolios (
+        -- Portfolio represents a portfolio of a user.
+        id TEXT PRIMARY KEY, -- ID is the primary identifier for a portfolio.
+        display_name TEXT NOT NULL -- DisplayName is the human-readable name of the portfolio.
+    );
+
+CREATE TABLE
+    IF NOT EXISTS bank_accounts (
+        -- BankAccount represents a bank account of a user.
+        id TEXT PRIMARY KEY, -- ID is the primary identifier for a bank account.
+        display_name TEXT NOT NULL -- DisplayName is the human-readable name of the bank account.
+    );
+
+-- Owner is the subject of the user that owns the portfolio. Existing
+-- portfolios do not have an owner until one is assigned.
+ALTER TABLE portfolios
+ADD COLUMN owner TEXT NOT NULL DEFAULT '';
+
+-- Owner is the subject of the user that owns the bank account.
+ALTER TABLE bank_accounts
+ADD COLUMN owner TEXT NOT NULL DEFAULT '';
+
+CREATE TABLE
+    IF NOT EXISTS portfolio_shares (
+        -- PortfolioShare shares a portfolio with another user.
+        portfolio_id TEXT NOT NULL, -- PortfolioID is the ID of the shared portfolio.
+        subject TEXT NOT NULL, -- Subject is the subject of the user the portfolio is shared with.
+        access INTEGER NOT NULL, -- Access is the portfolio access of the user, e.g., read or write.
+        PRIMARY KEY (portfolio_id, subject)
+    );
+
+-- +goose Down
+DROP TABLE portfolio_shares;
+ALTER TABLE bank_accounts DROP COLUMN owner;
+ALTER TABLE portfolios DROP COLUMN owner;
@@ -0,0 +1,16 @@
+-- +goose Up
+CREATE TABLE
+    IF NOT EXISTS access_tokens (
+        -- AccessToken is a personal access token of a user.
+        id TEXT PRIMARY KEY, -- ID is the primary identifier for an access token.
+        display_name TEXT NOT NULL, -- DisplayName is the human-readable name of the access token.
+        owner TEXT NOT NULL, -- Owner is the subject of the user that owns the access token.
+        scopes TEXT NOT NULL, -- Scopes contains the space-separated permissions that are granted to the access token.
+        hash TEXT NOT NULL UNIQUE, -- Hash is the SHA-256 hash of the secret token.
+        create_time DATETIME NOT NULL, -- CreateTime is the time when the access token was created.
+        expire_time DATETIME, -- ExpireTime is the time when the access token expires.
+        last_used_time DATETIME -- LastUsedTime is the time when the access token was last used.
+    );
+
+-- +goose Down
+DROP TABLE access_tokens;
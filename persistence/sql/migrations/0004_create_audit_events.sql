@@ -0,0 +1,20 @@
+-- +goose Up
+CREATE TABLE
+    IF NOT EXISTS audit_events (
+        -- AuditEvent records a change of a resource by a user.
+        id INTEGER PRIMARY KEY AUTOINCREMENT, -- ID is the primary identifier for an audit event.
+        time DATETIME NOT NULL, -- Time is the time when the change was made.
+        subject TEXT NOT NULL, -- Subject is the subject of the user that made the change.
+        procedure TEXT NOT NULL, -- Procedure is the RPC procedure that made the change.
+        action TEXT NOT NULL, -- Action is the kind of change, e.g., create, update, delete or import.
+        resource_type TEXT NOT NULL, -- ResourceType is the name of the message of the changed resource.
+        resource_id TEXT NOT NULL, -- ResourceID is the identifier of the changed resource.
+        update_mask TEXT NOT NULL, -- UpdateMask contains the comma-separated paths of updated fields.
+        before TEXT, -- Before contains the JSON representation of the resource before the change.
+        after TEXT -- After contains the JSON representation of the resource after the change.
+    );
+
+CREATE INDEX IF NOT EXISTS audit_events_resource ON audit_events (resource_type, resource_id);
+
+-- +goose Down
+DROP TABLE audit_events;
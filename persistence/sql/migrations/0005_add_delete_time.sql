@@ -0,0 +1,75 @@
+-- +goose Up
+-- Portfolio events were created on demand before, so we need to make sure they
+-- exist before we can add the delete time.
+CREATE TABLE
+    IF NOT EXISTS portfolio_events (
+        -- PortfolioEvent represents a transaction or another event of a portfolio.
+        id TEXT PRIMARY KEY, -- ID is the primary identifier for a portfolio event.
+        type INTEGER NOT NULL, -- Type is the type of the event, e.g., buy or sell.
+        time DATETIME NOT NULL, -- Time is the time when the event happened.
+        portfolio_id TEXT NOT NULL, -- PortfolioID is the ID of the portfolio of the event.
+        security_id TEXT NOT NULL, -- SecurityID is the ID of the security of the event.
+        amount REAL, -- Amount is the amount of shares.
+        price INTEGER, -- Price is the price of one share.
+        fees INTEGER, -- Fees are the fees of the event.
+        taxes INTEGER -- Taxes are the taxes of the event.
+    );
+
+-- DeleteTime is the time when the portfolio was moved to the trash.
+ALTER TABLE portfolios
+ADD COLUMN delete_time DATETIME;
+
+-- DeleteTime is the time when the portfolio event was moved to the trash.
+ALTER TABLE portfolio_events
+ADD COLUMN delete_time DATETIME;
+
+-- DeletedWithPortfolio is true if the portfolio event was moved to the trash
+-- together with its portfolio.
+ALTER TABLE portfolio_events
+ADD COLUMN deleted_with_portfolio BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- DeleteTime is the time when the security was moved to the trash.
+ALTER TABLE securities
+ADD COLUMN delete_time DATETIME;
+
+-- Moving a portfolio to the trash also moves all of its events there, with the
+-- same delete time, so that they can be restored together.
+-- +goose StatementBegin
+CREATE TRIGGER IF NOT EXISTS portfolios_trash AFTER
+UPDATE OF delete_time ON portfolios WHEN OLD.delete_time IS NULL
+AND NEW.delete_time IS NOT NULL BEGIN
+UPDATE portfolio_events
+SET
+    delete_time = NEW.delete_time,
+    deleted_with_portfolio = TRUE
+WHERE
+    portfolio_id = NEW.id
+    AND delete_time IS NULL;
+
+END;
+-- +goose StatementEnd
+
+-- Restoring a portfolio restores all events that were moved to the trash
+-- together with it, but not the ones that were deleted individually before.
+-- +goose StatementBegin
+CREATE TRIGGER IF NOT EXISTS portfolios_restore AFTER
+UPDATE OF delete_time ON portfolios WHEN OLD.delete_time IS NOT NULL
+AND NEW.delete_time IS NULL BEGIN
+UPDATE portfolio_events
+SET
+    delete_time = NULL,
+    deleted_with_portfolio = FALSE
+WHERE
+    portfolio_id = NEW.id
+    AND deleted_with_portfolio;
+
+END;
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TRIGGER portfolios_restore;
+DROP TRIGGER portfolios_trash;
+ALTER TABLE securities DROP COLUMN delete_time;
+ALTER TABLE portfolio_events DROP COLUMN deleted_with_portfolio;
+ALTER TABLE portfolio_events DROP COLUMN delete_time;
+ALTER TABLE portfolios DROP COLUMN delete_time;
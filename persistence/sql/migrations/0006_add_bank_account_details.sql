@@ -0,0 +1,23 @@
+-- +goose Up
+-- Currency is the ISO 4217 code of the currency of the bank account.
+ALTER TABLE bank_accounts
+ADD COLUMN currency TEXT NOT NULL DEFAULT 'EUR';
+
+-- IBAN is the international bank account number of the bank account.
+ALTER TABLE bank_accounts
+ADD COLUMN iban TEXT NOT NULL DEFAULT '';
+
+-- Institution is the name of the bank that holds the bank account.
+ALTER TABLE bank_accounts
+ADD COLUMN institution TEXT NOT NULL DEFAULT '';
+
+-- BankAccountID is the ID of the bank account that the transactions of the
+-- portfolio settle against.
+ALTER TABLE portfolios
+ADD COLUMN bank_account_id TEXT NOT NULL DEFAULT '';
+
+-- +goose Down
+ALTER TABLE portfolios DROP COLUMN bank_account_id;
+ALTER TABLE bank_accounts DROP COLUMN institution;
+ALTER TABLE bank_accounts DROP COLUMN iban;
+ALTER TABLE bank_accounts DROP COLUMN currency;
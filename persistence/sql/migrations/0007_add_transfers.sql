@@ -0,0 +1,13 @@
+-- +goose Up
+-- TransferID links the events of both sides of a transfer between portfolios.
+ALTER TABLE portfolio_events
+ADD COLUMN transfer_id TEXT NOT NULL DEFAULT '';
+
+-- PurchaseTime is the time when the shares of an inbound transfer were
+-- originally bought.
+ALTER TABLE portfolio_events
+ADD COLUMN purchase_time DATETIME;
+
+-- +goose Down
+ALTER TABLE portfolio_events DROP COLUMN purchase_time;
+ALTER TABLE portfolio_events DROP COLUMN transfer_id;
@@ -0,0 +1,29 @@
+-- +goose Up
+-- PreviousQuote is the last quote of the day before the latest quote.
+ALTER TABLE listed_securities
+ADD COLUMN previous_quote INTEGER;
+
+CREATE TABLE
+    IF NOT EXISTS watchlists (
+        -- Watchlist contains securities that a user follows without holding them.
+        id TEXT PRIMARY KEY, -- ID is the primary identifier for a watchlist.
+        display_name TEXT NOT NULL, -- DisplayName is the human-readable name of the watchlist.
+        owner TEXT NOT NULL -- Owner is the subject of the user that owns the watchlist.
+    );
+
+CREATE TABLE
+    IF NOT EXISTS watchlist_entries (
+        -- WatchlistEntry is a security on a watchlist.
+        watchlist_id TEXT NOT NULL, -- WatchlistID is the ID of the watchlist.
+        security_id TEXT NOT NULL, -- SecurityID is the ID of the security.
+        add_time DATETIME NOT NULL, -- AddTime is the time when the security was added to the watchlist.
+        added_quote INTEGER, -- AddedQuote is the first known quote of the security after it was added.
+        FOREIGN KEY (watchlist_id) REFERENCES watchlists (id) ON DELETE CASCADE,
+        FOREIGN KEY (security_id) REFERENCES securities (id) ON DELETE CASCADE,
+        PRIMARY KEY (watchlist_id, security_id)
+    );
+
+-- +goose Down
+DROP TABLE watchlist_entries;
+DROP TABLE watchlists;
+ALTER TABLE listed_securities DROP COLUMN previous_quote;
@@ -0,0 +1,34 @@
+-- +goose Up
+CREATE TABLE
+    IF NOT EXISTS alert_rules (
+        -- AlertRule describes a condition of a portfolio that a user wants to be notified about.
+        id TEXT PRIMARY KEY, -- ID is the primary identifier for an alert rule.
+        display_name TEXT NOT NULL, -- DisplayName is the human-readable name of the alert rule.
+        owner TEXT NOT NULL, -- Owner is the subject of the user that owns the alert rule.
+        type INTEGER NOT NULL, -- Type is the condition of the alert rule.
+        portfolio_id TEXT NOT NULL, -- PortfolioID is the ID of the portfolio whose positions are checked.
+        security_id TEXT NOT NULL, -- SecurityID restricts the alert rule to the position of this security, if it is not empty.
+        percentage REAL NOT NULL, -- Percentage is the relative loss of a position drop.
+        threshold INTEGER, -- Threshold is the total portfolio value for the portfolio value conditions.
+        threshold_currency TEXT, -- ThresholdCurrency is the currency of the threshold.
+        max_quote_age INTEGER NOT NULL, -- MaxQuoteAge is the age in seconds after which a quote is stale.
+        channels TEXT NOT NULL, -- Channels contains the comma-separated names of the notification channels.
+        active BOOLEAN NOT NULL DEFAULT FALSE -- Active is true while the condition of the alert rule is met.
+    );
+
+CREATE TABLE
+    IF NOT EXISTS triggered_alerts (
+        -- TriggeredAlert records that the condition of an alert rule was met.
+        id INTEGER PRIMARY KEY AUTOINCREMENT, -- ID is the primary identifier for a triggered alert.
+        rule_id TEXT NOT NULL, -- RuleID is the ID of the alert rule that was triggered.
+        owner TEXT NOT NULL, -- Owner is the subject of the user that owns the alert rule.
+        time DATETIME NOT NULL, -- Time is the time when the alert rule was triggered.
+        message TEXT NOT NULL, -- Message describes why the alert rule was triggered.
+        FOREIGN KEY (rule_id) REFERENCES alert_rules (id)
+    );
+
+CREATE INDEX IF NOT EXISTS triggered_alerts_owner ON triggered_alerts (owner, time);
+
+-- +goose Down
+DROP TABLE triggered_alerts;
+DROP TABLE alert_rules;
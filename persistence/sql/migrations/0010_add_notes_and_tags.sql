@@ -0,0 +1,33 @@
+-- +goose Up
+-- Note is a free-form note about the portfolio.
+ALTER TABLE portfolios
+ADD COLUMN note TEXT NOT NULL DEFAULT '';
+
+-- Tags is a JSON array of the tags of the portfolio.
+ALTER TABLE portfolios
+ADD COLUMN tags TEXT NOT NULL DEFAULT '[]';
+
+-- Note is a free-form note about the portfolio event, e.g., why a security
+-- was bought.
+ALTER TABLE portfolio_events
+ADD COLUMN note TEXT NOT NULL DEFAULT '';
+
+-- Tags is a JSON array of the tags of the portfolio event.
+ALTER TABLE portfolio_events
+ADD COLUMN tags TEXT NOT NULL DEFAULT '[]';
+
+-- Note is a free-form note about the security.
+ALTER TABLE securities
+ADD COLUMN note TEXT NOT NULL DEFAULT '';
+
+-- Tags is a JSON array of the tags of the security.
+ALTER TABLE securities
+ADD COLUMN tags TEXT NOT NULL DEFAULT '[]';
+
+-- +goose Down
+ALTER TABLE securities DROP COLUMN tags;
+ALTER TABLE securities DROP COLUMN note;
+ALTER TABLE portfolio_events DROP COLUMN tags;
+ALTER TABLE portfolio_events DROP COLUMN note;
+ALTER TABLE portfolios DROP COLUMN tags;
+ALTER TABLE portfolios DROP COLUMN note;
@@ -0,0 +1,30 @@
+-- +goose Up
+CREATE TABLE
+    IF NOT EXISTS attachments (
+        -- Attachment is a document that belongs to a portfolio event.
+        id TEXT PRIMARY KEY, -- ID is the primary identifier for an attachment.
+        event_id TEXT NOT NULL, -- EventID is the ID of the portfolio event of the attachment.
+        file_name TEXT NOT NULL, -- FileName is the name of the attached file.
+        content_type TEXT NOT NULL, -- ContentType is the media type of the content.
+        size INTEGER NOT NULL, -- Size is the size of the content in bytes.
+        hash TEXT NOT NULL, -- Hash is the hex-encoded SHA-256 hash of the content.
+        create_time DATETIME NOT NULL, -- CreateTime is the time when the attachment was uploaded.
+        FOREIGN KEY (event_id) REFERENCES portfolio_events (id)
+    );
+
+CREATE INDEX IF NOT EXISTS attachments_event_id ON attachments (event_id);
+
+CREATE INDEX IF NOT EXISTS attachments_hash ON attachments (hash);
+
+CREATE TABLE
+    IF NOT EXISTS attachment_blobs (
+        -- AttachmentBlob is the content of attachments that are stored in the database.
+        hash TEXT PRIMARY KEY, -- Hash is the hex-encoded SHA-256 hash of the content.
+        content BLOB NOT NULL -- Content is the content of the attachments.
+    );
+
+-- +goose Down
+DROP TABLE attachment_blobs;
+DROP INDEX attachments_hash;
+DROP INDEX attachments_event_id;
+DROP TABLE attachments;
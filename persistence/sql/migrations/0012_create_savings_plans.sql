@@ -0,0 +1,37 @@
+-- +goose Up
+-- SavingsPlanID is the ID of the savings plan that created the portfolio
+-- event.
+ALTER TABLE portfolio_events
+ADD COLUMN savings_plan_id TEXT NOT NULL DEFAULT '';
+
+-- Pending is set for expected executions of a savings plan, until they are
+-- confirmed.
+ALTER TABLE portfolio_events
+ADD COLUMN pending BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE TABLE
+    IF NOT EXISTS savings_plans (
+        -- SavingsPlan buys a security for a portfolio in regular intervals.
+        id TEXT PRIMARY KEY, -- ID is the primary identifier for a savings plan.
+        portfolio_id TEXT NOT NULL, -- PortfolioID is the ID of the portfolio the security is bought for.
+        security_id TEXT NOT NULL, -- SecurityID is the ID of the security that is bought.
+        display_name TEXT NOT NULL, -- DisplayName is the human-readable name of the savings plan.
+        amount REAL NOT NULL, -- Amount is the number of shares that are bought on each execution.
+        value INTEGER NOT NULL, -- Value is the amount of money that is invested on each execution.
+        interval_months INTEGER NOT NULL, -- IntervalMonths is the number of months between two executions.
+        start_time DATETIME NOT NULL, -- StartTime is the time of the first execution.
+        end_time DATETIME, -- EndTime is the time after which the plan is not executed anymore.
+        fixed_fee INTEGER NOT NULL, -- FixedFee is the fee that applies to each execution.
+        fee_percentage REAL NOT NULL, -- FeePercentage is the part of the invested value that applies as a fee.
+        last_execution_time DATETIME, -- LastExecutionTime is the time of the last execution that was created.
+        FOREIGN KEY (portfolio_id) REFERENCES portfolios (id),
+        FOREIGN KEY (security_id) REFERENCES securities (id)
+    );
+
+CREATE INDEX IF NOT EXISTS savings_plans_portfolio_id ON savings_plans (portfolio_id);
+
+-- +goose Down
+DROP INDEX savings_plans_portfolio_id;
+DROP TABLE savings_plans;
+ALTER TABLE portfolio_events DROP COLUMN pending;
+ALTER TABLE portfolio_events DROP COLUMN savings_plan_id;
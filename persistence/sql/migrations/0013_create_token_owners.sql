@@ -0,0 +1,11 @@
+-- +goose Up
+CREATE TABLE
+    IF NOT EXISTS token_owners (
+        -- TokenOwner contains the roles of a user the last time it authenticated with the identity provider, which restrict the permissions of its access tokens.
+        owner TEXT PRIMARY KEY, -- Owner is the subject of the user.
+        roles TEXT NOT NULL, -- Roles contains the space-separated roles of the user.
+        update_time DATETIME NOT NULL -- UpdateTime is the time when the roles were last updated.
+    );
+
+-- +goose Down
+DROP TABLE token_owners;
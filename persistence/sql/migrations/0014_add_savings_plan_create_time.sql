@@ -0,0 +1,8 @@
+-- +goose Up
+-- CreateTime is the time when the savings plan was created. Existing plans
+-- get a time before all of their executions, so that none of them is skipped.
+ALTER TABLE savings_plans
+ADD COLUMN create_time DATETIME NOT NULL DEFAULT '1970-01-01 00:00:00';
+
+-- +goose Down
+ALTER TABLE savings_plans DROP COLUMN create_time;
@@ -0,0 +1,56 @@
+-- +goose Up
+-- Entries refer to their watchlist and security without foreign keys. Once
+-- foreign keys are enforced, REPLACE INTO a watchlist would otherwise delete
+-- all of its entries. Entries are deleted explicitly together with their
+-- watchlist instead.
+CREATE TABLE
+    watchlist_entries_new (
+        -- WatchlistEntry is a security on a watchlist.
+        watchlist_id TEXT NOT NULL, -- WatchlistID is the ID of the watchlist.
+        security_id TEXT NOT NULL, -- SecurityID is the ID of the security.
+        add_time DATETIME NOT NULL, -- AddTime is the time when the security was added to the watchlist.
+        added_quote INTEGER, -- AddedQuote is the latest quote of the security at the time it was added.
+        PRIMARY KEY (watchlist_id, security_id)
+    );
+
+INSERT INTO
+    watchlist_entries_new (watchlist_id, security_id, add_time, added_quote)
+SELECT
+    watchlist_id,
+    security_id,
+    add_time,
+    added_quote
+FROM
+    watchlist_entries;
+
+DROP TABLE watchlist_entries;
+
+ALTER TABLE watchlist_entries_new
+RENAME TO watchlist_entries;
+
+-- +goose Down
+CREATE TABLE
+    watchlist_entries_old (
+        watchlist_id TEXT NOT NULL,
+        security_id TEXT NOT NULL,
+        add_time DATETIME NOT NULL,
+        added_quote INTEGER,
+        FOREIGN KEY (watchlist_id) REFERENCES watchlists (id) ON DELETE CASCADE,
+        FOREIGN KEY (security_id) REFERENCES securities (id) ON DELETE CASCADE,
+        PRIMARY KEY (watchlist_id, security_id)
+    );
+
+INSERT INTO
+    watchlist_entries_old (watchlist_id, security_id, add_time, added_quote)
+SELECT
+    watchlist_id,
+    security_id,
+    add_time,
+    added_quote
+FROM
+    watchlist_entries;
+
+DROP TABLE watchlist_entries;
+
+ALTER TABLE watchlist_entries_old
+RENAME TO watchlist_entries;
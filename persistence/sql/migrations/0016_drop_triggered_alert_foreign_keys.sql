@@ -0,0 +1,62 @@
+-- +goose Up
+-- Triggered alerts refer to their alert rule without a foreign key. Foreign
+-- keys are only enforced for in-memory and encrypted databases, so the same
+-- change would succeed or fail depending on how the database is opened.
+-- Triggered alerts are deleted explicitly together with their alert rule
+-- instead.
+CREATE TABLE
+    triggered_alerts_new (
+        -- TriggeredAlert records that the condition of an alert rule was met.
+        id INTEGER PRIMARY KEY AUTOINCREMENT, -- ID is the primary identifier for a triggered alert.
+        rule_id TEXT NOT NULL, -- RuleID is the ID of the alert rule that was triggered.
+        owner TEXT NOT NULL, -- Owner is the subject of the user that owns the alert rule.
+        time DATETIME NOT NULL, -- Time is the time when the alert rule was triggered.
+        message TEXT NOT NULL -- Message describes why the alert rule was triggered.
+    );
+
+INSERT INTO
+    triggered_alerts_new (id, rule_id, owner, time, message)
+SELECT
+    id,
+    rule_id,
+    owner,
+    time,
+    message
+FROM
+    triggered_alerts;
+
+DROP TABLE triggered_alerts;
+
+ALTER TABLE triggered_alerts_new
+RENAME TO triggered_alerts;
+
+CREATE INDEX IF NOT EXISTS triggered_alerts_owner ON triggered_alerts (owner, time);
+
+-- +goose Down
+CREATE TABLE
+    triggered_alerts_old (
+        id INTEGER PRIMARY KEY AUTOINCREMENT,
+        rule_id TEXT NOT NULL,
+        owner TEXT NOT NULL,
+        time DATETIME NOT NULL,
+        message TEXT NOT NULL,
+        FOREIGN KEY (rule_id) REFERENCES alert_rules (id)
+    );
+
+INSERT INTO
+    triggered_alerts_old (id, rule_id, owner, time, message)
+SELECT
+    id,
+    rule_id,
+    owner,
+    time,
+    message
+FROM
+    triggered_alerts;
+
+DROP TABLE triggered_alerts;
+
+ALTER TABLE triggered_alerts_old
+RENAME TO triggered_alerts;
+
+CREATE INDEX IF NOT EXISTS triggered_alerts_owner ON triggered_alerts (owner, time);
@@ -0,0 +1,90 @@
+-- +goose Up
+-- Attachments refer to their portfolio event without a foreign key. Foreign
+-- keys are only enforced for in-memory and encrypted databases, so the same
+-- change would succeed or fail depending on how the database is opened.
+-- Attachments are deleted explicitly together with their portfolio event
+-- instead.
+CREATE TABLE
+    attachments_new (
+        -- Attachment is a document that belongs to a portfolio event.
+        id TEXT PRIMARY KEY, -- ID is the primary identifier for an attachment.
+        event_id TEXT NOT NULL, -- EventID is the ID of the portfolio event of the attachment.
+        file_name TEXT NOT NULL, -- FileName is the name of the attached file.
+        content_type TEXT NOT NULL, -- ContentType is the media type of the content.
+        size INTEGER NOT NULL, -- Size is the size of the content in bytes.
+        hash TEXT NOT NULL, -- Hash is the hex-encoded SHA-256 hash of the content.
+        create_time DATETIME NOT NULL -- CreateTime is the time when the attachment was uploaded.
+    );
+
+INSERT INTO
+    attachments_new (
+        id,
+        event_id,
+        file_name,
+        content_type,
+        size,
+        hash,
+        create_time
+    )
+SELECT
+    id,
+    event_id,
+    file_name,
+    content_type,
+    size,
+    hash,
+    create_time
+FROM
+    attachments;
+
+DROP TABLE attachments;
+
+ALTER TABLE attachments_new
+RENAME TO attachments;
+
+CREATE INDEX IF NOT EXISTS attachments_event_id ON attachments (event_id);
+
+CREATE INDEX IF NOT EXISTS attachments_hash ON attachments (hash);
+
+-- +goose Down
+CREATE TABLE
+    attachments_old (
+        id TEXT PRIMARY KEY,
+        event_id TEXT NOT NULL,
+        file_name TEXT NOT NULL,
+        content_type TEXT NOT NULL,
+        size INTEGER NOT NULL,
+        hash TEXT NOT NULL,
+        create_time DATETIME NOT NULL,
+        FOREIGN KEY (event_id) REFERENCES portfolio_events (id)
+    );
+
+INSERT INTO
+    attachments_old (
+        id,
+        event_id,
+        file_name,
+        content_type,
+        size,
+        hash,
+        create_time
+    )
+SELECT
+    id,
+    event_id,
+    file_name,
+    content_type,
+    size,
+    hash,
+    create_time
+FROM
+    attachments;
+
+DROP TABLE attachments;
+
+ALTER TABLE attachments_old
+RENAME TO attachments;
+
+CREATE INDEX IF NOT EXISTS attachments_event_id ON attachments (event_id);
+
+CREATE INDEX IF NOT EXISTS attachments_hash ON attachments (hash);
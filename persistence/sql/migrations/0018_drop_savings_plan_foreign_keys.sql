@@ -0,0 +1,122 @@
+-- +goose Up
+-- Savings plans refer to their portfolio and security without foreign keys.
+-- Foreign keys are only enforced for in-memory and encrypted databases, so the
+-- same change would succeed or fail depending on how the database is opened.
+-- Savings plans are deleted explicitly together with their portfolio instead.
+CREATE TABLE
+    savings_plans_new (
+        -- SavingsPlan buys a security for a portfolio in regular intervals.
+        id TEXT PRIMARY KEY, -- ID is the primary identifier for a savings plan.
+        portfolio_id TEXT NOT NULL, -- PortfolioID is the ID of the portfolio the security is bought for.
+        security_id TEXT NOT NULL, -- SecurityID is the ID of the security that is bought.
+        display_name TEXT NOT NULL, -- DisplayName is the human-readable name of the savings plan.
+        amount REAL NOT NULL, -- Amount is the number of shares that are bought on each execution.
+        value INTEGER NOT NULL, -- Value is the amount of money that is invested on each execution.
+        interval_months INTEGER NOT NULL, -- IntervalMonths is the number of months between two executions.
+        start_time DATETIME NOT NULL, -- StartTime is the time of the first execution.
+        end_time DATETIME, -- EndTime is the time after which the plan is not executed anymore.
+        fixed_fee INTEGER NOT NULL, -- FixedFee is the fee that applies to each execution.
+        fee_percentage REAL NOT NULL, -- FeePercentage is the part of the invested value that applies as a fee.
+        last_execution_time DATETIME, -- LastExecutionTime is the time of the last execution that was created.
+        create_time DATETIME NOT NULL DEFAULT '1970-01-01 00:00:00' -- CreateTime is the time when the savings plan was created.
+    );
+
+INSERT INTO
+    savings_plans_new (
+        id,
+        portfolio_id,
+        security_id,
+        display_name,
+        amount,
+        value,
+        interval_months,
+        start_time,
+        end_time,
+        fixed_fee,
+        fee_percentage,
+        last_execution_time,
+        create_time
+    )
+SELECT
+    id,
+    portfolio_id,
+    security_id,
+    display_name,
+    amount,
+    value,
+    interval_months,
+    start_time,
+    end_time,
+    fixed_fee,
+    fee_percentage,
+    last_execution_time,
+    create_time
+FROM
+    savings_plans;
+
+DROP TABLE savings_plans;
+
+ALTER TABLE savings_plans_new
+RENAME TO savings_plans;
+
+CREATE INDEX IF NOT EXISTS savings_plans_portfolio_id ON savings_plans (portfolio_id);
+
+-- +goose Down
+CREATE TABLE
+    savings_plans_old (
+        id TEXT PRIMARY KEY,
+        portfolio_id TEXT NOT NULL,
+        security_id TEXT NOT NULL,
+        display_name TEXT NOT NULL,
+        amount REAL NOT NULL,
+        value INTEGER NOT NULL,
+        interval_months INTEGER NOT NULL,
+        start_time DATETIME NOT NULL,
+        end_time DATETIME,
+        fixed_fee INTEGER NOT NULL,
+        fee_percentage REAL NOT NULL,
+        last_execution_time DATETIME,
+        create_time DATETIME NOT NULL DEFAULT '1970-01-01 00:00:00',
+        FOREIGN KEY (portfolio_id) REFERENCES portfolios (id),
+        FOREIGN KEY (security_id) REFERENCES securities (id)
+    );
+
+INSERT INTO
+    savings_plans_old (
+        id,
+        portfolio_id,
+        security_id,
+        display_name,
+        amount,
+        value,
+        interval_months,
+        start_time,
+        end_time,
+        fixed_fee,
+        fee_percentage,
+        last_execution_time,
+        create_time
+    )
+SELECT
+    id,
+    portfolio_id,
+    security_id,
+    display_name,
+    amount,
+    value,
+    interval_months,
+    start_time,
+    end_time,
+    fixed_fee,
+    fee_percentage,
+    last_execution_time,
+    create_time
+FROM
+    savings_plans;
+
+DROP TABLE savings_plans;
+
+ALTER TABLE savings_plans_old
+RENAME TO savings_plans;
+
+CREATE INDEX IF NOT EXISTS savings_plans_portfolio_id ON savings_plans (portfolio_id);
@@ -0,0 +1,92 @@
+-- name: CreateAccessToken :one
+INSERT INTO
+    access_tokens (
+        id,
+        display_name,
+        owner,
+        scopes,
+        hash,
+        create_time,
+        expire_time
+    )
+VALUES
+    (?, ?, ?, ?, ?, ?, ?) RETURNING *;
+
+-- name: ListAccessTokensByOwner :many
+SELECT
+    *
+FROM
+    access_tokens
+WHERE
+    owner = ?
+ORDER BY
+    create_time;
+
+-- name: GetAccessTokenByHash :one
+SELECT
+    *
+FROM
+    access_tokens
+WHERE
+    hash = ?;
+
+-- name: UpdateAccessTokenLastUsed :exec
+UPDATE access_tokens
+SET
+    last_used_time = ?
+WHERE
+    id = ?;
+
+-- name: DeleteAccessToken :execrows
+DELETE FROM access_tokens
+WHERE
+    id = ?
+    AND owner = ?;
+
+-- name: SetTokenOwnerRoles :exec
+INSERT INTO
+    token_owners (owner, roles, update_time)
+VALUES
+    (?, ?, ?) ON CONFLICT (owner) DO
+UPDATE
+SET
+    roles = excluded.roles,
+    update_time = excluded.update_time;
+
+-- name: GetTokenOwner :one
+SELECT
+    *
+FROM
+    token_owners
+WHERE
+    owner = ?;
+
+-- name: ListAllAccessTokens :many
+SELECT
+    *
+FROM
+    access_tokens
+ORDER BY
+    id;
+
+-- name: ReplaceAccessToken :exec
+REPLACE INTO access_tokens (
+    id,
+    display_name,
+    owner,
+    scopes,
+    hash,
+    create_time,
+    expire_time,
+    last_used_time
+)
+VALUES
+    (?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: ListTokenOwners :many
+SELECT
+    *
+FROM
+    token_owners
+ORDER BY
+    owner;
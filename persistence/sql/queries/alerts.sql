@@ -0,0 +1,117 @@
+-- name: CreateAlertRule :one
+-- Creates an alert rule. No row is returned if the ID is already taken.
+INSERT INTO
+    alert_rules (
+        id,
+        display_name,
+        owner,
+        type,
+        portfolio_id,
+        security_id,
+        percentage,
+        threshold,
+        threshold_currency,
+        max_quote_age,
+        channels
+    )
+VALUES
+    (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?) ON CONFLICT (id) DO NOTHING RETURNING *;
+
+-- name: ListAlertRules :many
+SELECT
+    *
+FROM
+    alert_rules
+ORDER BY
+    id;
+
+-- name: ListAlertRulesByOwner :many
+SELECT
+    *
+FROM
+    alert_rules
+WHERE
+    owner = ?
+ORDER BY
+    id;
+
+-- name: GetAlertRule :one
+SELECT
+    *
+FROM
+    alert_rules
+WHERE
+    id = ?
+    AND owner = ?;
+
+-- name: DeleteAlertRule :execrows
+DELETE FROM alert_rules
+WHERE
+    id = ?
+    AND owner = ?;
+
+-- name: DeleteTriggeredAlerts :exec
+DELETE FROM triggered_alerts
+WHERE
+    rule_id = ?;
+
+-- name: SetAlertRuleActive :exec
+UPDATE alert_rules
+SET
+    active = ?
+WHERE
+    id = ?;
+
+-- name: CreateTriggeredAlert :one
+INSERT INTO
+    triggered_alerts (rule_id, owner, time, message)
+VALUES
+    (?, ?, ?, ?) RETURNING *;
+
+-- name: ListTriggeredAlerts :many
+SELECT
+    *
+FROM
+    triggered_alerts
+WHERE
+    owner = sqlc.arg (owner)
+    AND (
+        CAST(sqlc.narg (rule_id) AS TEXT) IS NULL
+        OR rule_id = sqlc.narg (rule_id)
+    )
+ORDER BY
+    time DESC,
+    id DESC
+LIMIT
+    sqlc.arg (page_size);
+
+-- name: ReplaceAlertRule :exec
+REPLACE INTO alert_rules (
+    id,
+    display_name,
+    owner,
+    type,
+    portfolio_id,
+    security_id,
+    percentage,
+    threshold,
+    threshold_currency,
+    max_quote_age,
+    channels,
+    active
+)
+VALUES
+    (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: ListAllTriggeredAlerts :many
+SELECT
+    *
+FROM
+    triggered_alerts
+ORDER BY
+    id;
+
+-- name: ReplaceTriggeredAlert :exec
+REPLACE INTO triggered_alerts (id, rule_id, owner, time, message)
+VALUES
+    (?, ?, ?, ?, ?);
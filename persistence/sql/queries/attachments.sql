@@ -0,0 +1,103 @@
+-- name: CreateAttachment :one
+-- Creates an attachment. No row is returned if the same content is already
+-- attached to the event.
+INSERT INTO
+    attachments (
+        id,
+        event_id,
+        file_name,
+        content_type,
+        size,
+        hash,
+        create_time
+    )
+VALUES
+    (?, ?, ?, ?, ?, ?, ?) ON CONFLICT (id) DO NOTHING RETURNING *;
+
+-- name: ListAttachments :many
+SELECT
+    *
+FROM
+    attachments
+WHERE
+    event_id = ?
+ORDER BY
+    create_time,
+    id;
+
+-- name: GetAttachment :one
+SELECT
+    *
+FROM
+    attachments
+WHERE
+    id = ?;
+
+-- name: DeleteAttachment :execrows
+DELETE FROM attachments
+WHERE
+    id = ?;
+
+-- name: CountAttachmentsWithHash :one
+SELECT
+    COUNT(*)
+FROM
+    attachments
+WHERE
+    hash = ?;
+
+-- name: ListTrashedAttachments :many
+-- Lists the attachments of all portfolio events that were moved to the trash
+-- before the specified time.
+SELECT
+    a.id,
+    a.hash
+FROM
+    attachments a
+    JOIN portfolio_events e ON e.id = a.event_id
+WHERE
+    e.delete_time < sqlc.arg (before);
+
+-- name: CreateAttachmentBlob :exec
+-- Stores the content of an attachment. The content is stored only once, even
+-- if it is attached several times.
+INSERT
+OR IGNORE INTO attachment_blobs (hash, content)
+VALUES
+    (?, ?);
+
+-- name: GetAttachmentBlob :one
+SELECT
+    content
+FROM
+    attachment_blobs
+WHERE
+    hash = ?;
+
+-- name: DeleteAttachmentBlob :exec
+DELETE FROM attachment_blobs
+WHERE
+    hash = ?;
+
+-- name: ListAllAttachments :many
+-- Lists the attachments of all portfolio events, including the ones in the
+-- trash.
+SELECT
+    *
+FROM
+    attachments
+ORDER BY
+    id;
+
+-- name: ReplaceAttachment :exec
+REPLACE INTO attachments (
+    id,
+    event_id,
+    file_name,
+    content_type,
+    size,
+    hash,
+    create_time
+)
+VALUES
+    (?, ?, ?, ?, ?, ?, ?);
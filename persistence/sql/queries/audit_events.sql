@@ -0,0 +1,75 @@
+-- name: CreateAuditEvent :one
+INSERT INTO
+    audit_events (
+        time,
+        subject,
+        procedure,
+        action,
+        resource_type,
+        resource_id,
+        update_mask,
+        before,
+        after
+    )
+VALUES
+    (?, ?, ?, ?, ?, ?, ?, ?, ?) RETURNING *;
+
+-- name: ListAuditEvents :many
+SELECT
+    *
+FROM
+    audit_events
+WHERE
+    (
+        CAST(sqlc.narg (subject) AS TEXT) IS NULL
+        OR subject = sqlc.narg (subject)
+    )
+    AND (
+        CAST(sqlc.narg (resource_type) AS TEXT) IS NULL
+        OR resource_type = sqlc.narg (resource_type)
+    )
+    AND (
+        CAST(sqlc.narg (resource_id) AS TEXT) IS NULL
+        OR resource_id = sqlc.narg (resource_id)
+    )
+    AND (
+        CAST(sqlc.narg (action) AS TEXT) IS NULL
+        OR action = sqlc.narg (action)
+    )
+    AND (
+        CAST(sqlc.narg (start_time) AS DATETIME) IS NULL
+        OR time >= sqlc.narg (start_time)
+    )
+    AND (
+        CAST(sqlc.narg (end_time) AS DATETIME) IS NULL
+        OR time < sqlc.narg (end_time)
+    )
+ORDER BY
+    time DESC,
+    id DESC
+LIMIT
+    sqlc.arg (page_size);
+
+-- name: ListAllAuditEvents :many
+SELECT
+    *
+FROM
+    audit_events
+ORDER BY
+    id;
+
+-- name: ReplaceAuditEvent :exec
+REPLACE INTO audit_events (
+    id,
+    time,
+    subject,
+    procedure,
+    action,
+    resource_type,
+    resource_id,
+    update_mask,
+    before,
+    after
+)
+VALUES
+    (?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
@@ -0,0 +1,25 @@
+-- name: ListCashLedgerEvents :many
+-- Lists all events of the portfolios that settle against a bank account,
+-- ordered by their time. Pending executions of savings plans do not settle
+-- until they are confirmed.
+SELECT
+    e.id,
+    e.type,
+    e.time,
+    e.portfolio_id,
+    e.security_id,
+    e.amount,
+    e.price,
+    e.fees,
+    e.taxes
+FROM
+    portfolio_events e
+    JOIN portfolios p ON p.id = e.portfolio_id
+WHERE
+    p.bank_account_id = ?
+    AND p.delete_time IS NULL
+    AND e.delete_time IS NULL
+    AND NOT e.pending
+ORDER BY
+    e.time,
+    e.id;
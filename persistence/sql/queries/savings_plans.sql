@@ -0,0 +1,128 @@
+-- name: CreateSavingsPlan :one
+-- Creates a savings plan. No row is returned if the ID is already taken.
+INSERT INTO
+    savings_plans (
+        id,
+        portfolio_id,
+        security_id,
+        display_name,
+        amount,
+        value,
+        interval_months,
+        start_time,
+        end_time,
+        fixed_fee,
+        fee_percentage,
+        create_time
+    )
+VALUES
+    (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?) ON CONFLICT (id) DO NOTHING RETURNING *;
+
+-- name: ListSavingsPlans :many
+SELECT
+    *
+FROM
+    savings_plans
+WHERE
+    portfolio_id = ?
+ORDER BY
+    id;
+
+-- name: GetSavingsPlan :one
+SELECT
+    *
+FROM
+    savings_plans
+WHERE
+    id = ?;
+
+-- name: UpdateSavingsPlan :one
+UPDATE savings_plans
+SET
+    display_name = ?,
+    amount = ?,
+    value = ?,
+    interval_months = ?,
+    end_time = ?,
+    fixed_fee = ?,
+    fee_percentage = ?
+WHERE
+    id = ? RETURNING *;
+
+-- name: DeleteSavingsPlan :execrows
+DELETE FROM savings_plans
+WHERE
+    id = ?;
+
+-- name: ListDueSavingsPlans :many
+-- Lists the savings plans of portfolios that are not in the trash, whose next
+-- execution might be due. The exact schedule is calculated by the caller.
+SELECT
+    s.*
+FROM
+    savings_plans s
+    JOIN portfolios p ON p.id = s.portfolio_id
+WHERE
+    p.delete_time IS NULL
+    AND s.start_time <= sqlc.arg (now)
+    AND (
+        s.end_time IS NULL
+        OR s.last_execution_time IS NULL
+        OR s.last_execution_time < s.end_time
+    )
+ORDER BY
+    s.id;
+
+-- name: SetSavingsPlanLastExecutionTime :exec
+UPDATE savings_plans
+SET
+    last_execution_time = ?
+WHERE
+    id = ?;
+
+-- name: CreatePendingPortfolioEvent :execrows
+-- Creates an expected execution of a savings plan. Executions that were
+-- created before are ignored, even if they were deleted or confirmed since.
+INSERT
+OR IGNORE INTO portfolio_events (
+    id,
+    type,
+    time,
+    portfolio_id,
+    security_id,
+    amount,
+    price,
+    fees,
+    taxes,
+    savings_plan_id,
+    pending
+)
+VALUES
+    (?, ?, ?, ?, ?, ?, ?, ?, 0, ?, TRUE);
+
+-- name: ListAllSavingsPlans :many
+SELECT
+    *
+FROM
+    savings_plans
+ORDER BY
+    id;
+
+-- name: ReplaceSavingsPlan :exec
+REPLACE INTO savings_plans (
+    id,
+    portfolio_id,
+    security_id,
+    display_name,
+    amount,
+    value,
+    interval_months,
+    start_time,
+    end_time,
+    fixed_fee,
+    fee_percentage,
+    last_execution_time,
+    create_time
+)
+VALUES
+    (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
@@ -4,13 +4,16 @@ SELECT
 FROM
     securities
 WHERE
-    id = ?;
+    id = ?
+    AND delete_time IS NULL;
 
 -- name: ListSecurities :many
 SELECT
     *
 FROM
     securities
+WHERE
+    delete_time IS NULL
 ORDER BY
     id;
 
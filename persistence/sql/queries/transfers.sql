@@ -0,0 +1,27 @@
+-- name: ListTransferEvents :many
+-- Lists the events of both sides of a transfer.
+SELECT
+    id,
+    portfolio_id
+FROM
+    portfolio_events
+WHERE
+    transfer_id = ?
+    AND delete_time IS NULL
+ORDER BY
+    id;
+
+-- name: ListTrashedTransferEvents :many
+-- Lists the events of all sides of a transfer in the trash, which were not
+-- moved to the trash together with their portfolio.
+SELECT
+    id,
+    portfolio_id
+FROM
+    portfolio_events
+WHERE
+    transfer_id = ?
+    AND delete_time IS NOT NULL
+    AND NOT deleted_with_portfolio
+ORDER BY
+    id;
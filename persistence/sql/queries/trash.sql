@@ -0,0 +1,265 @@
+-- name: ListTrashedPortfolios :many
+SELECT
+    id,
+    display_name,
+    delete_time
+FROM
+    portfolios
+WHERE
+    delete_time IS NOT NULL
+    AND owner = ?
+ORDER BY
+    delete_time DESC;
+
+-- name: ListTrashedPortfolioEvents :many
+-- Events that were moved to the trash together with their portfolio are
+-- listed as part of the portfolio.
+SELECT
+    e.id,
+    e.type,
+    e.portfolio_id,
+    e.security_id,
+    e.delete_time
+FROM
+    portfolio_events e
+    JOIN portfolios p ON p.id = e.portfolio_id
+WHERE
+    e.delete_time IS NOT NULL
+    AND p.delete_time IS NULL
+    AND (
+        p.owner = sqlc.arg (subject)
+        OR p.id IN (
+            SELECT
+                portfolio_id
+            FROM
+                portfolio_shares
+            WHERE
+                subject = sqlc.arg (subject)
+                AND access >= sqlc.arg (access)
+        )
+    )
+ORDER BY
+    e.delete_time DESC;
+
+-- name: ListTrashedSecurities :many
+SELECT
+    id,
+    display_name,
+    delete_time
+FROM
+    securities
+WHERE
+    delete_time IS NOT NULL
+ORDER BY
+    delete_time DESC;
+
+-- name: GetTrashedPortfolio :one
+SELECT
+    id,
+    owner,
+    delete_time
+FROM
+    portfolios
+WHERE
+    id = ?
+    AND delete_time IS NOT NULL;
+
+-- name: GetTrashedPortfolioEvent :one
+SELECT
+    id,
+    portfolio_id,
+    delete_time,
+    transfer_id
+FROM
+    portfolio_events
+WHERE
+    id = ?
+    AND delete_time IS NOT NULL;
+
+-- name: RestorePortfolio :execrows
+UPDATE portfolios
+SET
+    delete_time = NULL
+WHERE
+    id = ?
+    AND delete_time IS NOT NULL;
+
+-- name: RestorePortfolioEvent :execrows
+UPDATE portfolio_events
+SET
+    delete_time = NULL
+WHERE
+    id = ?
+    AND delete_time IS NOT NULL;
+
+-- name: RestoreSecurity :execrows
+UPDATE securities
+SET
+    delete_time = NULL
+WHERE
+    id = ?
+    AND delete_time IS NOT NULL;
+
+-- name: PurgeAttachments :execrows
+DELETE FROM attachments
+WHERE
+    event_id IN (
+        SELECT
+            id
+        FROM
+            portfolio_events
+        WHERE
+            delete_time < sqlc.arg (before)
+    );
+
+-- name: PurgePortfolioEvents :execrows
+DELETE FROM portfolio_events
+WHERE
+    delete_time < sqlc.arg (before);
+
+-- name: PurgePortfolioShares :execrows
+DELETE FROM portfolio_shares
+WHERE
+    portfolio_id IN (
+        SELECT
+            id
+        FROM
+            portfolios
+        WHERE
+            delete_time < sqlc.arg (before)
+    );
+
+-- name: PurgeTriggeredAlerts :execrows
+DELETE FROM triggered_alerts
+WHERE
+    rule_id IN (
+        SELECT
+            alert_rules.id
+        FROM
+            alert_rules
+            JOIN portfolios ON portfolios.id = alert_rules.portfolio_id
+        WHERE
+            portfolios.delete_time < sqlc.arg (before)
+    );
+
+-- name: PurgeAlertRules :execrows
+DELETE FROM alert_rules
+WHERE
+    portfolio_id IN (
+        SELECT
+            id
+        FROM
+            portfolios
+        WHERE
+            delete_time < sqlc.arg (before)
+    );
+
+-- name: PurgeSavingsPlans :execrows
+DELETE FROM savings_plans
+WHERE
+    portfolio_id IN (
+        SELECT
+            id
+        FROM
+            portfolios
+        WHERE
+            portfolios.delete_time < sqlc.arg (before)
+    )
+    OR security_id IN (
+        SELECT
+            id
+        FROM
+            securities
+        WHERE
+            securities.delete_time < sqlc.arg (before)
+    );
+
+-- name: PurgePortfolios :execrows
+DELETE FROM portfolios
+WHERE
+    delete_time < sqlc.arg (before);
+
+-- name: PurgeListedSecurities :execrows
+DELETE FROM listed_securities
+WHERE
+    security_id IN (
+        SELECT
+            id
+        FROM
+            securities
+        WHERE
+            delete_time < sqlc.arg (before)
+    );
+
+-- name: PurgeWatchlistEntries :execrows
+DELETE FROM watchlist_entries
+WHERE
+    security_id IN (
+        SELECT
+            id
+        FROM
+            securities
+        WHERE
+            delete_time < sqlc.arg (before)
+    );
+
+-- name: PurgeSecurities :execrows
+DELETE FROM securities
+WHERE
+    delete_time < sqlc.arg (before);
+
+-- name: ListAllTrash :many
+-- Lists all portfolios, portfolio events and securities in the trash,
+-- regardless of their owner. Events that were moved to the trash together
+-- with their portfolio are not listed, since they follow their portfolio.
+SELECT
+    'Portfolio' AS resource_type,
+    id,
+    delete_time
+FROM
+    portfolios
+WHERE
+    delete_time IS NOT NULL
+UNION ALL
+SELECT
+    'PortfolioEvent' AS resource_type,
+    id,
+    delete_time
+FROM
+    portfolio_events
+WHERE
+    delete_time IS NOT NULL
+    AND NOT deleted_with_portfolio
+UNION ALL
+SELECT
+    'Security' AS resource_type,
+    id,
+    delete_time
+FROM
+    securities
+WHERE
+    delete_time IS NOT NULL
+ORDER BY
+    resource_type,
+    id;
+
+-- name: SetPortfolioDeleteTime :exec
+UPDATE portfolios
+SET
+    delete_time = ?
+WHERE
+    id = ?;
+
+-- name: SetPortfolioEventDeleteTime :exec
+UPDATE portfolio_events
+SET
+    delete_time = ?
+WHERE
+    id = ?;
+
+-- name: SetSecurityDeleteTime :exec
+UPDATE securities
+SET
+    delete_time = ?
+WHERE
+    id = ?;
@@ -0,0 +1,97 @@
+-- name: CreateWatchlist :one
+-- Creates a watchlist. No row is returned if the ID is already taken.
+INSERT INTO
+    watchlists (id, display_name, owner)
+VALUES
+    (?, ?, ?) ON CONFLICT (id) DO NOTHING RETURNING *;
+
+-- name: ListWatchlistsByOwner :many
+SELECT
+    *
+FROM
+    watchlists
+WHERE
+    owner = ?
+ORDER BY
+    id;
+
+-- name: GetWatchlist :one
+SELECT
+    *
+FROM
+    watchlists
+WHERE
+    id = ?
+    AND owner = ?;
+
+-- name: UpdateWatchlistDisplayName :exec
+UPDATE watchlists
+SET
+    display_name = ?
+WHERE
+    id = ?;
+
+-- name: DeleteWatchlist :execrows
+DELETE FROM watchlists
+WHERE
+    id = ?
+    AND owner = ?;
+
+-- name: AddWatchlistEntry :exec
+INSERT
+OR IGNORE INTO watchlist_entries (watchlist_id, security_id, add_time, added_quote)
+VALUES
+    (?, ?, ?, ?);
+
+-- name: RemoveWatchlistEntry :exec
+DELETE FROM watchlist_entries
+WHERE
+    watchlist_id = ?
+    AND security_id = ?;
+
+-- name: DeleteWatchlistEntries :exec
+DELETE FROM watchlist_entries
+WHERE
+    watchlist_id = ?;
+
+-- name: ListWatchlistEntries :many
+SELECT
+    *
+FROM
+    watchlist_entries
+WHERE
+    watchlist_id = ?
+ORDER BY
+    add_time,
+    security_id;
+
+-- name: ListWatchedSecurityIDs :many
+-- Lists the IDs of all securities that are on at least one watchlist and not
+-- in the trash.
+SELECT DISTINCT
+    watchlist_entries.security_id
+FROM
+    watchlist_entries
+    JOIN securities ON securities.id = watchlist_entries.security_id
+WHERE
+    securities.delete_time IS NULL
+ORDER BY
+    watchlist_entries.security_id;
+
+-- name: ListAllWatchlists :many
+SELECT
+    *
+FROM
+    watchlists
+ORDER BY
+    id;
+
+-- name: ReplaceWatchlist :exec
+REPLACE INTO watchlists (id, display_name, owner)
+VALUES
+    (?, ?, ?);
+
+-- name: ReplaceWatchlistEntry :exec
+REPLACE INTO watchlist_entries (watchlist_id, security_id, add_time, added_quote)
+VALUES
+    (?, ?, ?, ?);
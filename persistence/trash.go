@@ -0,0 +1,71 @@
+// Copyright 2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// PurgeTrash permanently removes all portfolios, portfolio events and
+// securities that were moved to the trash before the specified time, together
+// with their shares and listings. It returns the number of removed rows.
+func PurgeTrash(ctx context.Context, db *DB, before time.Time) (n int64, err error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	var (
+		q = New(observedDB{tx})
+		t = sql.NullTime{Time: before.UTC(), Valid: true}
+	)
+
+	// Dependent rows need to be removed first
+	for _, purge := range []struct {
+		name string
+		f    func(context.Context, sql.NullTime) (int64, error)
+	}{
+		{"attachments", q.PurgeAttachments},
+		{"portfolio events", q.PurgePortfolioEvents},
+		{"portfolio shares", q.PurgePortfolioShares},
+		{"triggered alerts", q.PurgeTriggeredAlerts},
+		{"alert rules", q.PurgeAlertRules},
+		{"savings plans", q.PurgeSavingsPlans},
+		{"portfolios", q.PurgePortfolios},
+		{"listed securities", q.PurgeListedSecurities},
+		{"watchlist entries", q.PurgeWatchlistEntries},
+		{"securities", q.PurgeSecurities},
+	} {
+		affected, err := purge.f(ctx, t)
+		if err != nil {
+			return 0, fmt.Errorf("could not purge %s: %w", purge.name, err)
+		}
+
+		n += affected
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return 0, err
+	}
+
+	return n, nil
+}
@@ -0,0 +1,75 @@
+// Copyright 2023 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package persistence
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/oxisto/assert"
+)
+
+func TestPurgeTrash(t *testing.T) {
+	db, _, err := OpenDB(Options{UseInMemory: true})
+	if err != nil {
+		t.Fatalf("could not open database: %v", err)
+	}
+
+	_, err = db.Exec(`INSERT INTO portfolios (id, display_name, owner) VALUES ('old', 'Old', 'money'), ('recent', 'Recent', 'money');
+INSERT INTO portfolio_events (id, type, time, portfolio_id, security_id) VALUES ('old-buy', 1, '2020-01-01', 'old', 'US0378331005'), ('recent-buy', 1, '2020-01-01', 'recent', 'US0378331005');
+INSERT INTO portfolio_shares (portfolio_id, subject, access) VALUES ('old', 'gopher', 1);
+INSERT INTO attachments (id, event_id, file_name, content_type, size, hash, create_time) VALUES ('old-note', 'old-buy', 'note.pdf', 'application/pdf', 4, 'abcd', '2020-01-01');
+INSERT INTO alert_rules (id, display_name, owner, type, portfolio_id, security_id, percentage, max_quote_age, channels) VALUES ('old-drop', 'Old drops', 'money', 1, 'old', '', 0.1, 0, '');
+INSERT INTO triggered_alerts (rule_id, owner, time, message) VALUES ('old-drop', 'money', '2020-01-01', 'Dropped');
+INSERT INTO savings_plans (id, portfolio_id, security_id, display_name, amount, value, interval_months, start_time, fixed_fee, fee_percentage) VALUES ('old-plan', 'old', 'US0378331005', 'Old plan', 0, 10000, 1, '2020-01-01', 0, 0);
+INSERT INTO securities (id, display_name) VALUES ('US0378331005', 'Apple Inc.');
+INSERT INTO listed_securities (security_id, ticker, currency) VALUES ('US0378331005', 'APC.F', 'EUR');
+INSERT INTO watchlists (id, display_name, owner) VALUES ('tech', 'Tech', 'money');
+INSERT INTO watchlist_entries (watchlist_id, security_id, add_time) VALUES ('tech', 'US0378331005', '2020-01-01');
+UPDATE portfolios SET delete_time = '2020-01-01 00:00:00' WHERE id = 'old';
+UPDATE portfolios SET delete_time = CURRENT_TIMESTAMP WHERE id = 'recent';
+UPDATE securities SET delete_time = '2020-01-01 00:00:00';`)
+	if err != nil {
+		t.Fatalf("could not insert data: %v", err)
+	}
+
+	var deleteTime string
+	err = db.QueryRow("SELECT delete_time FROM portfolio_events WHERE id = 'old-buy'").Scan(&deleteTime)
+	assert.NoError(t, err)
+	assert.Equals(t, "2020-01-01T00:00:00Z", deleteTime)
+
+	// The portfolio, its event, attachment, share, alert rule, triggered alert
+	// and savings plan as well as the security, its listing and watchlist
+	// entry are purged
+	n, err := PurgeTrash(context.Background(), db, time.Now().AddDate(0, 0, -30))
+	assert.NoError(t, err)
+	assert.Equals(t, int64(10), n)
+
+	var count int
+	err = db.QueryRow("SELECT COUNT(*) FROM portfolios").Scan(&count)
+	assert.NoError(t, err)
+	assert.Equals(t, 1, count)
+
+	// Events of the recently deleted portfolio are kept and restored with it
+	_, err = db.Exec("UPDATE portfolios SET delete_time = NULL WHERE id = 'recent'")
+	assert.NoError(t, err)
+
+	err = db.QueryRow("SELECT COUNT(*) FROM portfolio_events WHERE delete_time IS NULL").Scan(&count)
+	assert.NoError(t, err)
+	assert.Equals(t, 1, count)
+}
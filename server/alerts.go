@@ -0,0 +1,52 @@
+// Copyright 2023 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package server
+
+import (
+	"net"
+	"net/smtp"
+	"strings"
+
+	"github.com/oxisto/money-gopher/service/alerts"
+)
+
+// registerNotifiers registers the notification channels of alert rules that
+// are configured in opts.
+func registerNotifiers(opts Options) {
+	if opts.AlertWebhookURL != "" {
+		alerts.RegisterNotifier(alerts.ChannelWebhook, &alerts.Webhook{URL: opts.AlertWebhookURL})
+	}
+
+	if opts.AlertSMTPAddr != "" {
+		n := &alerts.SMTP{
+			Addr: opts.AlertSMTPAddr,
+			From: opts.AlertSMTPFrom,
+			To:   opts.AlertSMTPTo,
+		}
+
+		if opts.AlertSMTPUsername != "" {
+			host, _, _ := net.SplitHostPort(opts.AlertSMTPAddr)
+			n.Auth = smtp.PlainAuth("", opts.AlertSMTPUsername, opts.AlertSMTPPassword, host)
+		}
+
+		alerts.RegisterNotifier(alerts.ChannelSMTP, n)
+	}
+
+	if fields := strings.Fields(opts.AlertCommand); len(fields) > 0 {
+		alerts.RegisterNotifier(alerts.ChannelCommand, &alerts.Command{Name: fields[0], Args: fields[1:]})
+	}
+}
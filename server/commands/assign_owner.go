@@ -0,0 +1,49 @@
+// Copyright 2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package commands
+
+import (
+	"context"
+	"errors"
+
+	"github.com/oxisto/money-gopher/persistence"
+
+	"github.com/urfave/cli/v3"
+)
+
+// AssignOwnerCmd is the command to assign unowned portfolios and bank accounts
+// to a user.
+var AssignOwnerCmd = &cli.Command{
+	Name: "assign-owner",
+	Usage: "Assigns all portfolios and bank accounts without an owner to the specified user. " +
+		"This is needed once for databases that were created before entities were owned by users. The server must not be running.",
+	Flags: []cli.Flag{
+		&cli.StringFlag{Name: "owner", Usage: "The subject (sub) of the user that will own the entities", Required: true},
+	},
+	Action: AssignOwner,
+}
+
+// AssignOwner is the action for the assign-owner command.
+func AssignOwner(ctx context.Context, cmd *cli.Command) (err error) {
+	db, _, err := persistence.OpenDB(dbOpts)
+	if err != nil {
+		return err
+	}
+
+	_, err = persistence.AssignOwner(ctx, db, cmd.String("owner"))
+	return errors.Join(err, persistence.Close(db))
+}
@@ -0,0 +1,224 @@
+// Copyright 2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package commands
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	portfoliov1 "github.com/oxisto/money-gopher/gen"
+	"github.com/oxisto/money-gopher/persistence"
+	"github.com/oxisto/money-gopher/service/admin"
+
+	"github.com/urfave/cli/v3"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// FormatSQLite is a consistent copy of the SQLite database file.
+	FormatSQLite = "sqlite"
+
+	// FormatJSON is a [portfoliov1.Dump] in its JSON representation.
+	FormatJSON = "json"
+
+	// FormatProtobuf is a [portfoliov1.Dump] in its binary protobuf
+	// representation.
+	FormatProtobuf = "binpb"
+)
+
+// ErrUnknownFormat is returned if an unknown backup format is specified.
+var ErrUnknownFormat = errors.New("unknown backup format")
+
+// BackupCmd is the command to back up the database.
+var BackupCmd = &cli.Command{
+	Name:  "backup",
+	Usage: "Creates a backup of the database. Backups are not encrypted. " +
+		"An unencrypted database can be backed up while the server is running, an encrypted one only while the server is not running.",
+	Flags: []cli.Flag{
+		&cli.StringFlag{Name: "output", Aliases: []string{"o"}, Usage: "The file to write the backup to", Required: true},
+		&cli.StringFlag{Name: "format", Usage: "The format of the backup (sqlite, json or binpb)", Value: FormatSQLite},
+	},
+	Action: Backup,
+}
+
+// RestoreCmd is the command to restore the database from a backup.
+var RestoreCmd = &cli.Command{
+	Name:  "restore",
+	Usage: "Restores the database from a backup. Existing entities with the same ID are replaced. The server must not be running.",
+	Flags: []cli.Flag{
+		&cli.StringFlag{Name: "input", Aliases: []string{"i"}, Usage: "The file to read the backup from", Required: true},
+		&cli.StringFlag{Name: "format", Usage: "The format of the backup (sqlite, json or binpb)", Value: FormatSQLite},
+	},
+	Action: Restore,
+}
+
+// Backup is the action for the backup command.
+func Backup(ctx context.Context, cmd *cli.Command) (err error) {
+	var (
+		dump *portfoliov1.Dump
+		b    []byte
+		out  = cmd.String("output")
+	)
+
+	db, q, err := persistence.OpenDB(dbOpts)
+	if err != nil {
+		return err
+	}
+	defer persistence.Close(db)
+
+	switch cmd.String("format") {
+	case FormatSQLite:
+		_, err = persistence.Backup(ctx, db, out)
+		return err
+	case FormatJSON, FormatProtobuf:
+		var blobs persistence.BlobStore
+		blobs, err = persistence.NewBlobStore(q, opts.AttachmentDir)
+		if err != nil {
+			return err
+		}
+
+		dump, err = admin.Export(ctx, db, blobs)
+		if err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownFormat, cmd.String("format"))
+	}
+
+	b, err = marshalDump(dump, cmd.String("format"))
+	if err != nil {
+		return fmt.Errorf("could not marshal dump: %w", err)
+	}
+
+	// We do not want to accidentally overwrite an existing backup
+	f, err := os.OpenFile(out, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = f.Write(b)
+	return err
+}
+
+// Restore is the action for the restore command.
+func Restore(ctx context.Context, cmd *cli.Command) (err error) {
+	var (
+		dump *portfoliov1.Dump
+		in   = cmd.String("input")
+	)
+
+	switch cmd.String("format") {
+	case FormatSQLite:
+		// We restore a database file by exporting its content into a dump
+		// first, so that it can also be restored into a running database.
+		dump, err = exportBackup(ctx, in)
+		if err != nil {
+			return err
+		}
+	case FormatJSON, FormatProtobuf:
+		var b []byte
+		b, err = os.ReadFile(in)
+		if err != nil {
+			return err
+		}
+
+		dump, err = unmarshalDump(b, cmd.String("format"))
+		if err != nil {
+			return fmt.Errorf("could not unmarshal dump: %w", err)
+		}
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownFormat, cmd.String("format"))
+	}
+
+	db, q, err := persistence.OpenDB(dbOpts)
+	if err != nil {
+		return err
+	}
+
+	blobs, err := persistence.NewBlobStore(q, opts.AttachmentDir)
+	if err != nil {
+		return errors.Join(err, persistence.Close(db))
+	}
+
+	return errors.Join(admin.Restore(ctx, db, blobs, dump), persistence.Close(db))
+}
+
+// exportBackup exports the content of a SQLite backup into a dump. The backup
+// might have been created by an older version, so we copy it into a temporary
+// database first and apply all migrations to the copy. The backup itself is
+// opened read-only and is never modified.
+func exportBackup(ctx context.Context, path string) (dump *portfoliov1.Dump, err error) {
+	var (
+		src *sql.DB
+		tmp *persistence.DB
+		q   *persistence.Queries
+		dir string
+	)
+
+	src, err = sql.Open("sqlite3", "file:"+path+"?mode=ro")
+	if err != nil {
+		return nil, fmt.Errorf("could not open backup: %w", err)
+	}
+	defer src.Close()
+
+	dir, err = os.MkdirTemp("", "mgo-restore-*")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	_, err = persistence.Backup(ctx, src, filepath.Join(dir, "money.db"))
+	if err != nil {
+		return nil, fmt.Errorf("could not copy backup: %w", err)
+	}
+
+	tmp, q, err = persistence.OpenDB(persistence.Options{DSN: filepath.Join(dir, "money.db")})
+	if err != nil {
+		return nil, err
+	}
+	defer persistence.Close(tmp)
+
+	// The backup only contains the content of attachments that were stored in
+	// the database
+	return admin.Export(ctx, tmp, persistence.NewDBBlobStore(q))
+}
+
+func marshalDump(dump *portfoliov1.Dump, format string) ([]byte, error) {
+	if format == FormatJSON {
+		return protojson.MarshalOptions{Multiline: true}.Marshal(dump)
+	}
+
+	return proto.Marshal(dump)
+}
+
+func unmarshalDump(b []byte, format string) (dump *portfoliov1.Dump, err error) {
+	dump = new(portfoliov1.Dump)
+
+	if format == FormatJSON {
+		err = protojson.Unmarshal(b, dump)
+	} else {
+		err = proto.Unmarshal(b, dump)
+	}
+
+	return
+}
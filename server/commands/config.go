@@ -0,0 +1,131 @@
+// Copyright 2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/urfave/cli/v3"
+	"gopkg.in/yaml.v3"
+)
+
+// EnvPrefix is the prefix of all environment variables that configure the
+// server.
+const EnvPrefix = "MONEYD_"
+
+var (
+	// ErrUnknownConfigKey is returned if the config file contains a key that
+	// does not correspond to a flag.
+	ErrUnknownConfigKey = errors.New("unknown config key")
+
+	// ErrInvalidConfigValue is returned if the config file contains a value
+	// that is neither a scalar nor, for flags that can be given multiple times,
+	// a list of scalars.
+	ErrInvalidConfigValue = errors.New("invalid config value")
+)
+
+// envVars returns the environment variable for the flag with the given name,
+// e.g., MONEYD_API_ADDR for api-addr.
+func envVars(name string) cli.ValueSourceChain {
+	return cli.EnvVars(EnvPrefix + strings.ToUpper(strings.ReplaceAll(name, "-", "_")))
+}
+
+// LoadConfig loads the config file specified by the "config" flag. Every key
+// in the config file corresponds to a flag. Values from the config file are
+// only used if the flag was not already set on the command line or by an
+// environment variable, so the order of precedence is: flag, environment
+// variable, config file, default value. Flags that can be given multiple
+// times, e.g., alert-smtp-to, also accept a list of values.
+func LoadConfig(ctx context.Context, cmd *cli.Command) (newCtx context.Context, err error) {
+	var (
+		b      []byte
+		config map[string]any
+		names  []string
+		multi  []string
+		path   = cmd.String("config")
+	)
+
+	if path == "" {
+		return ctx, nil
+	}
+
+	for _, f := range cmd.Flags {
+		names = append(names, f.Names()...)
+
+		if mf, ok := f.(cli.DocGenerationMultiValueFlag); ok && mf.IsMultiValueFlag() {
+			multi = append(multi, f.Names()...)
+		}
+	}
+
+	b, err = os.ReadFile(path)
+	if err != nil {
+		return ctx, fmt.Errorf("could not read config file: %w", err)
+	}
+
+	err = yaml.Unmarshal(b, &config)
+	if err != nil {
+		return ctx, fmt.Errorf("could not parse config file: %w", err)
+	}
+
+	for key, value := range config {
+		if key == "config" || !slices.Contains(names, key) {
+			return ctx, fmt.Errorf("%w: %s", ErrUnknownConfigKey, key)
+		}
+
+		var s string
+		switch v := value.(type) {
+		case map[string]any:
+			return ctx, fmt.Errorf("%w: %s must be a scalar", ErrInvalidConfigValue, key)
+		case []any:
+			if !slices.Contains(multi, key) {
+				return ctx, fmt.Errorf("%w: %s must be a scalar", ErrInvalidConfigValue, key)
+			}
+
+			// Slice flags split their value at commas, so we can set all
+			// items at once
+			items := make([]string, 0, len(v))
+			for _, item := range v {
+				switch item.(type) {
+				case map[string]any, []any:
+					return ctx, fmt.Errorf("%w: %s must be a list of scalars", ErrInvalidConfigValue, key)
+				}
+
+				items = append(items, fmt.Sprint(item))
+			}
+
+			s = strings.Join(items, ",")
+		default:
+			s = fmt.Sprint(value)
+		}
+
+		if cmd.IsSet(key) {
+			continue
+		}
+
+		err = cmd.Set(key, s)
+		if err != nil {
+			return ctx, fmt.Errorf("%w: %s: %w", ErrInvalidConfigValue, key, err)
+		}
+	}
+
+	return ctx, nil
+}
@@ -0,0 +1,123 @@
+// Copyright 2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package commands
+
+import (
+	"context"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/oxisto/assert"
+	"github.com/urfave/cli/v3"
+)
+
+func TestLoadConfig(t *testing.T) {
+	var (
+		addr   string
+		debug  bool
+		to     []string
+		config = func(content string) string {
+			p := path.Join(t.TempDir(), "moneyd.yaml")
+			assert.NoError(t, os.WriteFile(p, []byte(content), 0600))
+			return p
+		}
+	)
+
+	tests := []struct {
+		name     string
+		args     []string
+		env      map[string]string
+		wantAddr string
+		wantTo   []string
+		wantErr  error
+	}{
+		{
+			name:     "no config",
+			wantAddr: ":8080",
+		},
+		{
+			name:     "config file",
+			args:     []string{"--config", config("api-addr: \":9090\"\ndebug: true\n")},
+			wantAddr: ":9090",
+		},
+		{
+			name:     "env overrides config file",
+			args:     []string{"--config", config("api-addr: \":9090\"\n")},
+			env:      map[string]string{"MONEYD_API_ADDR": ":7070"},
+			wantAddr: ":7070",
+		},
+		{
+			name:     "flag overrides config file",
+			args:     []string{"--config", config("api-addr: \":9090\"\n"), "--api-addr", ":6060"},
+			wantAddr: ":6060",
+		},
+		{
+			name:     "list for slice flag",
+			args:     []string{"--config", config("alert-smtp-to:\n  - money@example.com\n  - gopher@example.com\n")},
+			wantAddr: ":8080",
+			wantTo:   []string{"money@example.com", "gopher@example.com"},
+		},
+		{
+			name:    "unknown key",
+			args:    []string{"--config", config("api-address: \":9090\"\n")},
+			wantErr: ErrUnknownConfigKey,
+		},
+		{
+			name:    "invalid value",
+			args:    []string{"--config", config("debug: [true]\n")},
+			wantErr: ErrInvalidConfigValue,
+		},
+		{
+			name:    "nested list for slice flag",
+			args:    []string{"--config", config("alert-smtp-to: [[money@example.com]]\n")},
+			wantErr: ErrInvalidConfigValue,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			to = nil
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+
+			cmd := &cli.Command{
+				Name: "moneyd",
+				Flags: []cli.Flag{
+					&cli.StringFlag{Name: "config", Sources: envVars("config")},
+					&cli.BoolFlag{Name: "debug", Sources: envVars("debug"), Destination: &debug},
+					&cli.StringFlag{Name: "api-addr", Value: ":8080", Sources: envVars("api-addr"), Destination: &addr},
+					&cli.StringSliceFlag{Name: "alert-smtp-to", Sources: envVars("alert-smtp-to"), Destination: &to},
+				},
+				Before: LoadConfig,
+				Action: func(ctx context.Context, c *cli.Command) error { return nil },
+			}
+
+			err := cmd.Run(context.Background(), append([]string{"moneyd"}, tt.args...))
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, tt.wantErr, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equals(t, tt.wantAddr, addr)
+			if tt.wantTo != nil {
+				assert.Equals(t, tt.wantTo, to)
+			}
+		})
+	}
+}
@@ -18,10 +18,15 @@ package commands
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 	"os"
+	"os/signal"
+	"strings"
+	"syscall"
 	"time"
 
+	"github.com/oxisto/money-gopher/auth"
 	"github.com/oxisto/money-gopher/persistence"
 	"github.com/oxisto/money-gopher/server"
 
@@ -34,30 +39,286 @@ import (
 // opts holds the options for the server.
 var opts server.Options
 
+// dbOpts holds the options for the database.
+var dbOpts persistence.Options
+
 // ServerCmd is the command to start the Money Gopher server.
 var ServerCmd = &cli.Command{
 	Name:  "moneyd",
 	Usage: "Starts the Money Gopher server.",
 	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:    "config",
+			Aliases: []string{"c"},
+			Usage:   "Specifies a YAML config file that contains values for all other flags, using the flag names as keys",
+			Sources: envVars("config"),
+		},
 		&cli.BoolFlag{Name: "debug", Aliases: []string{"d"},
+			Sources:     envVars("debug"),
 			Destination: &opts.Debug},
+		&cli.StringFlag{
+			Name:        "db",
+			Value:       "money.db",
+			Usage:       "Specifies the path to the SQLite database",
+			Sources:     envVars("db"),
+			Destination: &dbOpts.DSN,
+		},
+		&cli.StringFlag{
+			Name:        "db-passphrase",
+			Usage:       "Encrypts the database at rest with a key derived from this passphrase. Changes are written to disk after --db-flush-delay",
+			Sources:     envVars("db-passphrase"),
+			Destination: &dbOpts.Passphrase,
+		},
+		&cli.StringFlag{
+			Name:        "db-key-file",
+			Usage:       "Encrypts the database at rest with a key derived from the content of this file. Changes are written to disk after --db-flush-delay",
+			Sources:     envVars("db-key-file"),
+			Destination: &dbOpts.KeyFile,
+		},
+		&cli.DurationFlag{
+			Name:        "db-flush-delay",
+			Usage:       "Specifies how long an encrypted database waits after a change before it is written to disk. Changes within this time are lost if the server crashes. 0 writes the database after every change",
+			Value:       persistence.DefaultFlushDelay,
+			Sources:     envVars("db-flush-delay"),
+			Destination: &dbOpts.FlushDelay,
+		},
+		&cli.StringFlag{
+			Name:        "api-addr",
+			Value:       ":8080",
+			Usage:       "Specifies the address the API server listens on",
+			Sources:     envVars("api-addr"),
+			Destination: &opts.APIAddr,
+		},
+		&cli.StringFlag{
+			Name:        "securities-service-url",
+			Usage:       "Specifies the URL of a remote securities service that is used by the portfolio service in a split deployment. If it is empty, the securities service is called in-process",
+			Sources:     envVars("securities-service-url"),
+			Destination: &opts.SecuritiesServiceURL,
+		},
+		&cli.StringFlag{
+			Name:        "securities-service-client-id",
+			Usage:       "Specifies the client ID of the service credential that is used to call a remote securities service",
+			Sources:     envVars("securities-service-client-id"),
+			Destination: &opts.SecuritiesServiceClientID,
+		},
+		&cli.StringFlag{
+			Name:        "securities-service-client-secret",
+			Usage:       "Specifies the client secret of the service credential that is used to call a remote securities service",
+			Sources:     envVars("securities-service-client-secret"),
+			Destination: &opts.SecuritiesServiceClientSecret,
+		},
+		&cli.StringFlag{
+			Name:        "securities-service-token-url",
+			Usage:       "Specifies the token URL for the service credential. Defaults to the one of the embedded oauth2 server or the one discovered from the OIDC issuer",
+			Sources:     envVars("securities-service-token-url"),
+			Destination: &opts.SecuritiesServiceTokenURL,
+		},
+		&cli.StringFlag{
+			Name:        "otlp-endpoint",
+			Usage:       "Specifies the URL of an OTLP/HTTP endpoint, e.g. http://localhost:4318, that traces are exported to. Tracing is disabled if empty",
+			Sources:     envVars("otlp-endpoint"),
+			Destination: &opts.OTLPEndpoint,
+		},
+		&cli.DurationFlag{
+			Name:        "shutdown-timeout",
+			Usage:       "Specifies how long in-flight requests and background quote updates may take on shutdown",
+			Value:       server.DefaultShutdownTimeout,
+			Sources:     envVars("shutdown-timeout"),
+			Destination: &opts.ShutdownTimeout,
+		},
+		&cli.IntFlag{
+			Name:        "trash-retention-days",
+			Usage:       "Specifies after how many days deleted portfolios, transactions and securities are purged from the trash. 0 keeps them forever",
+			Value:       30,
+			Sources:     envVars("trash-retention-days"),
+			Destination: &opts.TrashRetentionDays,
+		},
+		&cli.DurationFlag{
+			Name:        "watchlist-refresh-interval",
+			Usage:       "Specifies the interval in which the quotes of securities on a watchlist are updated. 0 disables the automatic update",
+			Value:       server.DefaultWatchlistRefreshInterval,
+			Sources:     envVars("watchlist-refresh-interval"),
+			Destination: &opts.WatchlistRefreshInterval,
+		},
+		&cli.StringFlag{
+			Name:        "backup-dir",
+			Usage:       "Specifies the directory in which backups that are requested via the API are written. Creating backups via the API is disabled if empty",
+			Sources:     envVars("backup-dir"),
+			Destination: &opts.BackupDir,
+		},
+		&cli.StringFlag{
+			Name:        "attachment-dir",
+			Usage:       "Specifies the directory in which attachments of transactions are stored. They are stored in the database if empty",
+			Sources:     envVars("attachment-dir"),
+			Destination: &opts.AttachmentDir,
+		},
+		&cli.StringFlag{
+			Name:        "alert-webhook-url",
+			Usage:       "Specifies a URL that triggered alerts are posted to as JSON. Alert rules can use it as the \"webhook\" channel",
+			Sources:     envVars("alert-webhook-url"),
+			Destination: &opts.AlertWebhookURL,
+		},
+		&cli.StringFlag{
+			Name:        "alert-smtp-addr",
+			Usage:       "Specifies the address (host:port) of an SMTP server that sends triggered alerts by e-mail. Alert rules can use it as the \"smtp\" channel",
+			Sources:     envVars("alert-smtp-addr"),
+			Destination: &opts.AlertSMTPAddr,
+		},
+		&cli.StringFlag{
+			Name:        "alert-smtp-from",
+			Usage:       "Specifies the sender of alerts by e-mail",
+			Sources:     envVars("alert-smtp-from"),
+			Destination: &opts.AlertSMTPFrom,
+		},
+		&cli.StringSliceFlag{
+			Name:        "alert-smtp-to",
+			Usage:       "Specifies a recipient of alerts by e-mail. Can be specified multiple times",
+			Sources:     envVars("alert-smtp-to"),
+			Destination: &opts.AlertSMTPTo,
+		},
+		&cli.StringFlag{
+			Name:        "alert-smtp-username",
+			Usage:       "Specifies the username for the SMTP server, if it requires authentication",
+			Sources:     envVars("alert-smtp-username"),
+			Destination: &opts.AlertSMTPUsername,
+		},
+		&cli.StringFlag{
+			Name:        "alert-smtp-password",
+			Usage:       "Specifies the password for the SMTP server",
+			Sources:     envVars("alert-smtp-password"),
+			Destination: &opts.AlertSMTPPassword,
+		},
+		&cli.StringFlag{
+			Name:        "alert-command",
+			Usage:       "Specifies a local command that is run for every triggered alert, which is passed as JSON on stdin. Alert rules can use it as the \"command\" channel",
+			Sources:     envVars("alert-command"),
+			Destination: &opts.AlertCommand,
+		},
+		&cli.StringFlag{
+			Name:        "jwks-url",
+			Usage:       "Specifies the URL of the JSON Web Key Set used to verify tokens. Defaults to the one discovered from the OIDC issuer or the one of the embedded oauth2 server",
+			Sources:     envVars("jwks-url"),
+			Destination: &opts.JWKSURL,
+		},
+		&cli.StringFlag{
+			Name:        "oidc-issuer",
+			Usage:       "Specifies the issuer URL of an external OpenID Connect provider, which is used instead of the embedded oauth2 server",
+			Sources:     envVars("oidc-issuer"),
+			Destination: &opts.OIDCIssuer,
+		},
+		&cli.StringFlag{
+			Name:        "oidc-audience",
+			Usage:       "Specifies the expected audience of tokens. If it is empty, the audience is not validated",
+			Sources:     envVars("oidc-audience"),
+			Destination: &opts.OIDCAudience,
+		},
+		&cli.StringFlag{
+			Name:        "oidc-subject-claim",
+			Value:       auth.DefaultClaimMapping.Subject,
+			Usage:       "Specifies the claim that uniquely identifies a user",
+			Sources:     envVars("oidc-subject-claim"),
+			Destination: &opts.OIDCClaims.Subject,
+		},
+		&cli.StringFlag{
+			Name:        "oidc-name-claim",
+			Value:       auth.DefaultClaimMapping.Name,
+			Usage:       "Specifies the claim that contains the name of a user",
+			Sources:     envVars("oidc-name-claim"),
+			Destination: &opts.OIDCClaims.Name,
+		},
+		&cli.StringFlag{
+			Name:        "oidc-email-claim",
+			Value:       auth.DefaultClaimMapping.Email,
+			Usage:       "Specifies the claim that contains the e-mail address of a user",
+			Sources:     envVars("oidc-email-claim"),
+			Destination: &opts.OIDCClaims.Email,
+		},
+		&cli.StringFlag{
+			Name:        "oidc-roles-claim",
+			Value:       auth.DefaultClaimMapping.Roles,
+			Usage:       "Specifies the claim that contains the roles (admin, editor or viewer) of a user. Nested claims are separated by a dot",
+			Sources:     envVars("oidc-roles-claim"),
+			Destination: &opts.OIDCClaims.Roles,
+		},
+		&cli.StringFlag{
+			Name:        "default-role",
+			Usage:       "Specifies the role of users whose token does not contain any roles. It defaults to admin for the embedded oauth2 server",
+			Sources:     envVars("default-role"),
+			Destination: (*string)(&opts.OIDCClaims.DefaultRole),
+		},
+		&cli.BoolFlag{
+			Name:        "embedded-oauth2-server",
+			Value:       true,
+			Usage:       "Starts the embedded oauth2 server. It is disabled by default if an OIDC issuer is specified",
+			Sources:     envVars("embedded-oauth2-server"),
+			Destination: &opts.EmbeddedOAuth2Server,
+		},
+		&cli.StringFlag{
+			Name:        "embedded-oauth2-server-addr",
+			Value:       ":8000",
+			Usage:       "Specifies the address the embedded oauth2 server listens on",
+			Sources:     envVars("embedded-oauth2-server-addr"),
+			Destination: &opts.EmbeddedOAuth2ServerAddr,
+		},
+		&cli.StringFlag{
+			Name:        "embedded-oauth2-server-public-url",
+			Value:       "http://localhost:8000",
+			Usage:       "Specifies the URL under which the embedded oauth2 server is reachable by clients",
+			Sources:     envVars("embedded-oauth2-server-public-url"),
+			Destination: &opts.EmbeddedOAuth2ServerPublicURL,
+		},
 		&cli.StringFlag{
 			Name:        "embedded-oauth2-server-dashboard-callback",
 			Value:       "http://localhost:3000/api/auth/callback/money-gopher",
 			Usage:       "Specifies the callback URL for the dashboard, if the embedded oauth2 server is used",
+			Sources:     envVars("embedded-oauth2-server-dashboard-callback"),
 			Destination: &opts.EmbeddedOAuth2ServerDashboardCallback,
 		},
+		&cli.StringFlag{
+			Name:        "embedded-oauth2-server-cli-callback",
+			Value:       "http://localhost:10000/callback",
+			Usage:       "Specifies the callback URL for the CLI, if the embedded oauth2 server is used",
+			Sources:     envVars("embedded-oauth2-server-cli-callback"),
+			Destination: &opts.EmbeddedOAuth2ServerCLICallback,
+		},
+		&cli.StringFlag{
+			Name:        "tls-cert-file",
+			Usage:       "Specifies a certificate file to serve the API and the embedded oauth2 server over TLS. It is reloaded once it changes",
+			Sources:     envVars("tls-cert-file"),
+			Destination: &opts.TLSCertFile,
+		},
+		&cli.StringFlag{
+			Name:        "tls-key-file",
+			Usage:       "Specifies the private key file belonging to the TLS certificate",
+			Sources:     envVars("tls-key-file"),
+			Destination: &opts.TLSKeyFile,
+		},
+		&cli.BoolFlag{
+			Name:        "tls-self-signed",
+			Usage:       "Serves the API and the embedded oauth2 server over TLS using a generated self-signed certificate. Only use this for development",
+			Sources:     envVars("tls-self-signed"),
+			Destination: &opts.TLSSelfSigned,
+		},
 		&cli.StringFlag{
 			Name:        "private-key-file",
 			Value:       "private.key",
+			Sources:     envVars("private-key-file"),
 			Destination: &opts.PrivateKeyFile,
 		},
 		&cli.StringFlag{
 			Name:        "private-key-password",
 			Value:       "moneymoneymoney",
+			Sources:     envVars("private-key-password"),
 			Destination: &opts.PrivateKeyPassword,
 		},
 	},
+	Before: LoadConfig,
+	Commands: []*cli.Command{
+		BackupCmd,
+		RestoreCmd,
+		RotateKeyCmd,
+		AssignOwnerCmd,
+	},
 	Action: RunServer,
 }
 
@@ -83,11 +344,33 @@ func RunServer(ctx context.Context, cmd *cli.Command) error {
 	slog.SetDefault(logger)
 	slog.Info("Welcome to the Money Gopher", "money", "🤑")
 
-	pdb, q, err := persistence.OpenDB(persistence.Options{})
+	// An external provider replaces the embedded oauth2 server, unless
+	// explicitly requested otherwise
+	if opts.OIDCIssuer != "" && !cmd.IsSet("embedded-oauth2-server") {
+		opts.EmbeddedOAuth2Server = false
+	}
+
+	// If TLS is enabled, our default public URL also needs to use TLS
+	if opts.TLSSelfSigned || opts.TLSCertFile != "" {
+		if !cmd.IsSet("embedded-oauth2-server-public-url") {
+			opts.EmbeddedOAuth2ServerPublicURL = strings.Replace(opts.EmbeddedOAuth2ServerPublicURL, "http://", "https://", 1)
+		}
+	}
+
+	if err := opts.Validate(); err != nil {
+		slog.Error("Invalid configuration", tint.Err(err))
+		return err
+	}
+
+	pdb, q, err := persistence.OpenDB(dbOpts)
 	if err != nil {
 		slog.Error("Error while opening database", tint.Err(err))
 		return err
 	}
 
-	return server.StartServer(pdb, q, opts)
+	// SIGINT and SIGTERM shut down the server gracefully
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	return errors.Join(server.StartServer(ctx, pdb, q, opts), persistence.Close(pdb))
 }
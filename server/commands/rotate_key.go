@@ -0,0 +1,55 @@
+// Copyright 2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package commands
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/oxisto/money-gopher/persistence"
+
+	"github.com/urfave/cli/v3"
+)
+
+// RotateKeyCmd is the command to rotate the key of an encrypted database.
+var RotateKeyCmd = &cli.Command{
+	Name: "rotate-key",
+	Usage: "Re-encrypts the database with a new passphrase or key file. " +
+		"If the database is not encrypted yet, it will be encrypted. " +
+		"If neither a new passphrase nor a new key file is specified, the database will be decrypted. " +
+		"The server must not be running.",
+	Flags: []cli.Flag{
+		&cli.StringFlag{Name: "new-passphrase", Usage: "The new passphrase", Sources: cli.EnvVars("MONEYD_NEW_DB_PASSPHRASE")},
+		&cli.StringFlag{Name: "new-key-file", Usage: "The path to the new key file"},
+	},
+	Action: RotateKey,
+}
+
+// RotateKey is the action for the rotate-key command.
+func RotateKey(ctx context.Context, cmd *cli.Command) (err error) {
+	err = persistence.RotateKey(dbOpts, persistence.Options{
+		Passphrase: cmd.String("new-passphrase"),
+		KeyFile:    cmd.String("new-key-file"),
+	})
+	if err != nil {
+		return err
+	}
+
+	slog.Info("Successfully rotated database key", "db", dbOpts)
+
+	return nil
+}
@@ -0,0 +1,97 @@
+// Copyright 2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/oxisto/money-gopher/persistence"
+
+	"github.com/lmittmann/tint"
+)
+
+// readyTimeout is the time a single readiness check may take.
+const readyTimeout = 2 * time.Second
+
+// health serves the liveness and readiness endpoints of the server.
+type health struct {
+	db      *persistence.DB
+	jwksURL string
+	client  *http.Client
+
+	// shuttingDown is set once the server starts to shut down, so that load
+	// balancers stop sending new requests.
+	shuttingDown atomic.Bool
+}
+
+// handleHealthz reports that the process is alive.
+func (h *health) handleHealthz(w http.ResponseWriter, _ *http.Request) {
+	w.Write([]byte("ok"))
+}
+
+// handleReadyz reports whether the server is able to serve requests, i.e.,
+// whether the database and the authorization server are reachable.
+func (h *health) handleReadyz(w http.ResponseWriter, r *http.Request) {
+	if err := h.ready(r.Context()); err != nil {
+		slog.Warn("Server is not ready", tint.Err(err))
+		http.Error(w, "not ready", http.StatusServiceUnavailable)
+		return
+	}
+
+	w.Write([]byte("ok"))
+}
+
+// ready checks all dependencies of the server.
+func (h *health) ready(ctx context.Context) (err error) {
+	if h.shuttingDown.Load() {
+		return errors.New("server is shutting down")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readyTimeout)
+	defer cancel()
+
+	if err = h.db.PingContext(ctx); err != nil {
+		return fmt.Errorf("database is not reachable: %w", err)
+	}
+
+	if h.jwksURL == "" {
+		return nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, h.jwksURL, nil)
+	if err != nil {
+		return err
+	}
+
+	res, err := h.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("authorization server is not reachable: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("authorization server returned status %d", res.StatusCode)
+	}
+
+	return nil
+}
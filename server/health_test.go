@@ -0,0 +1,96 @@
+// Copyright 2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/oxisto/money-gopher/internal"
+
+	"github.com/oxisto/assert"
+)
+
+func Test_health_handleReadyz(t *testing.T) {
+	jwks := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/certs" {
+			http.NotFound(w, r)
+			return
+		}
+
+		w.Write([]byte(`{"keys":[]}`))
+	}))
+	defer jwks.Close()
+
+	closedDB := internal.NewTestDB(t)
+	closedDB.Close()
+
+	tests := []struct {
+		name         string
+		h            *health
+		shuttingDown bool
+		wantCode     int
+	}{
+		{
+			name:     "ready",
+			h:        &health{db: internal.NewTestDB(t), jwksURL: jwks.URL + "/certs", client: http.DefaultClient},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:     "without JWKS URL",
+			h:        &health{db: internal.NewTestDB(t), client: http.DefaultClient},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:     "database closed",
+			h:        &health{db: closedDB, jwksURL: jwks.URL + "/certs", client: http.DefaultClient},
+			wantCode: http.StatusServiceUnavailable,
+		},
+		{
+			name:     "JWKS not found",
+			h:        &health{db: internal.NewTestDB(t), jwksURL: jwks.URL + "/notfound", client: http.DefaultClient},
+			wantCode: http.StatusServiceUnavailable,
+		},
+		{
+			name:         "shutting down",
+			h:            &health{db: internal.NewTestDB(t), jwksURL: jwks.URL + "/certs", client: http.DefaultClient},
+			shuttingDown: true,
+			wantCode:     http.StatusServiceUnavailable,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.h.shuttingDown.Store(tt.shuttingDown)
+
+			rr := httptest.NewRecorder()
+			tt.h.handleReadyz(rr, httptest.NewRequestWithContext(context.Background(), "GET", "/readyz", nil))
+			assert.Equals(t, tt.wantCode, rr.Code)
+		})
+	}
+}
+
+func Test_health_handleHealthz(t *testing.T) {
+	// Liveness does not depend on the shutdown
+	h := &health{}
+	h.shuttingDown.Store(true)
+
+	rr := httptest.NewRecorder()
+	h.handleHealthz(rr, httptest.NewRequest("GET", "/healthz", nil))
+	assert.Equals(t, http.StatusOK, rr.Code)
+}
@@ -19,80 +19,339 @@ package server
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log/slog"
+	"net/http"
 	"strings"
+	"time"
+
+	"github.com/oxisto/money-gopher/auth"
 
 	"connectrpc.com/connect"
+	"github.com/MicahParks/jwkset"
 	"github.com/MicahParks/keyfunc/v3"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/lmittmann/tint"
+	"golang.org/x/time/rate"
 )
 
+// loggingInterceptor is a simple interceptor that logs every request.
+type loggingInterceptor struct{}
+
 // NewSimpleLoggingInterceptor returns a new simple logging interceptor.
-func NewSimpleLoggingInterceptor() connect.UnaryInterceptorFunc {
-	interceptor := func(next connect.UnaryFunc) connect.UnaryFunc {
-		return connect.UnaryFunc(func(
-			ctx context.Context,
-			req connect.AnyRequest,
-		) (connect.AnyResponse, error) {
-			slog.Debug("Handling RPC Request",
-				slog.Group("req",
-					"procedure", req.Spec().Procedure,
-					"httpmethod", req.HTTPMethod(),
-				))
-			return next(ctx, req)
-		})
+func NewSimpleLoggingInterceptor() connect.Interceptor {
+	return &loggingInterceptor{}
+}
+
+func (*loggingInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return connect.UnaryFunc(func(
+		ctx context.Context,
+		req connect.AnyRequest,
+	) (connect.AnyResponse, error) {
+		slog.Debug("Handling RPC Request",
+			slog.Group("req",
+				"procedure", req.Spec().Procedure,
+				"httpmethod", req.HTTPMethod(),
+			))
+		return next(ctx, req)
+	})
+}
+
+func (*loggingInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (*loggingInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return connect.StreamingHandlerFunc(func(
+		ctx context.Context,
+		conn connect.StreamingHandlerConn,
+	) error {
+		slog.Debug("Handling RPC Stream",
+			slog.Group("req",
+				"procedure", conn.Spec().Procedure,
+				"streamtype", conn.Spec().StreamType,
+			))
+		return next(ctx, conn)
+	})
+}
+
+// AuthOptions holds all options to configure the auth interceptor.
+type AuthOptions struct {
+	// JWKSURL is the URL of the JSON Web Key Set that is used to verify tokens.
+	JWKSURL string
+
+	// Issuer is the expected issuer of tokens. If it is empty, the issuer is
+	// not validated.
+	Issuer string
+
+	// Audience is the expected audience of tokens. If it is empty, the
+	// audience is not validated.
+	Audience string
+
+	// Claims specifies which claims identify the user.
+	Claims auth.ClaimMapping
+
+	// Client is used to retrieve the JSON Web Key Set.
+	Client *http.Client
+
+	// AccessTokens verifies personal access tokens, which are accepted next
+	// to JWTs. If it is nil, access tokens are rejected.
+	AccessTokens AccessTokenVerifier
+}
+
+// AccessTokenVerifier verifies personal access tokens.
+type AccessTokenVerifier interface {
+	// VerifyAccessToken verifies the access token and returns the user it
+	// belongs to.
+	VerifyAccessToken(ctx context.Context, token string) (*auth.User, error)
+
+	// ObserveUser records the current roles of a user that authenticated with
+	// a JWT, which restrict the permissions of its access tokens.
+	ObserveUser(ctx context.Context, u *auth.User) error
+}
+
+// authInterceptor verifies tokens and adds the authenticated [auth.User] to the
+// context.
+type authInterceptor struct {
+	opts AuthOptions
+	v    *tokenVerifier
+}
+
+// NewAuthInterceptor returns a new auth interceptor that verifies tokens
+// according to opts and adds the authenticated [auth.User] to the context.
+// Tokens are either JWTs or personal access tokens.
+func NewAuthInterceptor(opts AuthOptions) connect.Interceptor {
+	var kf jwt.Keyfunc
+
+	k, err := newKeyfunc(opts.JWKSURL, opts.Client)
+	if err != nil {
+		slog.Error("Error while setting up JWKS", tint.Err(err))
+
+		// Reject all JWTs, since we cannot verify them
+		kf = func(*jwt.Token) (any, error) {
+			return nil, fmt.Errorf("JWKS not available: %w", err)
+		}
+	} else {
+		kf = k.Keyfunc
 	}
 
-	return connect.UnaryInterceptorFunc(interceptor)
+	return &authInterceptor{
+		opts: opts,
+		v:    newTokenVerifier(kf, opts),
+	}
+}
+
+func (i *authInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return connect.UnaryFunc(func(
+		ctx context.Context,
+		req connect.AnyRequest,
+	) (connect.AnyResponse, error) {
+		ctx, err := i.authenticate(ctx, req.Header())
+		if err != nil {
+			return nil, err
+		}
+
+		return next(ctx, req)
+	})
+}
+
+func (*authInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
 }
 
-// NewAuthInterceptor returns a new auth interceptor.
-func NewAuthInterceptor() connect.UnaryInterceptorFunc {
-	interceptor := func(next connect.UnaryFunc) connect.UnaryFunc {
-		k, err := keyfunc.NewDefault([]string{"http://localhost:8000/certs"})
+func (i *authInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return connect.StreamingHandlerFunc(func(
+		ctx context.Context,
+		conn connect.StreamingHandlerConn,
+	) error {
+		ctx, err := i.authenticate(ctx, conn.RequestHeader())
 		if err != nil {
-			slog.Error("Error while setting up JWKS", tint.Err(err))
+			return err
 		}
 
-		return connect.UnaryFunc(func(
-			ctx context.Context,
-			req connect.AnyRequest,
-		) (connect.AnyResponse, error) {
-			var (
-				claims jwt.RegisteredClaims
-				auth   string
-				token  string
-				err    error
-				ok     bool
-			)
-			auth = req.Header().Get("Authorization")
-			if auth == "" {
-				return nil, connect.NewError(
-					connect.CodeUnauthenticated,
-					errors.New("no token provided"),
-				)
-			}
-
-			token, ok = strings.CutPrefix(auth, "Bearer ")
-			if !ok {
-				return nil, connect.NewError(
-					connect.CodeUnauthenticated,
-					errors.New("no token provided"),
-				)
-			}
-
-			_, err = jwt.ParseWithClaims(token, &claims, k.Keyfunc)
-			if err != nil {
-				return nil, connect.NewError(
-					connect.CodeUnauthenticated,
-					err,
-				)
-			}
-
-			ctx = context.WithValue(ctx, "claims", claims)
-			return next(ctx, req)
-		})
+		return next(ctx, conn)
+	})
+}
+
+// authenticate verifies the bearer token in the Authorization header and
+// returns a context that carries the authenticated user.
+func (i *authInterceptor) authenticate(ctx context.Context, header http.Header) (context.Context, error) {
+	var (
+		user  *auth.User
+		token string
+		err   error
+		ok    bool
+	)
+
+	token, ok = strings.CutPrefix(header.Get("Authorization"), "Bearer ")
+	if !ok || token == "" {
+		return nil, connect.NewError(
+			connect.CodeUnauthenticated,
+			errors.New("no token provided"),
+		)
+	}
+
+	if auth.IsAccessToken(token) && i.opts.AccessTokens != nil {
+		user, err = i.opts.AccessTokens.VerifyAccessToken(ctx, token)
+	} else {
+		user, err = i.v.verify(token)
+	}
+	if err != nil {
+		return nil, connect.NewError(
+			connect.CodeUnauthenticated,
+			err,
+		)
 	}
-	return connect.UnaryInterceptorFunc(interceptor)
+
+	// Access tokens must not keep permissions that the roles of their owner no
+	// longer grant. Since we cannot ask the identity provider, we remember the
+	// roles of every user that authenticates with it.
+	if !auth.IsAccessToken(token) && i.opts.AccessTokens != nil {
+		if err = i.opts.AccessTokens.ObserveUser(ctx, user); err != nil {
+			slog.Warn("Could not record roles of user", tint.Err(err), "subject", user.Subject)
+		}
+	}
+
+	return auth.NewContext(ctx, user), nil
+}
+
+// authorizationInterceptor checks whether the authenticated [auth.User] is
+// allowed to call a procedure.
+type authorizationInterceptor struct {
+	permissions map[string]auth.Permission
+}
+
+// NewAuthorizationInterceptor returns a new interceptor that checks whether the
+// authenticated [auth.User] has the permission that is required to call the
+// procedure, as specified by permissions. It needs to be placed after the
+// auth interceptor.
+func NewAuthorizationInterceptor(permissions map[string]auth.Permission) connect.Interceptor {
+	return &authorizationInterceptor{permissions: permissions}
+}
+
+func (i *authorizationInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return connect.UnaryFunc(func(
+		ctx context.Context,
+		req connect.AnyRequest,
+	) (connect.AnyResponse, error) {
+		err := authorize(ctx, i.permissions, req.Spec().Procedure)
+		if err != nil {
+			return nil, err
+		}
+
+		return next(ctx, req)
+	})
+}
+
+func (*authorizationInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i *authorizationInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return connect.StreamingHandlerFunc(func(
+		ctx context.Context,
+		conn connect.StreamingHandlerConn,
+	) error {
+		err := authorize(ctx, i.permissions, conn.Spec().Procedure)
+		if err != nil {
+			return err
+		}
+
+		return next(ctx, conn)
+	})
+}
+
+// authorize checks whether the user in ctx is allowed to call procedure.
+func authorize(ctx context.Context, permissions map[string]auth.Permission, procedure string) error {
+	user, ok := auth.FromContext(ctx)
+	if !ok {
+		return connect.NewError(
+			connect.CodeUnauthenticated,
+			errors.New("no authenticated user"),
+		)
+	}
+
+	p, ok := permissions[procedure]
+	if !ok || !user.HasPermission(p) {
+		slog.Debug("Denied RPC request",
+			"procedure", procedure,
+			"sub", user.Subject,
+			"roles", user.Roles,
+			"permission", p,
+		)
+
+		return connect.NewError(
+			connect.CodePermissionDenied,
+			fmt.Errorf("permission %q required", p),
+		)
+	}
+
+	return nil
+}
+
+// tokenVerifier verifies tokens and maps their claims to a user.
+type tokenVerifier struct {
+	keyfunc jwt.Keyfunc
+	parser  *jwt.Parser
+	claims  auth.ClaimMapping
+}
+
+// newTokenVerifier creates a new [tokenVerifier] that retrieves keys from
+// keyfunc and validates issuer and audience according to opts.
+func newTokenVerifier(keyfunc jwt.Keyfunc, opts AuthOptions) *tokenVerifier {
+	var parserOpts []jwt.ParserOption
+
+	if opts.Issuer != "" {
+		parserOpts = append(parserOpts, jwt.WithIssuer(opts.Issuer))
+	}
+
+	if opts.Audience != "" {
+		parserOpts = append(parserOpts, jwt.WithAudience(opts.Audience))
+	}
+
+	return &tokenVerifier{
+		keyfunc: keyfunc,
+		parser:  jwt.NewParser(parserOpts...),
+		claims:  opts.Claims,
+	}
+}
+
+// verify verifies the token and returns the user it belongs to.
+func (v *tokenVerifier) verify(token string) (user *auth.User, err error) {
+	var claims jwt.MapClaims
+
+	_, err = v.parser.ParseWithClaims(token, &claims, v.keyfunc)
+	if err != nil {
+		return nil, err
+	}
+
+	return v.claims.User(claims)
+}
+
+// newKeyfunc creates a new [keyfunc.Keyfunc] that retrieves the JSON Web Key
+// Set from jwksURL using client. Apart from the client, it behaves like
+// [keyfunc.NewDefault].
+func newKeyfunc(jwksURL string, client *http.Client) (keyfunc.Keyfunc, error) {
+	storage, err := jwkset.NewStorageFromHTTP(jwksURL, jwkset.HTTPClientStorageOptions{
+		Client:                    client,
+		NoErrorReturnFirstHTTPReq: true,
+		RefreshErrorHandler: func(ctx context.Context, err error) {
+			slog.Error("Failed to refresh JWKS", tint.Err(err), "url", jwksURL)
+		},
+		RefreshInterval: time.Hour,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	storage, err = jwkset.NewHTTPClient(jwkset.HTTPClientOptions{
+		HTTPURLs:          map[string]jwkset.Storage{jwksURL: storage},
+		RateLimitWaitMax:  time.Minute,
+		RefreshUnknownKID: rate.NewLimiter(rate.Every(5*time.Minute), 1),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return keyfunc.New(keyfunc.Options{Storage: storage})
 }
@@ -0,0 +1,315 @@
+// Copyright 2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package server
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/oxisto/money-gopher/auth"
+	portfoliov1 "github.com/oxisto/money-gopher/gen"
+	"github.com/oxisto/money-gopher/gen/portfoliov1connect"
+
+	"connectrpc.com/connect"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/oxisto/assert"
+)
+
+func Test_tokenVerifier_verify(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	keyfunc := func(*jwt.Token) (any, error) {
+		return &key.PublicKey, nil
+	}
+
+	sign := func(claims jwt.MapClaims) string {
+		claims["exp"] = time.Now().Add(time.Hour).Unix()
+		token, err := jwt.NewWithClaims(jwt.SigningMethodES256, claims).SignedString(key)
+		assert.NoError(t, err)
+		return token
+	}
+
+	opts := AuthOptions{
+		Issuer:   "https://keycloak.example.com/realms/money",
+		Audience: "money-gopher",
+		Claims:   auth.ClaimMapping{Name: "name"},
+	}
+
+	tests := []struct {
+		name    string
+		opts    AuthOptions
+		claims  jwt.MapClaims
+		want    *auth.User
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			opts: opts,
+			claims: jwt.MapClaims{
+				"iss":  "https://keycloak.example.com/realms/money",
+				"aud":  []string{"money-gopher", "account"},
+				"sub":  "f81d4fae",
+				"name": "Money Gopher",
+			},
+			want: &auth.User{Subject: "f81d4fae", Name: "Money Gopher"},
+		},
+		{
+			name: "wrong issuer",
+			opts: opts,
+			claims: jwt.MapClaims{
+				"iss": "https://evil.example.com",
+				"aud": "money-gopher",
+				"sub": "f81d4fae",
+			},
+			wantErr: true,
+		},
+		{
+			name: "wrong audience",
+			opts: opts,
+			claims: jwt.MapClaims{
+				"iss": "https://keycloak.example.com/realms/money",
+				"aud": "account",
+				"sub": "f81d4fae",
+			},
+			wantErr: true,
+		},
+		{
+			name:   "embedded server without issuer and audience",
+			opts:   AuthOptions{},
+			claims: jwt.MapClaims{"sub": "cli"},
+			want:   &auth.User{Subject: "cli", Name: "cli"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := newTokenVerifier(keyfunc, tt.opts)
+
+			got, err := v.verify(sign(tt.claims))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("tokenVerifier.verify() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			assert.Equals(t, tt.want, got)
+		})
+	}
+}
+
+func Test_authorize(t *testing.T) {
+	var (
+		viewer = auth.NewContext(context.Background(), &auth.User{Subject: "viewer", Roles: []auth.Role{auth.RoleViewer}})
+		editor = auth.NewContext(context.Background(), &auth.User{Subject: "editor", Roles: []auth.Role{auth.RoleEditor}})
+		admin  = auth.NewContext(context.Background(), &auth.User{Subject: "admin", Roles: []auth.Role{auth.RoleAdmin}})
+	)
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		procedure string
+		want      connect.Code
+	}{
+		{
+			name:      "viewer can get snapshot",
+			ctx:       viewer,
+			procedure: portfoliov1connect.PortfolioServiceGetPortfolioSnapshotProcedure,
+		},
+		{
+			name:      "viewer cannot import transactions",
+			ctx:       viewer,
+			procedure: portfoliov1connect.PortfolioServiceImportTransactionsProcedure,
+			want:      connect.CodePermissionDenied,
+		},
+		{
+			name:      "viewer cannot trigger quote update",
+			ctx:       viewer,
+			procedure: portfoliov1connect.SecuritiesServiceTriggerSecurityQuoteUpdateProcedure,
+			want:      connect.CodePermissionDenied,
+		},
+		{
+			name:      "editor can import transactions",
+			ctx:       editor,
+			procedure: portfoliov1connect.PortfolioServiceImportTransactionsProcedure,
+		},
+		{
+			name:      "editor cannot export dump",
+			ctx:       editor,
+			procedure: portfoliov1connect.AdminServiceExportDumpProcedure,
+			want:      connect.CodePermissionDenied,
+		},
+		{
+			name:      "admin can export dump",
+			ctx:       admin,
+			procedure: portfoliov1connect.AdminServiceExportDumpProcedure,
+		},
+		{
+			name:      "unknown procedure",
+			ctx:       admin,
+			procedure: "/mgo.portfolio.v1.PortfolioService/Unknown",
+			want:      connect.CodePermissionDenied,
+		},
+		{
+			name:      "no user",
+			ctx:       context.Background(),
+			procedure: portfoliov1connect.PortfolioServiceGetPortfolioSnapshotProcedure,
+			want:      connect.CodeUnauthenticated,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := authorize(tt.ctx, procedurePermissions, tt.procedure)
+			if tt.want == 0 {
+				assert.NoError(t, err)
+			} else {
+				assert.Equals(t, tt.want, connect.CodeOf(err))
+			}
+		})
+	}
+}
+
+func Test_procedurePermissions(t *testing.T) {
+	// Make sure that we do not forget to assign a permission to new procedures
+	services := portfoliov1.File_mgo_proto.Services()
+	for i := 0; i < services.Len(); i++ {
+		methods := services.Get(i).Methods()
+		for j := 0; j < methods.Len(); j++ {
+			procedure := fmt.Sprintf("/%s/%s", services.Get(i).FullName(), methods.Get(j).Name())
+
+			if _, ok := procedurePermissions[procedure]; !ok {
+				t.Errorf("no permission for procedure %s", procedure)
+			}
+		}
+	}
+}
+
+func Test_authInterceptor_WrapStreamingHandler(t *testing.T) {
+	i := &authInterceptor{
+		opts: AuthOptions{AccessTokens: mockVerifier{}},
+		v: newTokenVerifier(func(*jwt.Token) (any, error) {
+			return nil, errors.New("no key")
+		}, AuthOptions{}),
+	}
+
+	tests := []struct {
+		name   string
+		header string
+		want   *auth.User
+		code   connect.Code
+	}{
+		{
+			name:   "access token",
+			header: "Bearer mgo_valid",
+			want:   &auth.User{Subject: "money"},
+		},
+		{
+			name:   "invalid JWT",
+			header: "Bearer ey",
+			code:   connect.CodeUnauthenticated,
+		},
+		{
+			name: "no token",
+			code: connect.CodeUnauthenticated,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got *auth.User
+
+			conn := &mockStreamingHandlerConn{header: http.Header{}}
+			if tt.header != "" {
+				conn.header.Set("Authorization", tt.header)
+			}
+
+			err := i.WrapStreamingHandler(func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+				got, _ = auth.FromContext(ctx)
+				return nil
+			})(context.Background(), conn)
+			if tt.code == 0 {
+				assert.NoError(t, err)
+			} else {
+				assert.Equals(t, tt.code, connect.CodeOf(err))
+			}
+
+			assert.Equals(t, tt.want, got)
+		})
+	}
+}
+
+func Test_authorizationInterceptor_WrapStreamingHandler(t *testing.T) {
+	var called bool
+
+	i := NewAuthorizationInterceptor(procedurePermissions)
+	viewer := auth.NewContext(context.Background(), &auth.User{Subject: "viewer", Roles: []auth.Role{auth.RoleViewer}})
+
+	err := i.WrapStreamingHandler(func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		called = true
+		return nil
+	})(viewer, &mockStreamingHandlerConn{
+		procedure: portfoliov1connect.PortfolioServiceWatchPortfolioSnapshotProcedure,
+	})
+	assert.NoError(t, err)
+	assert.Equals(t, true, called)
+
+	// Without a user, the handler must not be called
+	called = false
+	err = i.WrapStreamingHandler(func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		called = true
+		return nil
+	})(context.Background(), &mockStreamingHandlerConn{
+		procedure: portfoliov1connect.PortfolioServiceWatchPortfolioSnapshotProcedure,
+	})
+	assert.Equals(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+	assert.Equals(t, false, called)
+}
+
+type mockVerifier struct{}
+
+func (mockVerifier) VerifyAccessToken(_ context.Context, token string) (*auth.User, error) {
+	if token != "mgo_valid" {
+		return nil, errors.New("invalid token")
+	}
+
+	return &auth.User{Subject: "money"}, nil
+}
+
+func (mockVerifier) ObserveUser(context.Context, *auth.User) error {
+	return nil
+}
+
+// mockStreamingHandlerConn is a [connect.StreamingHandlerConn] that only
+// supports the spec and the request header.
+type mockStreamingHandlerConn struct {
+	connect.StreamingHandlerConn
+
+	procedure string
+	header    http.Header
+}
+
+func (c *mockStreamingHandlerConn) Spec() connect.Spec {
+	return connect.Spec{Procedure: c.procedure, StreamType: connect.StreamTypeServer}
+}
+
+func (c *mockStreamingHandlerConn) RequestHeader() http.Header {
+	return c.header
+}
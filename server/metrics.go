@@ -0,0 +1,84 @@
+// Copyright 2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/oxisto/money-gopher/metrics"
+
+	"connectrpc.com/connect"
+)
+
+// metricsInterceptor records the number and latency of all RPCs in
+// [metrics.RPCRequests] and [metrics.RPCDuration].
+type metricsInterceptor struct{}
+
+// NewMetricsInterceptor returns a new interceptor that records metrics about
+// all RPCs. It needs to be placed first, so that rejected requests are
+// recorded as well.
+func NewMetricsInterceptor() connect.Interceptor {
+	return &metricsInterceptor{}
+}
+
+func (*metricsInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return connect.UnaryFunc(func(
+		ctx context.Context,
+		req connect.AnyRequest,
+	) (connect.AnyResponse, error) {
+		defer observe(req.Spec().Procedure, time.Now())
+
+		res, err := next(ctx, req)
+		metrics.RPCRequests.WithLabelValues(req.Spec().Procedure, code(err)).Inc()
+
+		return res, err
+	})
+}
+
+func (*metricsInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (*metricsInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return connect.StreamingHandlerFunc(func(
+		ctx context.Context,
+		conn connect.StreamingHandlerConn,
+	) error {
+		defer observe(conn.Spec().Procedure, time.Now())
+
+		err := next(ctx, conn)
+		metrics.RPCRequests.WithLabelValues(conn.Spec().Procedure, code(err)).Inc()
+
+		return err
+	})
+}
+
+// observe records the latency of the procedure since start.
+func observe(procedure string, start time.Time) {
+	metrics.ObserveDuration(metrics.RPCDuration.WithLabelValues(procedure), start)
+}
+
+// code returns the name of the status code of err, which is "ok" if err is
+// nil.
+func code(err error) string {
+	if err == nil {
+		return "ok"
+	}
+
+	return connect.CodeOf(err).String()
+}
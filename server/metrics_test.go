@@ -0,0 +1,53 @@
+// Copyright 2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/oxisto/money-gopher/gen/portfoliov1connect"
+	"github.com/oxisto/money-gopher/metrics"
+
+	"connectrpc.com/connect"
+	"github.com/oxisto/assert"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func Test_metricsInterceptor(t *testing.T) {
+	i := NewMetricsInterceptor()
+
+	// A request without a spec is recorded with an empty procedure
+	_, err := i.WrapUnary(func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		return connect.NewResponse(&emptypb.Empty{}), nil
+	})(context.Background(), connect.NewRequest(&emptypb.Empty{}))
+	assert.NoError(t, err)
+	assert.Equals(t, 1.0, testutil.ToFloat64(metrics.RPCRequests.WithLabelValues("", "ok")))
+
+	err = i.WrapStreamingHandler(func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		return connect.NewError(connect.CodePermissionDenied, errors.New("denied"))
+	})(context.Background(), &mockStreamingHandlerConn{
+		procedure: portfoliov1connect.PortfolioServiceWatchPortfolioSnapshotProcedure,
+	})
+	assert.Equals(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	assert.Equals(t, 1.0, testutil.ToFloat64(metrics.RPCRequests.WithLabelValues(
+		portfoliov1connect.PortfolioServiceWatchPortfolioSnapshotProcedure, "permission_denied",
+	)))
+	assert.Equals(t, 2, testutil.CollectAndCount(metrics.RPCDuration))
+}
@@ -0,0 +1,93 @@
+// Copyright 2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package server
+
+import (
+	"github.com/oxisto/money-gopher/auth"
+	"github.com/oxisto/money-gopher/gen/portfoliov1connect"
+)
+
+// procedurePermissions contains the permission that is required to call each
+// procedure. Procedures that are not contained cannot be called by anyone.
+var procedurePermissions = map[string]auth.Permission{
+	portfoliov1connect.PortfolioServiceCreatePortfolioProcedure:                  auth.PermissionWritePortfolios,
+	portfoliov1connect.PortfolioServiceListPortfoliosProcedure:                   auth.PermissionReadPortfolios,
+	portfoliov1connect.PortfolioServiceGetPortfolioProcedure:                     auth.PermissionReadPortfolios,
+	portfoliov1connect.PortfolioServiceUpdatePortfolioProcedure:                  auth.PermissionWritePortfolios,
+	portfoliov1connect.PortfolioServiceDeletePortfolioProcedure:                  auth.PermissionWritePortfolios,
+	portfoliov1connect.PortfolioServiceGetPortfolioSnapshotProcedure:             auth.PermissionReadPortfolios,
+	portfoliov1connect.PortfolioServiceWatchPortfolioSnapshotProcedure:           auth.PermissionReadPortfolios,
+	portfoliov1connect.PortfolioServiceGetConsolidatedSnapshotProcedure:          auth.PermissionReadPortfolios,
+	portfoliov1connect.PortfolioServiceCreatePortfolioTransactionProcedure:       auth.PermissionWritePortfolios,
+	portfoliov1connect.PortfolioServiceGetPortfolioTransactionProcedure:          auth.PermissionReadPortfolios,
+	portfoliov1connect.PortfolioServiceListPortfolioTransactionsProcedure:        auth.PermissionReadPortfolios,
+	portfoliov1connect.PortfolioServiceUpdatePortfolioTransactionProcedure:       auth.PermissionWritePortfolios,
+	portfoliov1connect.PortfolioServiceDeletePortfolioTransactionProcedure:       auth.PermissionWritePortfolios,
+	portfoliov1connect.PortfolioServiceBatchCreatePortfolioTransactionsProcedure: auth.PermissionWritePortfolios,
+	portfoliov1connect.PortfolioServiceBatchUpdatePortfolioTransactionsProcedure: auth.PermissionWritePortfolios,
+	portfoliov1connect.PortfolioServiceBatchDeletePortfolioTransactionsProcedure: auth.PermissionWritePortfolios,
+	portfoliov1connect.PortfolioServiceImportTransactionsProcedure:               auth.PermissionWritePortfolios,
+	portfoliov1connect.PortfolioServiceConfirmPortfolioTransactionProcedure:      auth.PermissionWritePortfolios,
+	portfoliov1connect.PortfolioServiceUploadAttachmentProcedure:                 auth.PermissionWritePortfolios,
+	portfoliov1connect.PortfolioServiceDownloadAttachmentProcedure:               auth.PermissionReadPortfolios,
+	portfoliov1connect.PortfolioServiceListAttachmentsProcedure:                  auth.PermissionReadPortfolios,
+	portfoliov1connect.PortfolioServiceDeleteAttachmentProcedure:                 auth.PermissionWritePortfolios,
+	portfoliov1connect.PortfolioServiceCreatePortfolioTransferProcedure:          auth.PermissionWritePortfolios,
+	portfoliov1connect.PortfolioServiceCreateBankAccountProcedure:                auth.PermissionWritePortfolios,
+	portfoliov1connect.PortfolioServiceListBankAccountsProcedure:                 auth.PermissionReadPortfolios,
+	portfoliov1connect.PortfolioServiceGetBankAccountProcedure:                   auth.PermissionReadPortfolios,
+	portfoliov1connect.PortfolioServiceGetCashLedgerProcedure:                    auth.PermissionReadPortfolios,
+	portfoliov1connect.PortfolioServiceUpdateBankAccountProcedure:                auth.PermissionWritePortfolios,
+	portfoliov1connect.PortfolioServiceDeleteBankAccountProcedure:                auth.PermissionWritePortfolios,
+	portfoliov1connect.PortfolioServiceSharePortfolioProcedure:                   auth.PermissionWritePortfolios,
+	portfoliov1connect.PortfolioServiceUnsharePortfolioProcedure:                 auth.PermissionWritePortfolios,
+	portfoliov1connect.PortfolioServiceCreateWatchlistProcedure:                  auth.PermissionWritePortfolios,
+	portfoliov1connect.PortfolioServiceListWatchlistsProcedure:                   auth.PermissionReadPortfolios,
+	portfoliov1connect.PortfolioServiceGetWatchlistProcedure:                     auth.PermissionReadPortfolios,
+	portfoliov1connect.PortfolioServiceUpdateWatchlistProcedure:                  auth.PermissionWritePortfolios,
+	portfoliov1connect.PortfolioServiceDeleteWatchlistProcedure:                  auth.PermissionWritePortfolios,
+	portfoliov1connect.PortfolioServiceCreateSavingsPlanProcedure:                auth.PermissionWritePortfolios,
+	portfoliov1connect.PortfolioServiceListSavingsPlansProcedure:                 auth.PermissionReadPortfolios,
+	portfoliov1connect.PortfolioServiceGetSavingsPlanProcedure:                   auth.PermissionReadPortfolios,
+	portfoliov1connect.PortfolioServiceUpdateSavingsPlanProcedure:                auth.PermissionWritePortfolios,
+	portfoliov1connect.PortfolioServiceDeleteSavingsPlanProcedure:                auth.PermissionWritePortfolios,
+	portfoliov1connect.PortfolioServiceListPortfolioSharesProcedure:              auth.PermissionReadPortfolios,
+	portfoliov1connect.PortfolioServiceListTrashProcedure:                        auth.PermissionReadPortfolios,
+	portfoliov1connect.PortfolioServiceRestoreFromTrashProcedure:                 auth.PermissionWritePortfolios,
+
+	portfoliov1connect.SecuritiesServiceListSecuritiesProcedure:             auth.PermissionReadSecurities,
+	portfoliov1connect.SecuritiesServiceGetSecurityProcedure:                auth.PermissionReadSecurities,
+	portfoliov1connect.SecuritiesServiceCreateSecurityProcedure:             auth.PermissionWriteSecurities,
+	portfoliov1connect.SecuritiesServiceUpdateSecurityProcedure:             auth.PermissionWriteSecurities,
+	portfoliov1connect.SecuritiesServiceDeleteSecurityProcedure:             auth.PermissionWriteSecurities,
+	portfoliov1connect.SecuritiesServiceTriggerSecurityQuoteUpdateProcedure: auth.PermissionUpdateQuotes,
+
+	portfoliov1connect.TokenServiceCreateAccessTokenProcedure: auth.PermissionManageTokens,
+	portfoliov1connect.TokenServiceListAccessTokensProcedure:  auth.PermissionManageTokens,
+	portfoliov1connect.TokenServiceDeleteAccessTokenProcedure: auth.PermissionManageTokens,
+
+	portfoliov1connect.AuditServiceListAuditEventsProcedure: auth.PermissionReadAuditLog,
+
+	portfoliov1connect.AlertServiceCreateAlertRuleProcedure:     auth.PermissionWritePortfolios,
+	portfoliov1connect.AlertServiceListAlertRulesProcedure:      auth.PermissionReadPortfolios,
+	portfoliov1connect.AlertServiceDeleteAlertRuleProcedure:     auth.PermissionWritePortfolios,
+	portfoliov1connect.AlertServiceListTriggeredAlertsProcedure: auth.PermissionReadPortfolios,
+
+	portfoliov1connect.AdminServiceCreateBackupProcedure: auth.PermissionAdministrate,
+	portfoliov1connect.AdminServiceExportDumpProcedure:   auth.PermissionAdministrate,
+	portfoliov1connect.AdminServiceRestoreDumpProcedure:  auth.PermissionAdministrate,
+}
@@ -0,0 +1,44 @@
+// Copyright 2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package server
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/oxisto/money-gopher/gen/portfoliov1connect"
+
+	"connectrpc.com/connect"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+// newSecuritiesClient creates a client for a securities service that runs in
+// another process, e.g., in a split deployment. It authenticates with a
+// dedicated service credential using the OAuth 2.0 client credentials flow.
+// Tokens are retrieved using client, which is also used for the requests.
+func newSecuritiesClient(opts Options, client *http.Client, options ...connect.ClientOption) portfoliov1connect.SecuritiesServiceClient {
+	config := clientcredentials.Config{
+		ClientID:     opts.SecuritiesServiceClientID,
+		ClientSecret: opts.SecuritiesServiceClientSecret,
+		TokenURL:     opts.SecuritiesServiceTokenURL,
+	}
+
+	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, client)
+
+	return portfoliov1connect.NewSecuritiesServiceClient(config.Client(ctx), opts.SecuritiesServiceURL, options...)
+}
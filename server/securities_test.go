@@ -0,0 +1,81 @@
+// Copyright 2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	portfoliov1 "github.com/oxisto/money-gopher/gen"
+	"github.com/oxisto/money-gopher/gen/portfoliov1connect"
+
+	"connectrpc.com/connect"
+	"github.com/oxisto/assert"
+)
+
+// securitiesHandler is a securities service that records the authorization
+// header of the last request.
+type securitiesHandler struct {
+	portfoliov1connect.UnimplementedSecuritiesServiceHandler
+
+	authorization string
+}
+
+func (h *securitiesHandler) ListSecurities(ctx context.Context, req *connect.Request[portfoliov1.ListSecuritiesRequest]) (*connect.Response[portfoliov1.ListSecuritiesResponse], error) {
+	h.authorization = req.Header().Get("Authorization")
+	return connect.NewResponse(&portfoliov1.ListSecuritiesResponse{}), nil
+}
+
+func Test_newSecuritiesClient(t *testing.T) {
+	var h = new(securitiesHandler)
+
+	// A minimal token endpoint that only supports client credentials
+	tokenSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, secret, ok := r.BasicAuth()
+		if !ok || id != "portfolio" || secret != "secret" || r.FormValue("grant_type") != "client_credentials" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{
+			"access_token": "service-token",
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+		})
+	}))
+	defer tokenSrv.Close()
+
+	mux := http.NewServeMux()
+	mux.Handle(portfoliov1connect.NewSecuritiesServiceHandler(h))
+	securitiesSrv := httptest.NewServer(mux)
+	defer securitiesSrv.Close()
+
+	client := newSecuritiesClient(Options{
+		SecuritiesServiceURL:          securitiesSrv.URL,
+		SecuritiesServiceClientID:     "portfolio",
+		SecuritiesServiceClientSecret: "secret",
+		SecuritiesServiceTokenURL:     tokenSrv.URL,
+	}, http.DefaultClient)
+
+	_, err := client.ListSecurities(context.Background(), connect.NewRequest(&portfoliov1.ListSecuritiesRequest{}))
+	assert.NoError(t, err)
+	assert.Equals(t, "Bearer service-token", h.authorization)
+}
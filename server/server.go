@@ -17,76 +17,502 @@
 package server
 
 import (
+	"context"
 	"crypto/ecdsa"
+	"crypto/tls"
+	"errors"
+	"fmt"
 	"log/slog"
+	"net"
 	"net/http"
+	"net/url"
+	"strings"
+	"time"
 
+	"github.com/oxisto/money-gopher/auth"
 	"github.com/oxisto/money-gopher/gen/portfoliov1connect"
+	"github.com/oxisto/money-gopher/metrics"
 	"github.com/oxisto/money-gopher/persistence"
+	"github.com/oxisto/money-gopher/service/admin"
+	"github.com/oxisto/money-gopher/service/alerts"
+	"github.com/oxisto/money-gopher/service/audit"
+	"github.com/oxisto/money-gopher/service/events"
 	"github.com/oxisto/money-gopher/service/portfolio"
 	"github.com/oxisto/money-gopher/service/securities"
+	"github.com/oxisto/money-gopher/service/tokens"
+	"github.com/oxisto/money-gopher/tracing"
 
 	"connectrpc.com/connect"
+	"connectrpc.com/otelconnect"
 	"connectrpc.com/vanguard"
 	"github.com/lmittmann/tint"
 	oauth2 "github.com/oxisto/oauth2go"
 	"github.com/oxisto/oauth2go/login"
 	"github.com/oxisto/oauth2go/storage"
-	"golang.org/x/net/http2"
-	"golang.org/x/net/http2/h2c"
 )
 
 // Options holds all options to configure the server.
 type Options struct {
 	Debug bool
 
+	// APIAddr is the address the API server listens on.
+	APIAddr string
+
+	// EmbeddedOAuth2Server starts the embedded OAuth 2.0 server. It can be
+	// disabled if an external OpenID Connect provider is used instead.
+	EmbeddedOAuth2Server bool
+
+	// EmbeddedOAuth2ServerAddr is the address the embedded OAuth 2.0 server
+	// listens on.
+	EmbeddedOAuth2ServerAddr string
+
+	// EmbeddedOAuth2ServerPublicURL is the URL under which the embedded OAuth
+	// 2.0 server is reachable by clients, e.g., behind a reverse proxy.
+	EmbeddedOAuth2ServerPublicURL string
+
 	EmbeddedOAuth2ServerDashboardCallback string
+	EmbeddedOAuth2ServerCLICallback       string
+
+	// JWKSURL is the URL of the JSON Web Key Set that is used to verify
+	// tokens. If it is empty, it is discovered from OIDCIssuer or the one of
+	// the embedded OAuth 2.0 server is used.
+	JWKSURL string
+
+	// OIDCIssuer is the issuer URL of an external OpenID Connect provider,
+	// e.g., a Keycloak realm. If it is set, the issuer of all tokens is
+	// validated.
+	OIDCIssuer string
+
+	// OIDCAudience is the expected audience of tokens. If it is empty, the
+	// audience is not validated.
+	OIDCAudience string
+
+	// OIDCClaims specifies which claims of a token identify the user and
+	// contain its roles. If no default role is specified, it defaults to
+	// [auth.RoleAdmin] when the embedded OAuth 2.0 server is used.
+	OIDCClaims auth.ClaimMapping
+
+	// SecuritiesServiceURL is the URL of the securities service that the
+	// portfolio service uses in a split deployment. If it is empty, the
+	// securities service is called in-process.
+	SecuritiesServiceURL string
+
+	// SecuritiesServiceClientID and SecuritiesServiceClientSecret are the
+	// service credential that the portfolio service uses to authenticate at a
+	// remote securities service using the OAuth 2.0 client credentials flow.
+	// If the embedded OAuth 2.0 server is used, the client is registered
+	// there.
+	SecuritiesServiceClientID     string
+	SecuritiesServiceClientSecret string
+
+	// SecuritiesServiceTokenURL is the token endpoint that is used to retrieve
+	// tokens for the service credential. It defaults to the one of the embedded
+	// OAuth 2.0 server or the one discovered from OIDCIssuer.
+	SecuritiesServiceTokenURL string
 
 	PrivateKeyFile     string
 	PrivateKeyPassword string
+
+	// TLSCertFile and TLSKeyFile contain the certificate and private key that
+	// are used to serve both the API and the embedded OAuth 2.0 server over
+	// TLS. The files are reloaded once they change.
+	TLSCertFile string
+	TLSKeyFile  string
+
+	// OTLPEndpoint is the URL of an OTLP/HTTP endpoint, e.g., of an
+	// OpenTelemetry collector, that spans are exported to. If it is empty,
+	// tracing is disabled.
+	OTLPEndpoint string
+
+	// TLSSelfSigned serves both the API and the embedded OAuth 2.0 server over
+	// TLS with a self-signed certificate that is generated on startup. This is
+	// only meant for development.
+	TLSSelfSigned bool
+
+	// ShutdownTimeout is the time that in-flight requests and background
+	// quote updates are given to finish on shutdown. It defaults to
+	// [DefaultShutdownTimeout].
+	ShutdownTimeout time.Duration
+
+	// TrashRetentionDays is the number of days after which deleted
+	// portfolios, transactions and securities are purged from the trash. If it
+	// is 0, the trash is never purged.
+	TrashRetentionDays int64
+
+	// WatchlistRefreshInterval is the interval in which the quotes of all
+	// securities on a watchlist are updated. If it is 0, they are only updated
+	// on request.
+	WatchlistRefreshInterval time.Duration
+
+	// BackupDir is the directory in which backups that are requested via the
+	// API are written. If it is empty, creating backups via the API is
+	// disabled.
+	BackupDir string
+
+	// AttachmentDir is the directory in which the content of attachments is
+	// stored. If it is empty, the content is stored in the database.
+	AttachmentDir string
+
+	// AlertWebhookURL is the URL that triggered alerts are posted to, if the
+	// "webhook" channel is selected by an alert rule.
+	AlertWebhookURL string
+
+	// AlertSMTPAddr is the address (host:port) of the SMTP server that sends
+	// triggered alerts by e-mail from AlertSMTPFrom to AlertSMTPTo, if the
+	// "smtp" channel is selected by an alert rule. AlertSMTPUsername and
+	// AlertSMTPPassword are optional.
+	AlertSMTPAddr     string
+	AlertSMTPFrom     string
+	AlertSMTPTo       []string
+	AlertSMTPUsername string
+	AlertSMTPPassword string
+
+	// AlertCommand is a local command that is run for every triggered alert,
+	// if the "command" channel is selected by an alert rule.
+	AlertCommand string
+}
+
+// DefaultShutdownTimeout is the default of [Options.ShutdownTimeout].
+const DefaultShutdownTimeout = 30 * time.Second
+
+// Validate validates the options and fills in defaults that are derived from
+// other options.
+func (opts *Options) Validate() (err error) {
+	var errs []error
+
+	if opts.EmbeddedOAuth2Server {
+		errs = append(errs, opts.validateEmbeddedOAuth2Server()...)
+	} else if opts.OIDCIssuer == "" && opts.JWKSURL == "" {
+		errs = append(errs, errors.New("an OIDC issuer or a JWKS URL is required if the embedded OAuth 2.0 server is disabled"))
+	}
+
+	if opts.EmbeddedOAuth2Server && opts.OIDCIssuer != "" {
+		errs = append(errs, errors.New("the embedded OAuth 2.0 server cannot be used together with an OIDC issuer"))
+	}
+
+	// Tokens of the embedded OAuth 2.0 server do not contain any roles and its
+	// only user is the administrator
+	if opts.OIDCClaims.DefaultRole == "" && opts.EmbeddedOAuth2Server {
+		opts.OIDCClaims.DefaultRole = auth.RoleAdmin
+	} else if opts.OIDCClaims.DefaultRole != "" {
+		if _, err = auth.ParseRole(string(opts.OIDCClaims.DefaultRole)); err != nil {
+			errs = append(errs, fmt.Errorf("invalid default role: %w", err))
+		}
+	}
+
+	if _, _, err = net.SplitHostPort(opts.APIAddr); err != nil {
+		errs = append(errs, fmt.Errorf("invalid API address %q: %w", opts.APIAddr, err))
+	}
+
+	if opts.SecuritiesServiceURL != "" {
+		errs = append(errs, opts.validateRemoteSecuritiesService()...)
+	}
+
+	if opts.AlertSMTPAddr != "" {
+		errs = append(errs, opts.validateAlertSMTP()...)
+	}
+
+	// The JWKS URL, the issuer, the OTLP endpoint and the alert webhook are
+	// optional
+	for name, u := range map[string]string{
+		"JWKS URL":          opts.JWKSURL,
+		"OIDC issuer":       opts.OIDCIssuer,
+		"OTLP endpoint":     opts.OTLPEndpoint,
+		"alert webhook URL": opts.AlertWebhookURL,
+	} {
+		if u == "" {
+			continue
+		}
+
+		if err = validateURL(u); err != nil {
+			errs = append(errs, fmt.Errorf("invalid %s %q: %w", name, u, err))
+		}
+	}
+
+	if (opts.TLSCertFile == "") != (opts.TLSKeyFile == "") {
+		errs = append(errs, errors.New("TLS certificate and key file must be specified together"))
+	} else if opts.TLSCertFile != "" && opts.TLSSelfSigned {
+		errs = append(errs, errors.New("TLS certificate files cannot be used with a self-signed certificate"))
+	}
+
+	return errors.Join(errs...)
+}
+
+// validateEmbeddedOAuth2Server validates the options of the embedded OAuth
+// 2.0 server.
+func (opts *Options) validateEmbeddedOAuth2Server() (errs []error) {
+	if _, _, err := net.SplitHostPort(opts.EmbeddedOAuth2ServerAddr); err != nil {
+		errs = append(errs, fmt.Errorf("invalid embedded OAuth 2.0 server address %q: %w", opts.EmbeddedOAuth2ServerAddr, err))
+	}
+
+	if opts.JWKSURL == "" {
+		opts.JWKSURL = strings.TrimSuffix(opts.EmbeddedOAuth2ServerPublicURL, "/") + "/certs"
+	}
+
+	for name, u := range map[string]string{
+		"embedded OAuth 2.0 server public URL": opts.EmbeddedOAuth2ServerPublicURL,
+		"dashboard callback URL":               opts.EmbeddedOAuth2ServerDashboardCallback,
+		"CLI callback URL":                     opts.EmbeddedOAuth2ServerCLICallback,
+	} {
+		if err := validateURL(u); err != nil {
+			errs = append(errs, fmt.Errorf("invalid %s %q: %w", name, u, err))
+		}
+	}
+
+	if opts.PrivateKeyFile == "" {
+		errs = append(errs, errors.New("private key file must not be empty"))
+	}
+
+	return errs
+}
+
+// validateRemoteSecuritiesService validates the options of a securities
+// service that runs in another process.
+func (opts *Options) validateRemoteSecuritiesService() (errs []error) {
+	if err := validateURL(opts.SecuritiesServiceURL); err != nil {
+		errs = append(errs, fmt.Errorf("invalid securities service URL %q: %w", opts.SecuritiesServiceURL, err))
+	}
+
+	if opts.SecuritiesServiceClientID == "" || opts.SecuritiesServiceClientSecret == "" {
+		errs = append(errs, errors.New("a remote securities service requires a client ID and secret"))
+	}
+
+	// The token URL of an external provider is discovered on startup
+	if opts.SecuritiesServiceTokenURL == "" && opts.EmbeddedOAuth2Server {
+		opts.SecuritiesServiceTokenURL = strings.TrimSuffix(opts.EmbeddedOAuth2ServerPublicURL, "/") + "/token"
+	} else if opts.SecuritiesServiceTokenURL == "" && opts.OIDCIssuer == "" {
+		errs = append(errs, errors.New("a remote securities service requires a token URL"))
+	} else if opts.SecuritiesServiceTokenURL != "" {
+		if err := validateURL(opts.SecuritiesServiceTokenURL); err != nil {
+			errs = append(errs, fmt.Errorf("invalid securities service token URL %q: %w", opts.SecuritiesServiceTokenURL, err))
+		}
+	}
+
+	return errs
+}
+
+// validateURL checks whether s is an absolute HTTP(S) URL.
+// validateAlertSMTP validates the options of the SMTP notification channel.
+func (opts *Options) validateAlertSMTP() (errs []error) {
+	if _, _, err := net.SplitHostPort(opts.AlertSMTPAddr); err != nil {
+		errs = append(errs, fmt.Errorf("invalid alert SMTP address %q: %w", opts.AlertSMTPAddr, err))
+	}
+
+	if opts.AlertSMTPFrom == "" || len(opts.AlertSMTPTo) == 0 {
+		errs = append(errs, errors.New("alerts by e-mail require a sender and at least one recipient"))
+	}
+
+	return
+}
+
+func validateURL(s string) error {
+	u, err := url.Parse(s)
+	if err != nil {
+		return err
+	}
+
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return errors.New("scheme must be http or https")
+	} else if u.Host == "" {
+		return errors.New("host must not be empty")
+	}
+
+	return nil
 }
 
-// StartServer starts the server.
-func StartServer(pdb *persistence.DB, q *persistence.Queries, opts Options) (err error) {
+// StartServer starts the server and blocks until ctx is done or one of its
+// listeners fails. It then shuts down gracefully, i.e., it drains in-flight
+// requests and background quote updates.
+func StartServer(ctx context.Context, pdb *persistence.DB, q *persistence.Queries, opts Options) (err error) {
 	var (
 		authSrv    *oauth2.AuthorizationServer
 		transcoder *vanguard.Transcoder
+		tlsConfig  *tls.Config
+		client     *http.Client
+		md         *auth.ProviderMetadata
+		tracer     *otelconnect.Interceptor
+		shutdown   func(context.Context) error
+		ln         net.Listener
+		servers    []*http.Server
+		errc       = make(chan error, 2)
 	)
 
-	authSrv = oauth2.NewServer(
-		":8000",
-		oauth2.WithClient("dashboard", "", opts.EmbeddedOAuth2ServerDashboardCallback),
-		oauth2.WithClient("cli", "", "http://localhost:10000/callback"),
-		oauth2.WithPublicURL("http://localhost:8000"),
-		login.WithLoginPage(
-			login.WithUser("money", "money"),
-		),
-		oauth2.WithAllowedOrigins("*"),
-		oauth2.WithSigningKeysFunc(func() map[int]*ecdsa.PrivateKey {
-			return storage.LoadSigningKeys(opts.PrivateKeyFile, opts.PrivateKeyPassword, true)
-		}),
-	)
-	go authSrv.ListenAndServe()
+	// serve serves srv on ln in the background and reports its errors on errc
+	serve := func(srv *http.Server, ln net.Listener) {
+		servers = append(servers, srv)
+		go func() {
+			var err error
+			if srv.TLSConfig != nil {
+				err = srv.ServeTLS(ln, "", "")
+			} else {
+				err = srv.Serve(ln)
+			}
+
+			if !errors.Is(err, http.ErrServerClosed) {
+				errc <- err
+			}
+		}()
+	}
+
+	// Servers that were already started are closed if the remaining startup
+	// fails
+	defer func() {
+		if err != nil {
+			for _, srv := range servers {
+				srv.Close()
+			}
+		}
+	}()
+
+	shutdown, err = tracing.Setup(ctx, opts.OTLPEndpoint)
+	if err != nil {
+		slog.Error("Could not set up tracing", tint.Err(err))
+		return err
+	}
+	defer shutdown(context.Background())
+
+	// Trace context is also accepted from clients, so that the traces of a
+	// split deployment are connected
+	tracer, err = otelconnect.NewInterceptor(otelconnect.WithTrustRemote(), otelconnect.WithoutMetrics())
+	if err != nil {
+		return err
+	}
+
+	tlsConfig, client, err = newTLSConfig(opts)
+	if err != nil {
+		slog.Error("Could not configure TLS", tint.Err(err))
+		return err
+	}
+
+	if opts.EmbeddedOAuth2Server {
+		authOpts := []oauth2.AuthorizationServerOption{
+			oauth2.WithClient("dashboard", "", opts.EmbeddedOAuth2ServerDashboardCallback),
+			oauth2.WithClient("cli", "", opts.EmbeddedOAuth2ServerCLICallback),
+			oauth2.WithPublicURL(opts.EmbeddedOAuth2ServerPublicURL),
+			login.WithLoginPage(
+				login.WithUser("money", "money"),
+			),
+			oauth2.WithAllowedOrigins("*"),
+			oauth2.WithSigningKeysFunc(func() map[int]*ecdsa.PrivateKey {
+				return storage.LoadSigningKeys(opts.PrivateKeyFile, opts.PrivateKeyPassword, true)
+			}),
+		}
+
+		// The service credential of a split deployment
+		if opts.SecuritiesServiceClientID != "" {
+			authOpts = append(authOpts, oauth2.WithClient(opts.SecuritiesServiceClientID, opts.SecuritiesServiceClientSecret, ""))
+		}
+
+		authSrv = oauth2.NewServer(opts.EmbeddedOAuth2ServerAddr, authOpts...)
+		authSrv.TLSConfig = tlsConfig
+
+		ln, err = net.Listen("tcp", opts.EmbeddedOAuth2ServerAddr)
+		if err != nil {
+			slog.Error("Could not listen for the embedded OAuth 2.0 server", tint.Err(err))
+			return err
+		}
+
+		serve(&authSrv.Server, ln)
+	}
+
+	// Retrieve the JWKS URL and token URL of an external provider. Discovery
+	// ignores a trailing slash of the issuer, so tokens are validated against
+	// the exact issuer that the provider announces.
+	issuer := opts.OIDCIssuer
+	if opts.OIDCIssuer != "" && (opts.JWKSURL == "" ||
+		(opts.SecuritiesServiceURL != "" && opts.SecuritiesServiceTokenURL == "")) {
+		md, err = auth.Discover(ctx, client, opts.OIDCIssuer)
+		if err != nil {
+			slog.Error("Could not discover OIDC provider", tint.Err(err), "issuer", opts.OIDCIssuer)
+			return err
+		}
+
+		issuer = md.Issuer
+
+		if opts.JWKSURL == "" {
+			opts.JWKSURL = md.JWKSURI
+		}
+
+		if opts.SecuritiesServiceTokenURL == "" {
+			opts.SecuritiesServiceTokenURL = md.TokenEndpoint
+		}
+	}
+
+	// The portfolio service calls the securities service in-process, unless
+	// it runs in another process
+	broker := events.NewBroker()
+	securitiesHandler := securities.NewService(pdb, broker)
+	var securitiesClient portfoliov1connect.SecuritiesServiceClient
+	if opts.SecuritiesServiceURL != "" {
+		slog.Info("Using remote securities service", "url", opts.SecuritiesServiceURL)
+		securitiesClient = newSecuritiesClient(opts, client, connect.WithInterceptors(tracer))
+	}
 
 	interceptors := connect.WithInterceptors(
+		tracer,
+		NewMetricsInterceptor(),
 		NewSimpleLoggingInterceptor(),
-		NewAuthInterceptor(),
+		NewAuthInterceptor(AuthOptions{
+			JWKSURL:      opts.JWKSURL,
+			Issuer:       issuer,
+			Audience:     opts.OIDCAudience,
+			Claims:       opts.OIDCClaims,
+			Client:       client,
+			AccessTokens: tokens.NewVerifier(q),
+		}),
+		NewAuthorizationInterceptor(procedurePermissions),
+		audit.NewInterceptor(audit.NewRecorder(q)),
 	)
 
+	// Attachments are stored in the database, unless a directory is specified
+	blobs, err := persistence.NewBlobStore(q, opts.AttachmentDir)
+	if err != nil {
+		slog.Error("Could not open attachment directory", tint.Err(err))
+		return err
+	}
+
+	portfolioOpts := portfolio.Options{
+		DB:                 pdb,
+		SecuritiesClient:   securitiesClient,
+		SecuritiesHandler:  securitiesHandler,
+		Broker:             broker,
+		Queries:            q,
+		TrashRetentionDays: int(opts.TrashRetentionDays),
+		Attachments:        blobs,
+	}
+	portfolioHandler := portfolio.NewService(portfolioOpts)
+	alertOpts := alerts.Options{
+		Queries:    q,
+		Portfolios: portfolioHandler,
+	}
+	registerNotifiers(opts)
+
 	portfolioService := vanguard.NewService(
-		portfoliov1connect.NewPortfolioServiceHandler(portfolio.NewService(
-			portfolio.Options{
-				DB:               pdb,
-				SecuritiesClient: portfoliov1connect.NewSecuritiesServiceClient(http.DefaultClient, portfolio.DefaultSecuritiesServiceURL),
-			},
-		), interceptors))
+		portfoliov1connect.NewPortfolioServiceHandler(portfolioHandler, interceptors),
+	)
 	securitiesService := vanguard.NewService(
-		portfoliov1connect.NewSecuritiesServiceHandler(securities.NewService(pdb), interceptors),
+		portfoliov1connect.NewSecuritiesServiceHandler(securitiesHandler, interceptors),
+	)
+	adminService := vanguard.NewService(
+		portfoliov1connect.NewAdminServiceHandler(admin.NewService(pdb, blobs, opts.BackupDir), interceptors),
+	)
+	tokenService := vanguard.NewService(
+		portfoliov1connect.NewTokenServiceHandler(tokens.NewService(q), interceptors),
+	)
+	auditService := vanguard.NewService(
+		portfoliov1connect.NewAuditServiceHandler(audit.NewService(q), interceptors),
+	)
+	alertService := vanguard.NewService(
+		portfoliov1connect.NewAlertServiceHandler(alerts.NewService(alertOpts), interceptors),
 	)
 
 	transcoder, err = vanguard.NewTranscoder([]*vanguard.Service{
 		portfolioService,
 		securitiesService,
+		adminService,
+		tokenService,
+		auditService,
+		alertService,
 	}, vanguard.WithCodec(func(tr vanguard.TypeResolver) vanguard.Codec {
 		codec := vanguard.NewJSONCodec(tr)
 		codec.MarshalOptions.EmitDefaultValues = true
@@ -97,14 +523,105 @@ func StartServer(pdb *persistence.DB, q *persistence.Queries, opts Options) (err
 		return err
 	}
 
+	h := &health{db: pdb, jwksURL: opts.JWKSURL, client: client}
+
 	mux := http.NewServeMux()
 	mux.Handle("/", transcoder)
+	mux.Handle("GET /metrics", metrics.Handler())
+	mux.HandleFunc("GET /healthz", h.handleHealthz)
+	mux.HandleFunc("GET /readyz", h.handleReadyz)
 
-	err = http.ListenAndServe(
-		":8080",
-		h2c.NewHandler(handleCORS(mux), &http2.Server{}),
-	)
+	slog.Info("Starting server", "addr", opts.APIAddr, "tls", tlsConfig != nil)
+
+	// HTTP/2 is negotiated automatically when using TLS, otherwise we also
+	// accept it unencrypted (h2c)
+	srv := &http.Server{
+		Addr:      opts.APIAddr,
+		Handler:   handleCORS(mux),
+		TLSConfig: tlsConfig,
+		Protocols: new(http.Protocols),
+	}
+	srv.Protocols.SetHTTP1(true)
+	srv.Protocols.SetHTTP2(true)
+	srv.Protocols.SetUnencryptedHTTP2(true)
+
+	ln, err = net.Listen("tcp", opts.APIAddr)
+	if err != nil {
+		slog.Error("listen failed", tint.Err(err))
+		return err
+	}
+
+	serve(srv, ln)
+
+	// Purge the trash in the background, until we shut down
+	purgeCtx, stopPurge := context.WithCancel(ctx)
+	purged := make(chan struct{})
+	go func() {
+		purgeTrash(purgeCtx, pdb, blobs, int(opts.TrashRetentionDays))
+		close(purged)
+	}()
+
+	// Keep the quotes of watched securities fresh, until we shut down
+	refreshed := make(chan struct{})
+	go func() {
+		refreshWatchlists(purgeCtx, q, securitiesHandler, opts.WatchlistRefreshInterval)
+		close(refreshed)
+	}()
+
+	// Evaluate alert rules after every change, until we shut down
+	evaluated := make(chan struct{})
+	go func() {
+		alerts.NewEvaluator(alertOpts).Run(purgeCtx, broker)
+		close(evaluated)
+	}()
+
+	// Create the due executions of savings plans, until we shut down
+	executed := make(chan struct{})
+	go func() {
+		portfolio.NewSavingsPlanExecutor(portfolioOpts).Run(purgeCtx)
+		close(executed)
+	}()
+
+	select {
+	case <-ctx.Done():
+		slog.Info("Shutting down server")
+	case err = <-errc:
+		slog.Error("Server failed", tint.Err(err))
+	}
+
+	stopPurge()
+	<-purged
+	<-refreshed
+	<-evaluated
+	<-executed
+
+	return errors.Join(err, shutdownGracefully(h, broker, servers, securitiesHandler, opts.ShutdownTimeout))
+}
+
+// shutdownGracefully stops accepting new requests, ends all streams and waits
+// for in-flight requests and background quote updates until timeout.
+func shutdownGracefully(h *health, broker *events.Broker, servers []*http.Server, securities portfoliov1connect.SecuritiesServiceHandler, timeout time.Duration) error {
+	var errs []error
+
+	if timeout == 0 {
+		timeout = DefaultShutdownTimeout
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	h.shuttingDown.Store(true)
+
+	// Streams only end once their subscriptions are closed
+	broker.Close()
+
+	for _, srv := range servers {
+		errs = append(errs, srv.Shutdown(ctx))
+	}
+
+	if w, ok := securities.(interface{ Wait(context.Context) error }); ok {
+		errs = append(errs, w.Wait(ctx))
+	}
 
-	slog.Error("listen failed", tint.Err(err))
-	return err
+	return errors.Join(errs...)
 }
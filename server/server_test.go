@@ -0,0 +1,276 @@
+// Copyright 2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package server
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/oxisto/money-gopher/auth"
+	"github.com/oxisto/money-gopher/internal"
+	"github.com/oxisto/money-gopher/persistence"
+
+	"github.com/oxisto/assert"
+)
+
+func validOptions() Options {
+	return Options{
+		APIAddr:                               ":8080",
+		EmbeddedOAuth2Server:                  true,
+		EmbeddedOAuth2ServerAddr:              ":8000",
+		EmbeddedOAuth2ServerPublicURL:         "http://localhost:8000",
+		EmbeddedOAuth2ServerDashboardCallback: "http://localhost:3000/api/auth/callback/money-gopher",
+		EmbeddedOAuth2ServerCLICallback:       "http://localhost:10000/callback",
+		PrivateKeyFile:                        "private.key",
+	}
+}
+
+func TestOptions_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     func(opts *Options)
+		wantOpts assert.Want[*Options]
+		wantErr  bool
+	}{
+		{
+			name: "defaults",
+			opts: func(opts *Options) {},
+			wantOpts: func(t *testing.T, opts *Options) bool {
+				return assert.Equals(t, "http://localhost:8000/certs", opts.JWKSURL) &&
+					assert.Equals(t, auth.RoleAdmin, opts.OIDCClaims.DefaultRole)
+			},
+		},
+		{
+			name: "behind reverse proxy",
+			opts: func(opts *Options) {
+				opts.APIAddr = "127.0.0.1:9090"
+				opts.EmbeddedOAuth2ServerPublicURL = "https://auth.money.example.com/"
+			},
+			wantOpts: func(t *testing.T, opts *Options) bool {
+				return assert.Equals(t, "https://auth.money.example.com/certs", opts.JWKSURL)
+			},
+		},
+		{
+			name: "invalid address",
+			opts: func(opts *Options) {
+				opts.APIAddr = "8080"
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid URL",
+			opts: func(opts *Options) {
+				opts.SecuritiesServiceURL = "localhost:8080"
+				opts.SecuritiesServiceClientID = "portfolio"
+				opts.SecuritiesServiceClientSecret = "secret"
+			},
+			wantErr: true,
+		},
+		{
+			name: "split deployment",
+			opts: func(opts *Options) {
+				opts.SecuritiesServiceURL = "http://securities:8080"
+				opts.SecuritiesServiceClientID = "portfolio"
+				opts.SecuritiesServiceClientSecret = "secret"
+			},
+			wantOpts: func(t *testing.T, opts *Options) bool {
+				return assert.Equals(t, "http://localhost:8000/token", opts.SecuritiesServiceTokenURL)
+			},
+		},
+		{
+			name: "split deployment without service credential",
+			opts: func(opts *Options) {
+				opts.SecuritiesServiceURL = "http://securities:8080"
+			},
+			wantErr: true,
+		},
+		{
+			name: "split deployment without token URL",
+			opts: func(opts *Options) {
+				opts.EmbeddedOAuth2Server = false
+				opts.JWKSURL = "https://auth.example.com/certs"
+				opts.SecuritiesServiceURL = "http://securities:8080"
+				opts.SecuritiesServiceClientID = "portfolio"
+				opts.SecuritiesServiceClientSecret = "secret"
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing private key",
+			opts: func(opts *Options) {
+				opts.PrivateKeyFile = ""
+			},
+			wantErr: true,
+		},
+		{
+			name: "external OIDC provider",
+			opts: func(opts *Options) {
+				opts.EmbeddedOAuth2Server = false
+				opts.EmbeddedOAuth2ServerAddr = ""
+				opts.PrivateKeyFile = ""
+				opts.OIDCIssuer = "https://keycloak.example.com/realms/money"
+			},
+			wantOpts: func(t *testing.T, opts *Options) bool {
+				// The JWKS URL is discovered on startup and users need roles
+				return assert.Equals(t, "", opts.JWKSURL) &&
+					assert.Equals(t, auth.Role(""), opts.OIDCClaims.DefaultRole)
+			},
+		},
+		{
+			name: "invalid default role",
+			opts: func(opts *Options) {
+				opts.OIDCClaims.DefaultRole = "owner"
+			},
+			wantErr: true,
+		},
+		{
+			name: "external OIDC provider with embedded server",
+			opts: func(opts *Options) {
+				opts.OIDCIssuer = "https://keycloak.example.com/realms/money"
+			},
+			wantErr: true,
+		},
+		{
+			name: "no embedded server and no provider",
+			opts: func(opts *Options) {
+				opts.EmbeddedOAuth2Server = false
+			},
+			wantErr: true,
+		},
+		{
+			name: "TLS key without certificate",
+			opts: func(opts *Options) {
+				opts.TLSKeyFile = "tls.key"
+			},
+			wantErr: true,
+		},
+		{
+			name: "TLS certificate and self-signed",
+			opts: func(opts *Options) {
+				opts.TLSCertFile = "tls.crt"
+				opts.TLSKeyFile = "tls.key"
+				opts.TLSSelfSigned = true
+			},
+			wantErr: true,
+		},
+		{
+			name: "OTLP endpoint",
+			opts: func(opts *Options) {
+				opts.OTLPEndpoint = "http://localhost:4318"
+			},
+		},
+		{
+			name: "invalid OTLP endpoint",
+			opts: func(opts *Options) {
+				opts.OTLPEndpoint = "localhost:4318"
+			},
+			wantErr: true,
+		},
+		{
+			name: "alerts by e-mail",
+			opts: func(opts *Options) {
+				opts.AlertSMTPAddr = "mail.example.com:587"
+				opts.AlertSMTPFrom = "money@example.com"
+				opts.AlertSMTPTo = []string{"gopher@example.com"}
+			},
+		},
+		{
+			name: "alerts by e-mail without recipient",
+			opts: func(opts *Options) {
+				opts.AlertSMTPAddr = "mail.example.com:587"
+				opts.AlertSMTPFrom = "money@example.com"
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid alert webhook URL",
+			opts: func(opts *Options) {
+				opts.AlertWebhookURL = "example.com/hook"
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := validOptions()
+			tt.opts(&opts)
+
+			err := opts.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Options.Validate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantOpts != nil {
+				tt.wantOpts(t, &opts)
+			}
+		})
+	}
+}
+
+func TestStartServer(t *testing.T) {
+	// Occupy an address, so that the server cannot listen on it
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	defer ln.Close()
+
+	opts := Options{
+		APIAddr:         "127.0.0.1:0",
+		JWKSURL:         "http://127.0.0.1:1/certs",
+		ShutdownTimeout: time.Second,
+	}
+
+	tests := []struct {
+		name    string
+		opts    func() Options
+		cancel  bool
+		wantErr bool
+	}{
+		{
+			name: "address in use",
+			opts: func() Options {
+				opts := opts
+				opts.APIAddr = ln.Addr().String()
+				return opts
+			},
+			wantErr: true,
+		},
+		{
+			name:   "graceful shutdown",
+			opts:   func() Options { return opts },
+			cancel: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			if tt.cancel {
+				time.AfterFunc(100*time.Millisecond, cancel)
+			} else {
+				defer cancel()
+			}
+
+			db := internal.NewTestDB(t)
+			err := StartServer(ctx, db, persistence.New(db), tt.opts())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StartServer() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,198 @@
+// Copyright 2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package server
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"net"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/lmittmann/tint"
+)
+
+// certReloader holds a certificate that is loaded from files and reloads it,
+// once the files change. This allows to rotate certificates without
+// restarting the server.
+type certReloader struct {
+	certFile string
+	keyFile  string
+
+	mu      sync.Mutex
+	cert    *tls.Certificate
+	modTime time.Time
+}
+
+// newCertReloader creates a new [certReloader] and loads the certificate for
+// the first time.
+func newCertReloader(certFile string, keyFile string) (r *certReloader, err error) {
+	r = &certReloader{
+		certFile: certFile,
+		keyFile:  keyFile,
+	}
+
+	_, err = r.GetCertificate(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// GetCertificate can be used as [tls.Config.GetCertificate]. It reloads the
+// certificate, if either the certificate or the key file has been modified
+// since it was last loaded. If reloading fails, the previous certificate is
+// used.
+func (r *certReloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	modTime, err := latestModTime(r.certFile, r.keyFile)
+	if err == nil && !modTime.After(r.modTime) && r.cert != nil {
+		return r.cert, nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil && r.cert != nil {
+		slog.Error("Could not reload certificate, using previous one", tint.Err(err))
+		return r.cert, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("could not load certificate: %w", err)
+	}
+
+	if r.cert != nil {
+		slog.Info("Reloaded certificate", "cert", r.certFile)
+	}
+
+	r.cert = &cert
+	r.modTime = modTime
+
+	return r.cert, nil
+}
+
+// latestModTime returns the latest modification time of all files.
+func latestModTime(files ...string) (t time.Time, err error) {
+	for _, file := range files {
+		fi, err := os.Stat(file)
+		if err != nil {
+			return t, err
+		}
+
+		if fi.ModTime().After(t) {
+			t = fi.ModTime()
+		}
+	}
+
+	return
+}
+
+// newSelfSignedCertificate creates a self-signed certificate for localhost.
+// It is only meant for development.
+func newSelfSignedCertificate() (cert *tls.Certificate, err error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, err
+	}
+
+	tmpl := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{Organization: []string{"Money Gopher Development"}},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().AddDate(1, 0, 0),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		DNSNames:              []string{"localhost"},
+		IPAddresses:           []net.IP{net.IPv4(127, 0, 0, 1), net.IPv6loopback},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		return nil, err
+	}
+
+	cert = &tls.Certificate{
+		Certificate: [][]byte{der},
+		PrivateKey:  key,
+	}
+
+	cert.Leaf, err = x509.ParseCertificate(der)
+	if err != nil {
+		return nil, err
+	}
+
+	return cert, nil
+}
+
+// newTLSConfig creates the TLS config for our listeners according to the
+// options. It returns nil, if TLS is disabled. The returned client should be
+// used by all internal HTTP clients, since it also trusts a self-signed
+// certificate.
+func newTLSConfig(opts Options) (config *tls.Config, client *http.Client, err error) {
+	client = http.DefaultClient
+
+	if opts.TLSSelfSigned {
+		var cert *tls.Certificate
+
+		cert, err = newSelfSignedCertificate()
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not create self-signed certificate: %w", err)
+		}
+
+		slog.Warn("Using a self-signed certificate. This should only be used for development.")
+
+		pool := x509.NewCertPool()
+		pool.AddCert(cert.Leaf)
+
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = &tls.Config{RootCAs: pool}
+		client = &http.Client{Transport: transport}
+
+		config = &tls.Config{Certificates: []tls.Certificate{*cert}}
+	} else if opts.TLSCertFile != "" {
+		var r *certReloader
+
+		r, err = newCertReloader(opts.TLSCertFile, opts.TLSKeyFile)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		config = &tls.Config{GetCertificate: r.GetCertificate}
+	} else {
+		return nil, client, nil
+	}
+
+	config.MinVersion = tls.VersionTLS12
+
+	return config, client, nil
+}
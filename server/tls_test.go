@@ -0,0 +1,112 @@
+// Copyright 2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package server
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/oxisto/assert"
+)
+
+func writeCertificate(t *testing.T, dir string) (certFile string, keyFile string) {
+	cert, err := newSelfSignedCertificate()
+	assert.NoError(t, err)
+
+	key, err := x509.MarshalPKCS8PrivateKey(cert.PrivateKey)
+	assert.NoError(t, err)
+
+	certFile = filepath.Join(dir, "tls.crt")
+	keyFile = filepath.Join(dir, "tls.key")
+
+	err = os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Certificate[0]}), 0600)
+	assert.NoError(t, err)
+
+	err = os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: key}), 0600)
+	assert.NoError(t, err)
+
+	return
+}
+
+func Test_certReloader_GetCertificate(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := writeCertificate(t, dir)
+
+	r, err := newCertReloader(certFile, keyFile)
+	assert.NoError(t, err)
+
+	first, err := r.GetCertificate(nil)
+	assert.NoError(t, err)
+
+	// Nothing changed, so we should get the same certificate
+	same, err := r.GetCertificate(nil)
+	assert.NoError(t, err)
+	assert.Equals(t, true, first == same)
+
+	// Rotate the certificate
+	writeCertificate(t, dir)
+	future := time.Now().Add(time.Minute)
+	assert.NoError(t, os.Chtimes(certFile, future, future))
+
+	rotated, err := r.GetCertificate(nil)
+	assert.NoError(t, err)
+	assert.NotEquals(t, first.Certificate[0], rotated.Certificate[0])
+
+	// A broken certificate should not replace the current one
+	assert.NoError(t, os.WriteFile(certFile, []byte("broken"), 0600))
+	future = future.Add(time.Minute)
+	assert.NoError(t, os.Chtimes(certFile, future, future))
+
+	kept, err := r.GetCertificate(nil)
+	assert.NoError(t, err)
+	assert.Equals(t, true, rotated == kept)
+}
+
+func Test_newCertReloader(t *testing.T) {
+	_, err := newCertReloader("does-not-exist.crt", "does-not-exist.key")
+	assert.NotNil(t, err)
+}
+
+func Test_newTLSConfig(t *testing.T) {
+	t.Run("disabled", func(t *testing.T) {
+		config, client, err := newTLSConfig(Options{})
+		assert.NoError(t, err)
+		assert.Equals(t, true, config == nil)
+		assert.NotNil(t, client)
+	})
+
+	t.Run("self-signed", func(t *testing.T) {
+		config, client, err := newTLSConfig(Options{TLSSelfSigned: true})
+		assert.NoError(t, err)
+		assert.Equals(t, uint16(tls.VersionTLS12), config.MinVersion)
+		assert.Equals(t, 1, len(config.Certificates))
+		assert.NotNil(t, client.Transport)
+	})
+
+	t.Run("certificate files", func(t *testing.T) {
+		certFile, keyFile := writeCertificate(t, t.TempDir())
+
+		config, _, err := newTLSConfig(Options{TLSCertFile: certFile, TLSKeyFile: keyFile})
+		assert.NoError(t, err)
+		assert.NotNil(t, config.GetCertificate)
+	})
+}
@@ -0,0 +1,66 @@
+// Copyright 2023 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package server
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/oxisto/money-gopher/persistence"
+
+	"github.com/lmittmann/tint"
+)
+
+// purgeInterval is the interval in which the trash is purged.
+var purgeInterval = time.Hour
+
+// purgeTrash permanently removes all items that are in the trash for more than
+// the specified number of days, once on startup and then every
+// [purgeInterval], until ctx is done. The attachments of purged transactions
+// are removed from blobs. Nothing is purged if days is 0.
+func purgeTrash(ctx context.Context, db *persistence.DB, blobs persistence.BlobStore, days int) {
+	if days <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(purgeInterval)
+	defer ticker.Stop()
+
+	for {
+		before := time.Now().AddDate(0, 0, -days)
+
+		n, err := persistence.PurgeTrashedAttachments(ctx, db, blobs, before)
+		if err == nil {
+			var m int64
+			m, err = persistence.PurgeTrash(ctx, db, before)
+			n += m
+		}
+
+		if err != nil && ctx.Err() == nil {
+			slog.Error("Could not purge trash", tint.Err(err))
+		} else if n > 0 {
+			slog.Info("Purged trash", "rows", n, "retention-days", days)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright 2023 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/oxisto/money-gopher/internal"
+	"github.com/oxisto/money-gopher/persistence"
+
+	"github.com/oxisto/assert"
+)
+
+func Test_purgeTrash(t *testing.T) {
+	db := internal.NewTestDB(t)
+
+	_, err := db.Exec(`INSERT INTO portfolios (id, display_name, owner, delete_time) VALUES ('old', 'Old', 'money', '2020-01-01 00:00:00'), ('recent', 'Recent', 'money', CURRENT_TIMESTAMP);`)
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		purgeTrash(ctx, db, persistence.NewDBBlobStore(persistence.New(db)), 30)
+		close(done)
+	}()
+
+	// The trash is purged once on startup
+	assert.Equals(t, true, eventually(func() bool {
+		var count int
+		return db.QueryRow("SELECT COUNT(*) FROM portfolios").Scan(&count) == nil && count == 1
+	}))
+
+	cancel()
+	<-done
+
+	// Nothing is purged without a retention
+	purgeTrash(context.Background(), db, nil, 0)
+}
+
+// eventually checks cond until it is true or a second has passed.
+func eventually(cond func() bool) bool {
+	for range 100 {
+		if cond() {
+			return true
+		}
+
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	return false
+}
@@ -0,0 +1,68 @@
+// Copyright 2023 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package server
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	portfoliov1 "github.com/oxisto/money-gopher/gen"
+	"github.com/oxisto/money-gopher/gen/portfoliov1connect"
+	"github.com/oxisto/money-gopher/persistence"
+
+	"connectrpc.com/connect"
+	"github.com/lmittmann/tint"
+)
+
+// DefaultWatchlistRefreshInterval is the default of
+// [Options.WatchlistRefreshInterval].
+const DefaultWatchlistRefreshInterval = 15 * time.Minute
+
+// refreshWatchlists triggers a quote update of all securities that are on
+// any watchlist, once on startup and then every interval, until ctx is done.
+// Nothing is refreshed if interval is 0.
+func refreshWatchlists(ctx context.Context, q *persistence.Queries, securities portfoliov1connect.SecuritiesServiceHandler, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		ids, err := q.ListWatchedSecurityIDs(ctx)
+		if err != nil && ctx.Err() == nil {
+			slog.Error("Could not list watched securities", tint.Err(err))
+		} else if len(ids) > 0 {
+			_, err = securities.TriggerSecurityQuoteUpdate(ctx, connect.NewRequest(&portfoliov1.TriggerQuoteUpdateRequest{
+				SecurityIds: ids,
+			}))
+			if err != nil && ctx.Err() == nil {
+				slog.Error("Could not refresh quotes of watched securities", tint.Err(err))
+			} else {
+				slog.Debug("Refreshed quotes of watched securities", "securities", len(ids))
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
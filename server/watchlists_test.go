@@ -0,0 +1,89 @@
+// Copyright 2023 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package server
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	portfoliov1 "github.com/oxisto/money-gopher/gen"
+	"github.com/oxisto/money-gopher/gen/portfoliov1connect"
+	"github.com/oxisto/money-gopher/internal"
+	"github.com/oxisto/money-gopher/persistence"
+
+	"connectrpc.com/connect"
+	"github.com/oxisto/assert"
+)
+
+// triggerRecorder records the securities of all triggered quote updates.
+type triggerRecorder struct {
+	portfoliov1connect.UnimplementedSecuritiesServiceHandler
+
+	mu  sync.Mutex
+	ids []string
+}
+
+func (r *triggerRecorder) TriggerSecurityQuoteUpdate(_ context.Context, req *connect.Request[portfoliov1.TriggerQuoteUpdateRequest]) (*connect.Response[portfoliov1.TriggerQuoteUpdateResponse], error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.ids = append(r.ids, req.Msg.SecurityIds...)
+	return connect.NewResponse(&portfoliov1.TriggerQuoteUpdateResponse{}), nil
+}
+
+func (r *triggerRecorder) triggered() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.ids
+}
+
+func Test_refreshWatchlists(t *testing.T) {
+	db := internal.NewTestDB(t)
+
+	_, err := db.Exec(`INSERT INTO securities (id, display_name) VALUES ('watched', 'Watched'), ('other', 'Other');
+INSERT INTO watchlists (id, display_name, owner) VALUES ('a', 'A', 'money'), ('b', 'B', 'gopher');
+INSERT INTO watchlist_entries (watchlist_id, security_id, add_time) VALUES ('a', 'watched', CURRENT_TIMESTAMP), ('b', 'watched', CURRENT_TIMESTAMP);`)
+	assert.NoError(t, err)
+
+	var (
+		q   = persistence.New(db)
+		rec = &triggerRecorder{}
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		refreshWatchlists(ctx, q, rec, DefaultWatchlistRefreshInterval)
+		close(done)
+	}()
+
+	// Watched securities are refreshed once on startup, each only once
+	assert.Equals(t, true, eventually(func() bool {
+		return len(rec.triggered()) > 0
+	}))
+
+	cancel()
+	<-done
+
+	assert.Equals(t, []string{"watched"}, rec.triggered())
+
+	// Nothing is refreshed without an interval
+	refreshWatchlists(context.Background(), q, rec, 0)
+	assert.Equals(t, []string{"watched"}, rec.triggered())
+}
@@ -0,0 +1,249 @@
+// Copyright 2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package admin
+
+import (
+	"database/sql"
+	"strings"
+	"time"
+
+	portfoliov1 "github.com/oxisto/money-gopher/gen"
+	"github.com/oxisto/money-gopher/persistence"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// attachmentToProto converts an attachment of the database into its
+// representation in a dump. Like all conversions in this file, it keeps
+// everything that is stored, in contrast to the conversions of the services.
+func attachmentToProto(a *persistence.Attachment) *portfoliov1.Attachment {
+	return &portfoliov1.Attachment{
+		Id:            a.ID,
+		TransactionId: a.EventID,
+		FileName:      a.FileName,
+		ContentType:   a.ContentType,
+		Size:          a.Size,
+		Sha256:        a.Hash,
+		CreateTime:    timestamppb.New(a.CreateTime),
+	}
+}
+
+func attachmentFromProto(a *portfoliov1.Attachment) persistence.ReplaceAttachmentParams {
+	return persistence.ReplaceAttachmentParams{
+		ID:          a.Id,
+		EventID:     a.TransactionId,
+		FileName:    a.FileName,
+		ContentType: a.ContentType,
+		Size:        a.Size,
+		Hash:        a.Sha256,
+		CreateTime:  a.CreateTime.AsTime(),
+	}
+}
+
+func savingsPlanToProto(p *persistence.SavingsPlan) *portfoliov1.SavingsPlan {
+	return &portfoliov1.SavingsPlan{
+		Id:                p.ID,
+		PortfolioId:       p.PortfolioID,
+		SecurityId:        p.SecurityID,
+		DisplayName:       p.DisplayName,
+		Amount:            p.Amount,
+		Value:             portfoliov1.Value(int32(p.Value)),
+		IntervalMonths:    int32(p.IntervalMonths),
+		StartTime:         timestamppb.New(p.StartTime),
+		EndTime:           timestampOrNil(p.EndTime),
+		FixedFee:          portfoliov1.Value(int32(p.FixedFee)),
+		FeePercentage:     p.FeePercentage,
+		LastExecutionTime: timestampOrNil(p.LastExecutionTime),
+		CreateTime:        timestamppb.New(p.CreateTime),
+	}
+}
+
+func savingsPlanFromProto(p *portfoliov1.SavingsPlan) persistence.ReplaceSavingsPlanParams {
+	return persistence.ReplaceSavingsPlanParams{
+		ID:                p.Id,
+		PortfolioID:       p.PortfolioId,
+		SecurityID:        p.SecurityId,
+		DisplayName:       p.DisplayName,
+		Amount:            p.Amount,
+		Value:             int64(p.Value.GetValue()),
+		IntervalMonths:    int64(p.IntervalMonths),
+		StartTime:         p.StartTime.AsTime(),
+		EndTime:           nullTime(p.EndTime),
+		FixedFee:          int64(p.FixedFee.GetValue()),
+		FeePercentage:     p.FeePercentage,
+		LastExecutionTime: nullTime(p.LastExecutionTime),
+		CreateTime:        p.CreateTime.AsTime(),
+	}
+}
+
+func watchlistToProto(w *persistence.Watchlist, entries []*persistence.WatchlistEntry) *portfoliov1.Watchlist {
+	out := &portfoliov1.Watchlist{
+		Id:          w.ID,
+		DisplayName: w.DisplayName,
+		Owner:       w.Owner,
+	}
+
+	for _, e := range entries {
+		entry := &portfoliov1.WatchlistEntry{
+			Security: &portfoliov1.Security{Id: e.SecurityID},
+			AddTime:  timestamppb.New(e.AddTime),
+		}
+
+		if e.AddedQuote.Valid {
+			entry.AddedQuote = portfoliov1.Value(int32(e.AddedQuote.Int64))
+		}
+
+		out.Entries = append(out.Entries, entry)
+	}
+
+	return out
+}
+
+func watchlistEntryFromProto(watchlistID string, e *portfoliov1.WatchlistEntry) persistence.ReplaceWatchlistEntryParams {
+	params := persistence.ReplaceWatchlistEntryParams{
+		WatchlistID: watchlistID,
+		SecurityID:  e.Security.GetId(),
+		AddTime:     e.AddTime.AsTime(),
+	}
+
+	if e.AddedQuote != nil {
+		params.AddedQuote = sql.NullInt64{Int64: int64(e.AddedQuote.Value), Valid: true}
+	}
+
+	return params
+}
+
+func alertRuleToProto(r *persistence.AlertRule) *portfoliov1.AlertRule {
+	out := &portfoliov1.AlertRule{
+		Id:          r.ID,
+		DisplayName: r.DisplayName,
+		Owner:       r.Owner,
+		Type:        portfoliov1.AlertRuleType(r.Type),
+		PortfolioId: r.PortfolioID,
+		SecurityId:  r.SecurityID,
+		Percentage:  r.Percentage,
+		MaxQuoteAge: durationpb.New(time.Duration(r.MaxQuoteAge) * time.Second),
+		Active:      r.Active,
+	}
+
+	if r.Threshold.Valid {
+		out.Threshold = &portfoliov1.Currency{
+			Value:  int32(r.Threshold.Int64),
+			Symbol: r.ThresholdCurrency.String,
+		}
+	}
+
+	if r.Channels != "" {
+		out.Channels = strings.Split(r.Channels, ",")
+	}
+
+	return out
+}
+
+func alertRuleFromProto(r *portfoliov1.AlertRule) persistence.ReplaceAlertRuleParams {
+	params := persistence.ReplaceAlertRuleParams{
+		ID:          r.Id,
+		DisplayName: r.DisplayName,
+		Owner:       r.Owner,
+		Type:        int64(r.Type),
+		PortfolioID: r.PortfolioId,
+		SecurityID:  r.SecurityId,
+		Percentage:  r.Percentage,
+		MaxQuoteAge: int64(r.MaxQuoteAge.AsDuration() / time.Second),
+		Channels:    strings.Join(r.Channels, ","),
+		Active:      r.Active,
+	}
+
+	if r.Threshold != nil {
+		params.Threshold = sql.NullInt64{Int64: int64(r.Threshold.Value), Valid: true}
+		params.ThresholdCurrency = sql.NullString{String: r.Threshold.Symbol, Valid: true}
+	}
+
+	return params
+}
+
+func triggeredAlertToProto(a *persistence.TriggeredAlert) *portfoliov1.TriggeredAlert {
+	return &portfoliov1.TriggeredAlert{
+		Id:      a.ID,
+		RuleId:  a.RuleID,
+		Time:    timestamppb.New(a.Time),
+		Message: a.Message,
+	}
+}
+
+func accessTokenToProto(t *persistence.AccessToken) *portfoliov1.StoredAccessToken {
+	return &portfoliov1.StoredAccessToken{
+		AccessToken: &portfoliov1.AccessToken{
+			Id:           t.ID,
+			DisplayName:  t.DisplayName,
+			Scopes:       strings.Fields(t.Scopes),
+			Owner:        t.Owner,
+			CreateTime:   timestamppb.New(t.CreateTime),
+			ExpireTime:   timestampOrNil(t.ExpireTime),
+			LastUsedTime: timestampOrNil(t.LastUsedTime),
+		},
+		Hash: t.Hash,
+	}
+}
+
+func accessTokenFromProto(t *portfoliov1.StoredAccessToken) persistence.ReplaceAccessTokenParams {
+	return persistence.ReplaceAccessTokenParams{
+		ID:           t.AccessToken.GetId(),
+		DisplayName:  t.AccessToken.GetDisplayName(),
+		Owner:        t.AccessToken.GetOwner(),
+		Scopes:       strings.Join(t.AccessToken.GetScopes(), " "),
+		Hash:         t.Hash,
+		CreateTime:   t.AccessToken.GetCreateTime().AsTime(),
+		ExpireTime:   nullTime(t.AccessToken.GetExpireTime()),
+		LastUsedTime: nullTime(t.AccessToken.GetLastUsedTime()),
+	}
+}
+
+func tokenOwnerToProto(o *persistence.TokenOwner) *portfoliov1.TokenOwner {
+	return &portfoliov1.TokenOwner{
+		Owner:      o.Owner,
+		Roles:      strings.Fields(o.Roles),
+		UpdateTime: timestamppb.New(o.UpdateTime),
+	}
+}
+
+func tokenOwnerFromProto(o *portfoliov1.TokenOwner) persistence.SetTokenOwnerRolesParams {
+	return persistence.SetTokenOwnerRolesParams{
+		Owner:      o.Owner,
+		Roles:      strings.Join(o.Roles, " "),
+		UpdateTime: o.UpdateTime.AsTime(),
+	}
+}
+
+// timestampOrNil returns t as timestamp, which is nil if t is NULL.
+func timestampOrNil(t sql.NullTime) *timestamppb.Timestamp {
+	if !t.Valid {
+		return nil
+	}
+
+	return timestamppb.New(t.Time)
+}
+
+// nullTime is the inverse of [timestampOrNil].
+func nullTime(t *timestamppb.Timestamp) sql.NullTime {
+	if t == nil {
+		return sql.NullTime{}
+	}
+
+	return sql.NullTime{Time: t.AsTime(), Valid: true}
+}
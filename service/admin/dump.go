@@ -0,0 +1,551 @@
+// Copyright 2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package admin
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+
+	portfoliov1 "github.com/oxisto/money-gopher/gen"
+	"github.com/oxisto/money-gopher/persistence"
+	"github.com/oxisto/money-gopher/service/audit"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// DumpVersion is the current version of the [portfoliov1.Dump] format. It
+// needs to be increased whenever the dump format changes in an incompatible
+// way.
+const DumpVersion = 2
+
+// ErrUnsupportedDumpVersion is returned by [Restore] if the version of the
+// dump is not supported.
+var ErrUnsupportedDumpVersion = errors.New("unsupported dump version")
+
+// Export exports all entities in the database into a [portfoliov1.Dump],
+// including the ones in the trash. The content of attachments is read from
+// blobs; content that is missing there is skipped.
+func Export(ctx context.Context, db *persistence.DB, blobs persistence.BlobStore) (dump *portfoliov1.Dump, err error) {
+	// The export runs in a transaction that is never committed, so that it is
+	// consistent and the trash can be restored within it.
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	dump, err = export(ctx, tx, blobs.WithTx(tx))
+	if err != nil {
+		return nil, err
+	}
+
+	slog.Info("Exported database dump",
+		"portfolios", len(dump.Portfolios),
+		"bank-accounts", len(dump.BankAccounts),
+		"securities", len(dump.Securities),
+		"attachments", len(dump.Attachments),
+		"trash", len(dump.Trash),
+	)
+
+	return
+}
+
+// export exports all entities within the transaction tx.
+func export(ctx context.Context, tx *sql.Tx, blobs persistence.BlobStore) (dump *portfoliov1.Dump, err error) {
+	var (
+		q                = persistence.New(tx)
+		portfolios       = persistence.Ops[*portfoliov1.Portfolio](tx)
+		events           = persistence.Relationship[*portfoliov1.PortfolioEvent](portfolios)
+		shares           = persistence.Relationship[*portfoliov1.PortfolioShare](portfolios)
+		bankAccounts     = persistence.Ops[*portfoliov1.BankAccount](tx)
+		securities       = persistence.Ops[*portfoliov1.Security](tx)
+		listedSecurities = persistence.Relationship[*portfoliov1.ListedSecurity](securities)
+	)
+
+	// Tables are only created on the first write, so we need to make sure
+	// they exist before we can list anything.
+	err = errors.Join(
+		(&portfoliov1.Portfolio{}).InitTables(tx),
+		(&portfoliov1.BankAccount{}).InitTables(tx),
+		(&portfoliov1.Security{}).InitTables(tx),
+		(&portfoliov1.ListedSecurity{}).InitTables(tx),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("could not init tables: %w", err)
+	}
+
+	dump = &portfoliov1.Dump{
+		Version: DumpVersion,
+		Time:    timestamppb.Now(),
+	}
+
+	dump.Trash, err = exportTrash(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	dump.Portfolios, err = portfolios.List(ctx, portfoliov1.AllOwners)
+	if err != nil {
+		return nil, fmt.Errorf("could not list portfolios: %w", err)
+	}
+
+	for _, p := range dump.Portfolios {
+		p.Events, err = events.List(ctx, p.Id)
+		if err != nil {
+			return nil, fmt.Errorf("could not list events: %w", err)
+		}
+	}
+
+	dump.PortfolioShares, err = shares.List(ctx, portfoliov1.AllOwners)
+	if err != nil {
+		return nil, fmt.Errorf("could not list portfolio shares: %w", err)
+	}
+
+	dump.BankAccounts, err = bankAccounts.List(ctx, portfoliov1.AllOwners)
+	if err != nil {
+		return nil, fmt.Errorf("could not list bank accounts: %w", err)
+	}
+
+	dump.Securities, err = securities.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not list securities: %w", err)
+	}
+
+	for _, sec := range dump.Securities {
+		sec.ListedOn, err = listedSecurities.List(ctx, sec.Id)
+		if err != nil {
+			return nil, fmt.Errorf("could not list listed securities: %w", err)
+		}
+	}
+
+	err = exportAttachments(ctx, q, blobs, dump)
+	if err != nil {
+		return nil, err
+	}
+
+	err = exportTables(ctx, q, dump)
+	if err != nil {
+		return nil, err
+	}
+
+	return dump, nil
+}
+
+// exportTrash lists all items in the trash and restores them within the
+// transaction of q, so that they are listed like all other entities.
+func exportTrash(ctx context.Context, q *persistence.Queries) (trash []*portfoliov1.TrashItem, err error) {
+	items, err := q.ListAllTrash(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not list trash: %w", err)
+	}
+
+	for _, item := range items {
+		trash = append(trash, &portfoliov1.TrashItem{
+			ResourceType: item.ResourceType,
+			Id:           item.ID,
+			DeleteTime:   timestamppb.New(item.DeleteTime.Time),
+		})
+
+		switch item.ResourceType {
+		case "Portfolio":
+			_, err = q.RestorePortfolio(ctx, item.ID)
+		case "PortfolioEvent":
+			_, err = q.RestorePortfolioEvent(ctx, item.ID)
+		case "Security":
+			_, err = q.RestoreSecurity(ctx, item.ID)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("could not list trashed %s %s: %w", item.ResourceType, item.ID, err)
+		}
+	}
+
+	return trash, nil
+}
+
+// exportAttachments exports all attachments and their content in blobs into
+// dump.
+func exportAttachments(ctx context.Context, q *persistence.Queries, blobs persistence.BlobStore, dump *portfoliov1.Dump) (err error) {
+	list, err := q.ListAllAttachments(ctx)
+	if err != nil {
+		return fmt.Errorf("could not list attachments: %w", err)
+	}
+
+	exported := make(map[string]bool)
+	for _, a := range list {
+		dump.Attachments = append(dump.Attachments, attachmentToProto(a))
+
+		if exported[a.Hash] {
+			continue
+		}
+		exported[a.Hash] = true
+
+		content, err := readBlob(ctx, blobs, a.Hash)
+		if errors.Is(err, persistence.ErrBlobNotFound) {
+			// The content of a database backup does not contain the content of
+			// attachments that are stored as files
+			slog.Warn("Content of attachment is missing", "attachment", a.ID, "hash", a.Hash)
+			continue
+		} else if err != nil {
+			return fmt.Errorf("could not read content of attachment %s: %w", a.ID, err)
+		}
+
+		dump.AttachmentContents = append(dump.AttachmentContents, &portfoliov1.AttachmentContent{
+			Sha256:  a.Hash,
+			Content: content,
+		})
+	}
+
+	return nil
+}
+
+// readBlob reads the content with the specified hash from blobs.
+func readBlob(ctx context.Context, blobs persistence.BlobStore, hash string) (content []byte, err error) {
+	rc, err := blobs.Open(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	return io.ReadAll(rc)
+}
+
+// exportTables exports the entities of all tables that are not managed by our
+// storage operations into dump.
+func exportTables(ctx context.Context, q *persistence.Queries, dump *portfoliov1.Dump) (err error) {
+	plans, err := q.ListAllSavingsPlans(ctx)
+	if err != nil {
+		return fmt.Errorf("could not list savings plans: %w", err)
+	}
+
+	for _, p := range plans {
+		dump.SavingsPlans = append(dump.SavingsPlans, savingsPlanToProto(p))
+	}
+
+	watchlists, err := q.ListAllWatchlists(ctx)
+	if err != nil {
+		return fmt.Errorf("could not list watchlists: %w", err)
+	}
+
+	for _, w := range watchlists {
+		entries, err := q.ListWatchlistEntries(ctx, w.ID)
+		if err != nil {
+			return fmt.Errorf("could not list watchlist entries: %w", err)
+		}
+
+		dump.Watchlists = append(dump.Watchlists, watchlistToProto(w, entries))
+	}
+
+	rules, err := q.ListAlertRules(ctx)
+	if err != nil {
+		return fmt.Errorf("could not list alert rules: %w", err)
+	}
+
+	for _, r := range rules {
+		dump.AlertRules = append(dump.AlertRules, alertRuleToProto(r))
+	}
+
+	alerts, err := q.ListAllTriggeredAlerts(ctx)
+	if err != nil {
+		return fmt.Errorf("could not list triggered alerts: %w", err)
+	}
+
+	for _, a := range alerts {
+		dump.TriggeredAlerts = append(dump.TriggeredAlerts, triggeredAlertToProto(a))
+	}
+
+	tokens, err := q.ListAllAccessTokens(ctx)
+	if err != nil {
+		return fmt.Errorf("could not list access tokens: %w", err)
+	}
+
+	for _, t := range tokens {
+		dump.AccessTokens = append(dump.AccessTokens, accessTokenToProto(t))
+	}
+
+	owners, err := q.ListTokenOwners(ctx)
+	if err != nil {
+		return fmt.Errorf("could not list token owners: %w", err)
+	}
+
+	for _, o := range owners {
+		dump.TokenOwners = append(dump.TokenOwners, tokenOwnerToProto(o))
+	}
+
+	events, err := q.ListAllAuditEvents(ctx)
+	if err != nil {
+		return fmt.Errorf("could not list audit events: %w", err)
+	}
+
+	for _, dbe := range events {
+		e, err := audit.ToProto(dbe)
+		if err != nil {
+			return fmt.Errorf("could not convert audit event %d: %w", dbe.ID, err)
+		}
+
+		dump.AuditEvents = append(dump.AuditEvents, e)
+	}
+
+	return nil
+}
+
+// Restore restores all entities of a [portfoliov1.Dump] into the database and
+// the content of attachments into blobs. Existing entities with the same
+// identifier are replaced. All entities are restored within a single
+// transaction, so that a failed restore does not leave a partially restored
+// database behind.
+func Restore(ctx context.Context, db *persistence.DB, blobs persistence.BlobStore, dump *portfoliov1.Dump) (err error) {
+	if dump.GetVersion() != DumpVersion {
+		return fmt.Errorf("%w: %d", ErrUnsupportedDumpVersion, dump.GetVersion())
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("could not begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	err = restore(ctx, tx, blobs.WithTx(tx), dump)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("could not commit transaction: %w", err)
+	}
+
+	slog.Info("Restored database dump",
+		"portfolios", len(dump.Portfolios),
+		"bank-accounts", len(dump.BankAccounts),
+		"securities", len(dump.Securities),
+		"attachments", len(dump.Attachments),
+		"trash", len(dump.Trash),
+	)
+
+	return nil
+}
+
+// restore restores all entities of a [portfoliov1.Dump] within the
+// transaction tx.
+func restore(ctx context.Context, tx *sql.Tx, blobs persistence.BlobStore, dump *portfoliov1.Dump) (err error) {
+	var (
+		q                = persistence.New(tx)
+		portfolios       = persistence.Ops[*portfoliov1.Portfolio](tx)
+		events           = persistence.Relationship[*portfoliov1.PortfolioEvent](portfolios)
+		shares           = persistence.Relationship[*portfoliov1.PortfolioShare](portfolios)
+		bankAccounts     = persistence.Ops[*portfoliov1.BankAccount](tx)
+		securities       = persistence.Ops[*portfoliov1.Security](tx)
+		listedSecurities = persistence.Relationship[*portfoliov1.ListedSecurity](securities)
+	)
+
+	for _, acc := range dump.BankAccounts {
+		err = bankAccounts.Replace(ctx, acc)
+		if err != nil {
+			return fmt.Errorf("could not restore bank account %s: %w", acc.Id, err)
+		}
+	}
+
+	// Securities need to be restored before portfolios, since events refer to
+	// them.
+	for _, sec := range dump.Securities {
+		err = securities.Replace(ctx, sec)
+		if err != nil {
+			return fmt.Errorf("could not restore security %s: %w", sec.Id, err)
+		}
+
+		for _, ls := range sec.ListedOn {
+			err = listedSecurities.Replace(ctx, ls)
+			if err != nil {
+				return fmt.Errorf("could not restore listed security %s: %w", ls.Ticker, err)
+			}
+		}
+	}
+
+	for _, p := range dump.Portfolios {
+		err = portfolios.Replace(ctx, p)
+		if err != nil {
+			return fmt.Errorf("could not restore portfolio %s: %w", p.Id, err)
+		}
+
+		for _, e := range p.Events {
+			err = events.Replace(ctx, e)
+			if err != nil {
+				return fmt.Errorf("could not restore event %s: %w", e.Id, err)
+			}
+		}
+	}
+
+	for _, sh := range dump.PortfolioShares {
+		err = shares.Replace(ctx, sh)
+		if err != nil {
+			return fmt.Errorf("could not restore share of portfolio %s: %w", sh.PortfolioId, err)
+		}
+	}
+
+	err = restoreAttachments(ctx, q, blobs, dump)
+	if err != nil {
+		return err
+	}
+
+	err = restoreTables(ctx, q, dump)
+	if err != nil {
+		return err
+	}
+
+	return restoreTrash(ctx, q, dump.Trash)
+}
+
+// restoreAttachments restores all attachments of dump and their content into
+// blobs.
+func restoreAttachments(ctx context.Context, q *persistence.Queries, blobs persistence.BlobStore, dump *portfoliov1.Dump) (err error) {
+	for _, c := range dump.AttachmentContents {
+		hash, _, err := blobs.Put(ctx, bytes.NewReader(c.Content))
+		if err != nil {
+			return fmt.Errorf("could not restore content %s: %w", c.Sha256, err)
+		} else if hash != c.Sha256 {
+			return fmt.Errorf("could not restore content %s: content does not match hash", c.Sha256)
+		}
+	}
+
+	for _, a := range dump.Attachments {
+		err = q.ReplaceAttachment(ctx, attachmentFromProto(a))
+		if err != nil {
+			return fmt.Errorf("could not restore attachment %s: %w", a.Id, err)
+		}
+	}
+
+	return nil
+}
+
+// restoreTables restores the entities of all tables that are not managed by
+// our storage operations.
+func restoreTables(ctx context.Context, q *persistence.Queries, dump *portfoliov1.Dump) (err error) {
+	for _, p := range dump.SavingsPlans {
+		err = q.ReplaceSavingsPlan(ctx, savingsPlanFromProto(p))
+		if err != nil {
+			return fmt.Errorf("could not restore savings plan %s: %w", p.Id, err)
+		}
+	}
+
+	for _, w := range dump.Watchlists {
+		err = q.ReplaceWatchlist(ctx, persistence.ReplaceWatchlistParams{
+			ID:          w.Id,
+			DisplayName: w.DisplayName,
+			Owner:       w.Owner,
+		})
+		if err != nil {
+			return fmt.Errorf("could not restore watchlist %s: %w", w.Id, err)
+		}
+
+		for _, e := range w.Entries {
+			err = q.ReplaceWatchlistEntry(ctx, watchlistEntryFromProto(w.Id, e))
+			if err != nil {
+				return fmt.Errorf("could not restore entry of watchlist %s: %w", w.Id, err)
+			}
+		}
+	}
+
+	// Triggered alerts belong to the owner of their rule
+	owners := make(map[string]string)
+	for _, r := range dump.AlertRules {
+		err = q.ReplaceAlertRule(ctx, alertRuleFromProto(r))
+		if err != nil {
+			return fmt.Errorf("could not restore alert rule %s: %w", r.Id, err)
+		}
+
+		owners[r.Id] = r.Owner
+	}
+
+	for _, a := range dump.TriggeredAlerts {
+		owner, ok := owners[a.RuleId]
+		if !ok {
+			return fmt.Errorf("could not restore triggered alert %d: unknown alert rule %s", a.Id, a.RuleId)
+		}
+
+		err = q.ReplaceTriggeredAlert(ctx, persistence.ReplaceTriggeredAlertParams{
+			ID:      a.Id,
+			RuleID:  a.RuleId,
+			Owner:   owner,
+			Time:    a.Time.AsTime(),
+			Message: a.Message,
+		})
+		if err != nil {
+			return fmt.Errorf("could not restore triggered alert %d: %w", a.Id, err)
+		}
+	}
+
+	for _, t := range dump.AccessTokens {
+		err = q.ReplaceAccessToken(ctx, accessTokenFromProto(t))
+		if err != nil {
+			return fmt.Errorf("could not restore access token %s: %w", t.AccessToken.GetId(), err)
+		}
+	}
+
+	for _, o := range dump.TokenOwners {
+		err = q.SetTokenOwnerRoles(ctx, tokenOwnerFromProto(o))
+		if err != nil {
+			return fmt.Errorf("could not restore roles of %s: %w", o.Owner, err)
+		}
+	}
+
+	for _, e := range dump.AuditEvents {
+		dbe, err := audit.FromProto(e)
+		if err != nil {
+			return fmt.Errorf("could not convert audit event %d: %w", e.Id, err)
+		}
+
+		err = q.ReplaceAuditEvent(ctx, persistence.ReplaceAuditEventParams(*dbe))
+		if err != nil {
+			return fmt.Errorf("could not restore audit event %d: %w", e.Id, err)
+		}
+	}
+
+	return nil
+}
+
+// restoreTrash moves the items of trash back into the trash. Events are moved
+// before their portfolios. Otherwise, they would be marked as moved to the
+// trash together with their portfolio and restored with it.
+func restoreTrash(ctx context.Context, q *persistence.Queries, trash []*portfoliov1.TrashItem) (err error) {
+	for _, typ := range []string{"PortfolioEvent", "Portfolio", "Security"} {
+		for _, item := range trash {
+			if item.ResourceType != typ {
+				continue
+			}
+
+			t := sql.NullTime{Time: item.DeleteTime.AsTime(), Valid: true}
+			switch typ {
+			case "PortfolioEvent":
+				err = q.SetPortfolioEventDeleteTime(ctx, persistence.SetPortfolioEventDeleteTimeParams{DeleteTime: t, ID: item.Id})
+			case "Portfolio":
+				err = q.SetPortfolioDeleteTime(ctx, persistence.SetPortfolioDeleteTimeParams{DeleteTime: t, ID: item.Id})
+			case "Security":
+				err = q.SetSecurityDeleteTime(ctx, persistence.SetSecurityDeleteTimeParams{DeleteTime: t, ID: item.Id})
+			}
+			if err != nil {
+				return fmt.Errorf("could not move %s %s to the trash: %w", typ, item.Id, err)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,321 @@
+// Copyright 2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package admin
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+	"time"
+
+	moneygopher "github.com/oxisto/money-gopher"
+	portfoliov1 "github.com/oxisto/money-gopher/gen"
+	"github.com/oxisto/money-gopher/internal"
+	"github.com/oxisto/money-gopher/persistence"
+
+	"github.com/oxisto/assert"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func myData(t *testing.T) func(db *persistence.DB) {
+	return func(db *persistence.DB) {
+		portfolios := persistence.Ops[*portfoliov1.Portfolio](db)
+		assert.NoError(t, portfolios.Replace(context.Background(), &portfoliov1.Portfolio{
+			Id:          "mybank-myportfolio",
+			DisplayName: "My Portfolio",
+			Owner:       "money",
+		}))
+		assert.NoError(t, persistence.Relationship[*portfoliov1.PortfolioShare](portfolios).Replace(context.Background(), &portfoliov1.PortfolioShare{
+			PortfolioId: "mybank-myportfolio",
+			Subject:     "gopher",
+			Access:      portfoliov1.PortfolioAccess_PORTFOLIO_ACCESS_READ,
+		}))
+		assert.NoError(t, persistence.Relationship[*portfoliov1.PortfolioEvent](portfolios).Replace(context.Background(), &portfoliov1.PortfolioEvent{
+			Id:          "buy",
+			Type:        portfoliov1.PortfolioEventType_PORTFOLIO_EVENT_TYPE_BUY,
+			PortfolioId: "mybank-myportfolio",
+			SecurityId:  "US0378331005",
+			Amount:      20,
+			Price:       portfoliov1.Value(10708),
+			Fees:        portfoliov1.Value(1025),
+			Taxes:       portfoliov1.Zero(),
+			Time:        timestamppb.New(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)),
+		}))
+		assert.NoError(t, persistence.Ops[*portfoliov1.BankAccount](db).Replace(context.Background(), &portfoliov1.BankAccount{
+			Id:          "mybank-mycash",
+			DisplayName: "My Cash",
+			Owner:       "money",
+		}))
+		securities := persistence.Ops[*portfoliov1.Security](db)
+		assert.NoError(t, securities.Replace(context.Background(), &portfoliov1.Security{
+			Id:            "US0378331005",
+			DisplayName:   "Apple Inc.",
+			QuoteProvider: moneygopher.Ref("yf"),
+		}))
+		assert.NoError(t, persistence.Relationship[*portfoliov1.ListedSecurity](securities).Replace(context.Background(), &portfoliov1.ListedSecurity{
+			SecurityId: "US0378331005",
+			Ticker:     "AAPL",
+			Currency:   "USD",
+		}))
+
+		myTables(t, db)
+		myTrash(t, db, portfolios)
+	}
+}
+
+// myTables fills the tables that are not managed by our storage operations.
+func myTables(t *testing.T, db *persistence.DB) {
+	var (
+		ctx = context.Background()
+		q   = persistence.New(db)
+		now = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	)
+
+	hash, size, err := persistence.NewDBBlobStore(q).Put(ctx, strings.NewReader("receipt"))
+	assert.NoError(t, err)
+	assert.NoError(t, q.ReplaceAttachment(ctx, persistence.ReplaceAttachmentParams{
+		ID:          "receipt",
+		EventID:     "buy",
+		FileName:    "receipt.pdf",
+		ContentType: "application/pdf",
+		Size:        size,
+		Hash:        hash,
+		CreateTime:  now,
+	}))
+	assert.NoError(t, q.ReplaceSavingsPlan(ctx, persistence.ReplaceSavingsPlanParams{
+		ID:                "monthly",
+		PortfolioID:       "mybank-myportfolio",
+		SecurityID:        "US0378331005",
+		DisplayName:       "Monthly",
+		Value:             10000,
+		IntervalMonths:    1,
+		StartTime:         now,
+		FixedFee:          100,
+		LastExecutionTime: sql.NullTime{Time: now, Valid: true},
+		CreateTime:        now,
+	}))
+	assert.NoError(t, q.ReplaceWatchlist(ctx, persistence.ReplaceWatchlistParams{
+		ID:          "tech",
+		DisplayName: "Tech",
+		Owner:       "money",
+	}))
+	assert.NoError(t, q.ReplaceWatchlistEntry(ctx, persistence.ReplaceWatchlistEntryParams{
+		WatchlistID: "tech",
+		SecurityID:  "US0378331005",
+		AddTime:     now,
+		AddedQuote:  sql.NullInt64{Int64: 10708, Valid: true},
+	}))
+	assert.NoError(t, q.ReplaceAlertRule(ctx, persistence.ReplaceAlertRuleParams{
+		ID:                "above",
+		DisplayName:       "Above",
+		Owner:             "money",
+		Type:              int64(portfoliov1.AlertRuleType_ALERT_RULE_TYPE_PORTFOLIO_VALUE_ABOVE),
+		PortfolioID:       "mybank-myportfolio",
+		Threshold:         sql.NullInt64{Int64: 100000, Valid: true},
+		ThresholdCurrency: sql.NullString{String: "EUR", Valid: true},
+		Channels:          "webhook",
+		Active:            true,
+	}))
+	assert.NoError(t, q.ReplaceTriggeredAlert(ctx, persistence.ReplaceTriggeredAlertParams{
+		ID:      1,
+		RuleID:  "above",
+		Owner:   "money",
+		Time:    now,
+		Message: "The value is above 1000.00 EUR",
+	}))
+	assert.NoError(t, q.ReplaceAccessToken(ctx, persistence.ReplaceAccessTokenParams{
+		ID:          "token",
+		DisplayName: "Token",
+		Owner:       "money",
+		Scopes:      "portfolios:read",
+		Hash:        "abc",
+		CreateTime:  now,
+	}))
+	assert.NoError(t, q.SetTokenOwnerRoles(ctx, persistence.SetTokenOwnerRolesParams{
+		Owner:      "money",
+		Roles:      "user",
+		UpdateTime: now,
+	}))
+	assert.NoError(t, q.ReplaceAuditEvent(ctx, persistence.ReplaceAuditEventParams{
+		ID:           1,
+		Time:         now,
+		Subject:      "money",
+		Procedure:    "/mgo.portfolio.v1.PortfolioService/CreatePortfolio",
+		Action:       "create",
+		ResourceType: "Portfolio",
+		ResourceID:   "mybank-myportfolio",
+		After:        sql.NullString{String: `{"id":"mybank-myportfolio"}`, Valid: true},
+	}))
+}
+
+// myTrash moves a portfolio with its event, a single event and a security to
+// the trash.
+func myTrash(t *testing.T, db *persistence.DB, portfolios persistence.StorageOperations[*portfoliov1.Portfolio]) {
+	var (
+		ctx = context.Background()
+		q   = persistence.New(db)
+		now = sql.NullTime{Time: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), Valid: true}
+	)
+
+	assert.NoError(t, portfolios.Replace(ctx, &portfoliov1.Portfolio{
+		Id:          "mybank-old",
+		DisplayName: "Old Portfolio",
+		Owner:       "money",
+	}))
+	events := persistence.Relationship[*portfoliov1.PortfolioEvent](portfolios)
+	for _, e := range []*portfoliov1.PortfolioEvent{
+		{Id: "old-buy", PortfolioId: "mybank-old"},
+		{Id: "wrong-buy", PortfolioId: "mybank-myportfolio"},
+	} {
+		e.Type = portfoliov1.PortfolioEventType_PORTFOLIO_EVENT_TYPE_BUY
+		e.SecurityId = "US0378331005"
+		e.Amount = 1
+		e.Price = portfoliov1.Value(10000)
+		e.Fees = portfoliov1.Zero()
+		e.Taxes = portfoliov1.Zero()
+		e.Time = timestamppb.New(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC))
+		assert.NoError(t, events.Replace(ctx, e))
+	}
+	assert.NoError(t, persistence.Ops[*portfoliov1.Security](db).Replace(ctx, &portfoliov1.Security{
+		Id:          "DE0000000000",
+		DisplayName: "Delisted",
+	}))
+
+	assert.NoError(t, q.SetPortfolioEventDeleteTime(ctx, persistence.SetPortfolioEventDeleteTimeParams{DeleteTime: now, ID: "wrong-buy"}))
+	assert.NoError(t, q.SetPortfolioDeleteTime(ctx, persistence.SetPortfolioDeleteTimeParams{DeleteTime: now, ID: "mybank-old"}))
+	assert.NoError(t, q.SetSecurityDeleteTime(ctx, persistence.SetSecurityDeleteTimeParams{DeleteTime: now, ID: "DE0000000000"}))
+}
+
+func TestExport(t *testing.T) {
+	type args struct {
+		db *persistence.DB
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantDump assert.Want[*portfoliov1.Dump]
+		wantErr  bool
+	}{
+		{
+			name: "empty database",
+			args: args{db: internal.NewTestDB(t)},
+			wantDump: func(t *testing.T, dump *portfoliov1.Dump) bool {
+				return assert.Equals(t, DumpVersion, dump.Version) &&
+					assert.Equals(t, 0, len(dump.Portfolios)) &&
+					assert.Equals(t, 0, len(dump.Securities))
+			},
+		},
+		{
+			name: "with data",
+			args: args{db: internal.NewTestDB(t, myData(t))},
+			wantDump: func(t *testing.T, dump *portfoliov1.Dump) bool {
+				// Trashed portfolios, events and securities are part of the
+				// dump
+				return assert.Equals(t, 2, len(dump.Portfolios)) &&
+					assert.Equals(t, 2, len(dump.Portfolios[0].Events)) &&
+					assert.Equals(t, 1, len(dump.Portfolios[1].Events)) &&
+					assert.Equals(t, 1, len(dump.BankAccounts)) &&
+					assert.Equals(t, 2, len(dump.Securities)) &&
+					assert.Equals(t, 1, len(dump.Securities[0].ListedOn)) &&
+					assert.Equals(t, 3, len(dump.Trash)) &&
+					assert.Equals(t, 1, len(dump.Attachments)) &&
+					assert.Equals(t, "receipt", string(dump.AttachmentContents[0].Content)) &&
+					assert.Equals(t, 1, len(dump.SavingsPlans)) &&
+					assert.Equals(t, 1, len(dump.Watchlists[0].Entries)) &&
+					assert.Equals(t, 1, len(dump.AlertRules)) &&
+					assert.Equals(t, 1, len(dump.TriggeredAlerts)) &&
+					assert.Equals(t, "abc", dump.AccessTokens[0].Hash) &&
+					assert.Equals(t, 1, len(dump.TokenOwners)) &&
+					assert.Equals(t, 1, len(dump.AuditEvents))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotDump, err := Export(context.Background(), tt.args.db, persistence.NewDBBlobStore(persistence.New(tt.args.db)))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Export() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			tt.wantDump(t, gotDump)
+		})
+	}
+}
+
+func TestRestore(t *testing.T) {
+	src := internal.NewTestDB(t, myData(t))
+	dump, err := Export(context.Background(), src, persistence.NewDBBlobStore(persistence.New(src)))
+	assert.NoError(t, err)
+
+	type args struct {
+		db   *persistence.DB
+		dump *portfoliov1.Dump
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantDB  assert.Want[*persistence.DB]
+		wantErr assert.Want[error]
+	}{
+		{
+			name: "round-trip",
+			args: args{
+				db:   internal.NewTestDB(t),
+				dump: dump,
+			},
+			wantDB: func(t *testing.T, db *persistence.DB) bool {
+				restored, err := Export(context.Background(), db, persistence.NewDBBlobStore(persistence.New(db)))
+				assert.NoError(t, err)
+
+				// The time is the only thing that is allowed to differ
+				restored.Time = dump.Time
+
+				// The trash is restored as well, so that the restored
+				// portfolio is not listed
+				portfolios, err := persistence.Ops[*portfoliov1.Portfolio](db).List(context.Background(), portfoliov1.AllOwners)
+				assert.NoError(t, err)
+
+				return assert.Equals(t, dump, restored, protocmp.Transform()) &&
+					assert.Equals(t, 1, len(portfolios))
+			},
+		},
+		{
+			name: "unsupported version",
+			args: args{
+				db:   internal.NewTestDB(t),
+				dump: &portfoliov1.Dump{Version: 999},
+			},
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, ErrUnsupportedDumpVersion, err)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Restore(context.Background(), tt.args.db, persistence.NewDBBlobStore(persistence.New(tt.args.db)), tt.args.dump)
+			if tt.wantErr != nil {
+				tt.wantErr(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			tt.wantDB(t, tt.args.db)
+		})
+	}
+}
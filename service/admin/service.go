@@ -0,0 +1,121 @@
+// Copyright 2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+// package admin contains the code for the AdminService implementation.
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	portfoliov1 "github.com/oxisto/money-gopher/gen"
+	"github.com/oxisto/money-gopher/gen/portfoliov1connect"
+	"github.com/oxisto/money-gopher/persistence"
+	"github.com/oxisto/money-gopher/service/audit"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+var (
+	// ErrBackupsDisabled is returned by CreateBackup if no backup directory is
+	// configured.
+	ErrBackupsDisabled = errors.New("backups are disabled, since no backup directory is configured")
+
+	// ErrEncryptedBackup is returned by CreateBackup if the database is
+	// encrypted at rest, since a backup would contain it in plaintext.
+	ErrEncryptedBackup = errors.New("backups of an encrypted database are not supported, since they would not be encrypted")
+)
+
+// service is the main struct for the [AdminService] implementation.
+type service struct {
+	db *persistence.DB
+
+	// blobs stores the content of attachments, which is part of dumps.
+	blobs persistence.BlobStore
+
+	// backupDir is the directory in which CreateBackup writes backups.
+	backupDir string
+
+	portfoliov1connect.UnimplementedAdminServiceHandler
+}
+
+// NewService creates a new admin service. The content of attachments in dumps
+// is read from and written to blobs. Backups that are created by clients are
+// written to backupDir. If it is empty, CreateBackup is disabled.
+func NewService(db *persistence.DB, blobs persistence.BlobStore, backupDir string) portfoliov1connect.AdminServiceHandler {
+	return &service{db: db, blobs: blobs, backupDir: backupDir}
+}
+
+func (svc *service) CreateBackup(ctx context.Context, req *connect.Request[portfoliov1.CreateBackupRequest]) (res *connect.Response[portfoliov1.CreateBackupResponse], err error) {
+	var size int64
+
+	if svc.backupDir == "" {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, ErrBackupsDisabled)
+	} else if persistence.Encrypted(svc.db) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, ErrEncryptedBackup)
+	}
+
+	// Clients must not be able to write anywhere else than into the backup
+	// directory
+	if !filepath.IsLocal(req.Msg.Path) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("path %q is not within the backup directory", req.Msg.Path))
+	}
+
+	size, err = persistence.Backup(ctx, svc.db, filepath.Join(svc.backupDir, req.Msg.Path))
+	if errors.Is(err, persistence.ErrBackupExists) {
+		return nil, connect.NewError(connect.CodeAlreadyExists, err)
+	} else if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	res = connect.NewResponse(&portfoliov1.CreateBackupResponse{
+		Path: req.Msg.Path,
+		Size: size,
+	})
+
+	return
+}
+
+func (svc *service) ExportDump(ctx context.Context, req *connect.Request[portfoliov1.ExportDumpRequest]) (res *connect.Response[portfoliov1.Dump], err error) {
+	var dump *portfoliov1.Dump
+
+	dump, err = Export(ctx, svc.db, svc.blobs)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(dump), nil
+}
+
+func (svc *service) RestoreDump(ctx context.Context, req *connect.Request[portfoliov1.RestoreDumpRequest]) (res *connect.Response[emptypb.Empty], err error) {
+	err = Restore(ctx, svc.db, svc.blobs, req.Msg.Dump)
+	if errors.Is(err, ErrUnsupportedDumpVersion) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	} else if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	// The dump itself is too large to be recorded
+	audit.Record(ctx, audit.Event{
+		Action:       portfoliov1.AuditAction_AUDIT_ACTION_IMPORT,
+		ResourceType: "Dump",
+	})
+
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
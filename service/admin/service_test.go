@@ -0,0 +1,102 @@
+// Copyright 2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package admin
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	portfoliov1 "github.com/oxisto/money-gopher/gen"
+	"github.com/oxisto/money-gopher/internal"
+	"github.com/oxisto/money-gopher/persistence"
+
+	"connectrpc.com/connect"
+	"github.com/oxisto/assert"
+)
+
+func Test_service_CreateBackup(t *testing.T) {
+	type fields struct {
+		backupDir string
+		encrypted bool
+	}
+	type args struct {
+		path string
+	}
+	tests := []struct {
+		name     string
+		fields   fields
+		args     args
+		wantCode connect.Code
+	}{
+		{
+			name:   "within backup directory",
+			fields: fields{backupDir: t.TempDir()},
+			args:   args{path: "money.db"},
+		},
+		{
+			name:     "outside of backup directory",
+			fields:   fields{backupDir: t.TempDir()},
+			args:     args{path: "../money.db"},
+			wantCode: connect.CodeInvalidArgument,
+		},
+		{
+			name:     "absolute path",
+			fields:   fields{backupDir: t.TempDir()},
+			args:     args{path: "/tmp/money.db"},
+			wantCode: connect.CodeInvalidArgument,
+		},
+		{
+			name:     "no backup directory",
+			args:     args{path: "money.db"},
+			wantCode: connect.CodeFailedPrecondition,
+		},
+		{
+			name:     "encrypted database",
+			fields:   fields{backupDir: t.TempDir(), encrypted: true},
+			args:     args{path: "money.db"},
+			wantCode: connect.CodeFailedPrecondition,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := internal.NewTestDB(t)
+			if tt.fields.encrypted {
+				var err error
+				db, _, err = persistence.OpenDB(persistence.Options{
+					DSN:        filepath.Join(t.TempDir(), "money.db"),
+					Passphrase: "moneymoneymoney",
+				})
+				assert.NoError(t, err)
+				defer persistence.Close(db)
+			}
+
+			svc := NewService(db, nil, tt.fields.backupDir)
+			res, err := svc.CreateBackup(context.Background(), connect.NewRequest(&portfoliov1.CreateBackupRequest{
+				Path: tt.args.path,
+			}))
+			if tt.wantCode != 0 {
+				assert.Equals(t, tt.wantCode, connect.CodeOf(err))
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equals(t, tt.args.path, res.Msg.Path)
+			assert.Equals(t, true, res.Msg.Size > 0)
+		})
+	}
+}
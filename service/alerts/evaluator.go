@@ -0,0 +1,283 @@
+// Copyright 2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package alerts
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/oxisto/money-gopher/auth"
+	portfoliov1 "github.com/oxisto/money-gopher/gen"
+	"github.com/oxisto/money-gopher/gen/portfoliov1connect"
+	"github.com/oxisto/money-gopher/persistence"
+	"github.com/oxisto/money-gopher/service/events"
+
+	"connectrpc.com/connect"
+	"github.com/lmittmann/tint"
+)
+
+// evaluateInterval is the interval in which all alert rules are evaluated, so
+// that quotes going stale are noticed even if nothing changes.
+var evaluateInterval = 5 * time.Minute
+
+// notifyTimeout is the time a notification channel has to deliver an alert.
+const notifyTimeout = 30 * time.Second
+
+// Evaluator evaluates alert rules and delivers the alerts of rules whose
+// condition is met.
+type Evaluator struct {
+	q          *persistence.Queries
+	portfolios portfoliov1connect.PortfolioServiceHandler
+}
+
+// NewEvaluator creates a new [Evaluator].
+func NewEvaluator(opts Options) *Evaluator {
+	return &Evaluator{q: opts.Queries, portfolios: opts.Portfolios}
+}
+
+// Run evaluates all alert rules once on startup and then every
+// [evaluateInterval]. In between, the rules that are affected by an event of
+// broker, e.g., a quote update or a new transaction, are evaluated. It returns
+// once ctx is done or the broker is closed.
+func (ev *Evaluator) Run(ctx context.Context, broker *events.Broker) {
+	var (
+		e  events.Event
+		ok bool
+	)
+
+	ch, cancel := broker.Subscribe()
+	defer cancel()
+
+	ticker := time.NewTicker(evaluateInterval)
+	defer ticker.Stop()
+
+	for {
+		err := ev.Evaluate(ctx, e)
+		if err != nil && ctx.Err() == nil {
+			slog.Error("Could not evaluate alert rules", tint.Err(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case e, ok = <-ch:
+			if !ok {
+				return
+			}
+		case <-ticker.C:
+			e = events.Event{}
+		}
+	}
+}
+
+// Evaluate evaluates all alert rules that are affected by the event. An empty
+// event affects all rules. A rule triggers an alert once its condition is met
+// and only again after its condition was not met in between.
+func (ev *Evaluator) Evaluate(ctx context.Context, e events.Event) (err error) {
+	var (
+		rules []*persistence.AlertRule
+		snap  *portfoliov1.PortfolioSnapshot
+		now   = time.Now()
+	)
+
+	rules, err = ev.q.ListAlertRules(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, dbr := range rules {
+		if !affects(dbr, e) {
+			continue
+		}
+
+		r := ruleToProto(dbr)
+
+		// The snapshot is taken on behalf of the owner, so that rules of
+		// portfolios the owner lost access to do not trigger anymore
+		snap, err = ev.snapshot(auth.NewContext(ctx, &auth.User{Subject: dbr.Owner}), dbr.PortfolioID)
+		if err != nil {
+			slog.Warn("Could not evaluate alert rule", tint.Err(err), "rule", dbr.ID)
+			continue
+		}
+
+		// We cannot convert between currencies, so a threshold is only
+		// comparable to a portfolio value of the same currency
+		if isValueRule(r) && r.Threshold.GetSymbol() != snap.TotalPortfolioValue.GetSymbol() {
+			slog.Warn("Could not evaluate alert rule", tint.Err(ErrThresholdCurrency), "rule", dbr.ID,
+				"threshold", r.Threshold.GetSymbol(), "portfolio", snap.TotalPortfolioValue.GetSymbol())
+			continue
+		}
+
+		msg, met := check(r, snap, now)
+		if met == dbr.Active {
+			continue
+		}
+
+		// A failure of one rule must not keep the other rules from being
+		// evaluated. The rule keeps its state and is retried on the next
+		// evaluation.
+		err = ev.q.SetAlertRuleActive(ctx, persistence.SetAlertRuleActiveParams{
+			Active: met,
+			ID:     dbr.ID,
+		})
+		if err != nil {
+			slog.Error("Could not update alert rule", tint.Err(err), "rule", dbr.ID)
+			continue
+		}
+
+		if met {
+			err = ev.trigger(ctx, r, msg, now)
+			if err != nil {
+				slog.Error("Could not trigger alert rule", tint.Err(err), "rule", dbr.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+// snapshot takes the current snapshot of a portfolio.
+func (ev *Evaluator) snapshot(ctx context.Context, portfolioID string) (snap *portfoliov1.PortfolioSnapshot, err error) {
+	res, err := ev.portfolios.GetPortfolioSnapshot(ctx, connect.NewRequest(&portfoliov1.GetPortfolioSnapshotRequest{
+		PortfolioId: portfolioID,
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Msg, nil
+}
+
+// trigger records the alert of the rule and delivers it to all channels of
+// the rule. Failed deliveries are only logged, since the alert can still be
+// listed.
+func (ev *Evaluator) trigger(ctx context.Context, r *portfoliov1.AlertRule, msg string, now time.Time) (err error) {
+	dba, err := ev.q.CreateTriggeredAlert(ctx, persistence.CreateTriggeredAlertParams{
+		RuleID:  r.Id,
+		Owner:   r.Owner,
+		Time:    now,
+		Message: msg,
+	})
+	if err != nil {
+		return err
+	}
+
+	alert := alertToProto(dba)
+
+	slog.Info("Alert triggered", "rule", r.Id, "message", msg)
+
+	for _, name := range r.Channels {
+		n, ok := notifier(name)
+		if !ok {
+			slog.Warn("Notification channel is not configured", "channel", name, "rule", r.Id)
+			continue
+		}
+
+		nctx, cancel := context.WithTimeout(ctx, notifyTimeout)
+		err = n.Notify(nctx, r, alert)
+		cancel()
+		if err != nil {
+			slog.Error("Could not deliver alert", tint.Err(err), "channel", name, "rule", r.Id)
+		}
+	}
+
+	return nil
+}
+
+// isValueRule checks, whether the rule compares the total portfolio value
+// against its threshold.
+func isValueRule(r *portfoliov1.AlertRule) bool {
+	return r.Type == portfoliov1.AlertRuleType_ALERT_RULE_TYPE_PORTFOLIO_VALUE_ABOVE ||
+		r.Type == portfoliov1.AlertRuleType_ALERT_RULE_TYPE_PORTFOLIO_VALUE_BELOW
+}
+
+// affects checks, whether the event can change the outcome of the rule.
+func affects(dbr *persistence.AlertRule, e events.Event) bool {
+	switch {
+	case e.PortfolioID != "":
+		return dbr.PortfolioID == e.PortfolioID
+	case len(e.SecurityIDs) > 0:
+		return dbr.SecurityID == "" || slices.Contains(e.SecurityIDs, dbr.SecurityID)
+	default:
+		return true
+	}
+}
+
+// check checks the condition of the rule against the snapshot. If it is met,
+// msg describes why. A position drop compares the gains of a position since
+// its purchase, not since the previous quote. The threshold of a portfolio
+// value condition must be of the same currency as the snapshot.
+func check(r *portfoliov1.AlertRule, snap *portfoliov1.PortfolioSnapshot, now time.Time) (msg string, met bool) {
+	var reasons []string
+
+	switch r.Type {
+	case portfoliov1.AlertRuleType_ALERT_RULE_TYPE_POSITION_DROP:
+		for _, pos := range positions(r, snap) {
+			if pos.Gains <= -r.Percentage {
+				reasons = append(reasons, fmt.Sprintf("%s has lost %.2f %% of its purchase value", pos.Security.GetDisplayName(), -pos.Gains*100))
+			}
+		}
+	case portfoliov1.AlertRuleType_ALERT_RULE_TYPE_STALE_QUOTE:
+		for _, pos := range positions(r, snap) {
+			t := latestQuoteTime(pos.Security)
+			if t.IsZero() {
+				reasons = append(reasons, fmt.Sprintf("%s has no quote", pos.Security.GetDisplayName()))
+			} else if age := now.Sub(t); age > r.MaxQuoteAge.AsDuration() {
+				reasons = append(reasons, fmt.Sprintf("The quote of %s is %s old", pos.Security.GetDisplayName(), age.Truncate(time.Minute)))
+			}
+		}
+	case portfoliov1.AlertRuleType_ALERT_RULE_TYPE_PORTFOLIO_VALUE_ABOVE:
+		if v := snap.TotalPortfolioValue; v.GetValue() > r.Threshold.GetValue() {
+			reasons = append(reasons, fmt.Sprintf("The value of portfolio %s is %s, which is above %s", r.PortfolioId, v.Pretty(), r.Threshold.Pretty()))
+		}
+	case portfoliov1.AlertRuleType_ALERT_RULE_TYPE_PORTFOLIO_VALUE_BELOW:
+		if v := snap.TotalPortfolioValue; v.GetValue() < r.Threshold.GetValue() {
+			reasons = append(reasons, fmt.Sprintf("The value of portfolio %s is %s, which is below %s", r.PortfolioId, v.Pretty(), r.Threshold.Pretty()))
+		}
+	}
+
+	return strings.Join(reasons, "\n"), len(reasons) > 0
+}
+
+// positions returns the positions of the snapshot that the rule applies to,
+// ordered by their security.
+func positions(r *portfoliov1.AlertRule, snap *portfoliov1.PortfolioSnapshot) (list []*portfoliov1.PortfolioPosition) {
+	for _, name := range slices.Sorted(maps.Keys(snap.Positions)) {
+		if r.SecurityId == "" || r.SecurityId == name {
+			list = append(list, snap.Positions[name])
+		}
+	}
+
+	return
+}
+
+// latestQuoteTime returns the time of the most recent quote of any listing of
+// the security.
+func latestQuoteTime(sec *portfoliov1.Security) (t time.Time) {
+	for _, ls := range sec.GetListedOn() {
+		if ls.LatestQuoteTimestamp != nil && ls.LatestQuoteTimestamp.AsTime().After(t) {
+			t = ls.LatestQuoteTimestamp.AsTime()
+		}
+	}
+
+	return
+}
@@ -0,0 +1,190 @@
+// Copyright 2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package alerts
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	portfoliov1 "github.com/oxisto/money-gopher/gen"
+	"github.com/oxisto/money-gopher/internal"
+	"github.com/oxisto/money-gopher/service/events"
+
+	"connectrpc.com/connect"
+	"github.com/oxisto/assert"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// recorder is a [Notifier] that records all alerts.
+type recorder struct {
+	mu     sync.Mutex
+	alerts []*portfoliov1.TriggeredAlert
+}
+
+func (r *recorder) Notify(_ context.Context, _ *portfoliov1.AlertRule, alert *portfoliov1.TriggeredAlert) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.alerts = append(r.alerts, alert)
+	return nil
+}
+
+func (r *recorder) messages() (msgs []string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, a := range r.alerts {
+		msgs = append(msgs, a.Message)
+	}
+
+	return
+}
+
+func TestEvaluator_Evaluate(t *testing.T) {
+	rec := &recorder{}
+	RegisterNotifier("evaluate", rec)
+
+	svc, q, portfolios := newTestService(t)
+	ctx := internal.WithTestUser(context.Background())
+
+	for _, r := range []*portfoliov1.AlertRule{
+		{
+			Id:          "apple-drop",
+			Type:        portfoliov1.AlertRuleType_ALERT_RULE_TYPE_POSITION_DROP,
+			PortfolioId: "mybank-myportfolio",
+			SecurityId:  "US0378331005",
+			Percentage:  0.1,
+			Channels:    []string{"evaluate"},
+		},
+		{
+			Id:          "stale",
+			Type:        portfoliov1.AlertRuleType_ALERT_RULE_TYPE_STALE_QUOTE,
+			PortfolioId: "mybank-myportfolio",
+			MaxQuoteAge: durationpb.New(24 * time.Hour),
+		},
+		{
+			Id:          "below",
+			Type:        portfoliov1.AlertRuleType_ALERT_RULE_TYPE_PORTFOLIO_VALUE_BELOW,
+			PortfolioId: "mybank-myportfolio",
+			Threshold:   portfoliov1.Value(50000),
+		},
+		{
+			Id:          "above-usd",
+			Type:        portfoliov1.AlertRuleType_ALERT_RULE_TYPE_PORTFOLIO_VALUE_ABOVE,
+			PortfolioId: "mybank-myportfolio",
+			Threshold:   &portfoliov1.Currency{Symbol: "USD", Value: 100},
+		},
+	} {
+		_, err := svc.CreateAlertRule(ctx, connect.NewRequest(&portfoliov1.CreateAlertRuleRequest{AlertRule: r}))
+		assert.NoError(t, err)
+	}
+
+	ev := NewEvaluator(Options{Queries: q, Portfolios: portfolios})
+
+	// Nothing is triggered as long as nothing happened
+	assert.NoError(t, ev.Evaluate(context.Background(), events.Event{}))
+	assert.Equals(t, 0, len(rec.messages()))
+
+	// A drop of Apple triggers its rule once, even if it drops further
+	portfolios.snapshot = snapshotWith(-0.15, time.Now())
+	assert.NoError(t, ev.Evaluate(context.Background(), events.Event{SecurityIDs: []string{"US0378331005"}}))
+	portfolios.snapshot = snapshotWith(-0.2, time.Now())
+	assert.NoError(t, ev.Evaluate(context.Background(), events.Event{SecurityIDs: []string{"US0378331005"}}))
+	assert.Equals(t, []string{"Apple Inc. has lost 15.00 % of its purchase value"}, rec.messages())
+
+	// Events of other portfolios do not affect the rules
+	portfolios.snapshot = snapshotWith(-0.6, time.Now().Add(-48*time.Hour))
+	assert.NoError(t, ev.Evaluate(context.Background(), events.Event{PortfolioID: "otherbank-myportfolio"}))
+
+	res, err := svc.ListTriggeredAlerts(ctx, connect.NewRequest(&portfoliov1.ListTriggeredAlertsRequest{}))
+	assert.NoError(t, err)
+	assert.Equals(t, 1, len(res.Msg.TriggeredAlerts))
+
+	// A stale quote and a low portfolio value are only listed, since their
+	// rules have no channel. The threshold in US dollars cannot be compared
+	// to the portfolio value in euros.
+	assert.NoError(t, ev.Evaluate(context.Background(), events.Event{}))
+	assert.Equals(t, 1, len(rec.messages()))
+
+	res, err = svc.ListTriggeredAlerts(ctx, connect.NewRequest(&portfoliov1.ListTriggeredAlertsRequest{}))
+	assert.NoError(t, err)
+	assert.Equals(t, 3, len(res.Msg.TriggeredAlerts))
+
+	res, err = svc.ListTriggeredAlerts(ctx, connect.NewRequest(&portfoliov1.ListTriggeredAlertsRequest{RuleId: "below"}))
+	assert.NoError(t, err)
+	assert.Equals(t, 1, len(res.Msg.TriggeredAlerts))
+	assert.Equals(t, "The value of portfolio mybank-myportfolio is 400 EUR, which is below 500 EUR", res.Msg.TriggeredAlerts[0].Message)
+
+	// Once Apple recovers, its rule triggers again on the next drop
+	portfolios.snapshot = snapshotWith(0, time.Now())
+	assert.NoError(t, ev.Evaluate(context.Background(), events.Event{}))
+	portfolios.snapshot = snapshotWith(-0.1, time.Now())
+	assert.NoError(t, ev.Evaluate(context.Background(), events.Event{}))
+	assert.Equals(t, []string{
+		"Apple Inc. has lost 15.00 % of its purchase value",
+		"Apple Inc. has lost 10.00 % of its purchase value",
+	}, rec.messages())
+
+	rules, err := svc.ListAlertRules(ctx, connect.NewRequest(&portfoliov1.ListAlertRulesRequest{}))
+	assert.NoError(t, err)
+	for _, r := range rules.Msg.AlertRules {
+		assert.Equals(t, r.Id == "apple-drop", r.Active)
+	}
+}
+
+func TestEvaluator_Run(t *testing.T) {
+	rec := &recorder{}
+	RegisterNotifier("run", rec)
+
+	svc, q, portfolios := newTestService(t)
+	portfolios.snapshot = snapshotWith(-0.5, time.Now())
+
+	_, err := svc.CreateAlertRule(internal.WithTestUser(context.Background()), connect.NewRequest(&portfoliov1.CreateAlertRuleRequest{
+		AlertRule: &portfoliov1.AlertRule{
+			Id:          "apple-drop",
+			Type:        portfoliov1.AlertRuleType_ALERT_RULE_TYPE_POSITION_DROP,
+			PortfolioId: "mybank-myportfolio",
+			Percentage:  0.1,
+			Channels:    []string{"run"},
+		},
+	}))
+	assert.NoError(t, err)
+
+	broker := events.NewBroker()
+	done := make(chan struct{})
+	go func() {
+		NewEvaluator(Options{Queries: q, Portfolios: portfolios}).Run(context.Background(), broker)
+		close(done)
+	}()
+
+	// All rules are evaluated on startup
+	for range 100 {
+		if len(rec.messages()) > 0 {
+			break
+		}
+
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	assert.Equals(t, []string{"Apple Inc. has lost 50.00 % of its purchase value"}, rec.messages())
+
+	// The evaluator stops once the broker is closed
+	broker.Close()
+	<-done
+}
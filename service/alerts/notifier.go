@@ -0,0 +1,263 @@
+// Copyright 2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package alerts
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"net/smtp"
+	"os"
+	"os/exec"
+	"slices"
+	"strings"
+	"sync"
+
+	portfoliov1 "github.com/oxisto/money-gopher/gen"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	// ChannelWebhook is the name of the channel that posts alerts to a URL.
+	ChannelWebhook = "webhook"
+
+	// ChannelSMTP is the name of the channel that sends alerts by e-mail.
+	ChannelSMTP = "smtp"
+
+	// ChannelCommand is the name of the channel that runs a local command
+	// for every alert.
+	ChannelCommand = "command"
+)
+
+// Notifier delivers triggered alerts, e.g., by e-mail.
+type Notifier interface {
+	Notify(ctx context.Context, rule *portfoliov1.AlertRule, alert *portfoliov1.TriggeredAlert) error
+}
+
+var (
+	// notifiers contains all notification channels, indexed by their name.
+	notifiers   = make(map[string]Notifier)
+	notifiersMu sync.RWMutex
+)
+
+// RegisterNotifier makes the notifier available as a notification channel of
+// alert rules under the specified name.
+func RegisterNotifier(name string, n Notifier) {
+	notifiersMu.Lock()
+	defer notifiersMu.Unlock()
+
+	notifiers[name] = n
+}
+
+// Channels returns the names of all registered notification channels.
+func Channels() (names []string) {
+	notifiersMu.RLock()
+	defer notifiersMu.RUnlock()
+
+	for name := range notifiers {
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+	return
+}
+
+// notifier returns the notifier of the channel with the specified name.
+func notifier(name string) (n Notifier, ok bool) {
+	notifiersMu.RLock()
+	defer notifiersMu.RUnlock()
+
+	n, ok = notifiers[name]
+	return
+}
+
+// Webhook posts the JSON representation of an alert to a URL.
+type Webhook struct {
+	URL    string
+	Client *http.Client
+}
+
+func (w *Webhook) Notify(ctx context.Context, rule *portfoliov1.AlertRule, alert *portfoliov1.TriggeredAlert) (err error) {
+	var (
+		client = w.Client
+		body   []byte
+		req    *http.Request
+		res    *http.Response
+	)
+
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	body, err = protojson.Marshal(alert)
+	if err != nil {
+		return err
+	}
+
+	req, err = http.NewRequestWithContext(ctx, http.MethodPost, w.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err = client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return fmt.Errorf("webhook returned status code %d", res.StatusCode)
+	}
+
+	return nil
+}
+
+// SMTP sends an alert by e-mail. Auth can be nil, if the server does not
+// require authentication.
+type SMTP struct {
+	Addr string
+	From string
+	To   []string
+	Auth smtp.Auth
+}
+
+func (s *SMTP) Notify(ctx context.Context, rule *portfoliov1.AlertRule, alert *portfoliov1.TriggeredAlert) (err error) {
+	var (
+		msg    strings.Builder
+		host   string
+		conn   net.Conn
+		client *smtp.Client
+		w      io.WriteCloser
+	)
+
+	fmt.Fprintf(&msg, "From: %s\r\n", s.From)
+	fmt.Fprintf(&msg, "To: %s\r\n", strings.Join(s.To, ", "))
+	fmt.Fprintf(&msg, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", "[Money Gopher] "+rule.DisplayName))
+	fmt.Fprintf(&msg, "Content-Type: text/plain; charset=utf-8\r\n\r\n")
+	fmt.Fprintf(&msg, "%s\r\n", alert.Message)
+
+	host, _, err = net.SplitHostPort(s.Addr)
+	if err != nil {
+		return err
+	}
+
+	// In contrast to [smtp.SendMail], the whole conversation with the server
+	// is bound to ctx
+	conn, err = new(net.Dialer).DialContext(ctx, "tcp", s.Addr)
+	if err != nil {
+		return err
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		err = conn.SetDeadline(deadline)
+		if err != nil {
+			return errors.Join(err, conn.Close())
+		}
+	}
+
+	client, err = smtp.NewClient(conn, host)
+	if err != nil {
+		return errors.Join(err, conn.Close())
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		err = client.StartTLS(&tls.Config{ServerName: host})
+		if err != nil {
+			return err
+		}
+	}
+
+	if s.Auth != nil {
+		err = client.Auth(s.Auth)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = client.Mail(s.From)
+	if err != nil {
+		return err
+	}
+
+	for _, to := range s.To {
+		err = client.Rcpt(to)
+		if err != nil {
+			return err
+		}
+	}
+
+	w, err = client.Data()
+	if err != nil {
+		return err
+	}
+
+	_, err = io.WriteString(w, msg.String())
+	if err != nil {
+		return errors.Join(err, w.Close())
+	}
+
+	err = w.Close()
+	if err != nil {
+		return err
+	}
+
+	return client.Quit()
+}
+
+// Command runs a local command for every alert. The JSON representation of
+// the alert is passed on stdin, its message and rule are also available in
+// the environment variables MGO_ALERT_MESSAGE and MGO_ALERT_RULE_ID.
+type Command struct {
+	Name string
+	Args []string
+}
+
+func (c *Command) Notify(ctx context.Context, rule *portfoliov1.AlertRule, alert *portfoliov1.TriggeredAlert) (err error) {
+	var (
+		cmd  = exec.CommandContext(ctx, c.Name, c.Args...)
+		body []byte
+		out  []byte
+	)
+
+	body, err = protojson.Marshal(alert)
+	if err != nil {
+		return err
+	}
+
+	cmd.Stdin = bytes.NewReader(body)
+	cmd.Env = append(os.Environ(),
+		"MGO_ALERT_MESSAGE="+alert.Message,
+		"MGO_ALERT_RULE_ID="+rule.Id,
+	)
+
+	out, err = cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("command failed: %w: %s", err, out)
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+// Copyright 2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package alerts
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	portfoliov1 "github.com/oxisto/money-gopher/gen"
+
+	"github.com/oxisto/assert"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+var (
+	testRule  = &portfoliov1.AlertRule{Id: "apple-drop", DisplayName: "Apple drops"}
+	testAlert = &portfoliov1.TriggeredAlert{Id: 1, RuleId: "apple-drop", Message: "Apple Inc. has lost 15.00 % of its purchase value"}
+)
+
+func TestWebhook_Notify(t *testing.T) {
+	var got = new(portfoliov1.TriggeredAlert)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/missing" {
+			http.NotFound(w, r)
+			return
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		if protojson.Unmarshal(body, got) != nil {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer srv.Close()
+
+	err := (&Webhook{URL: srv.URL}).Notify(context.Background(), testRule, testAlert)
+	assert.NoError(t, err)
+	assert.Equals(t, testAlert, got, protocmp.Transform())
+
+	// Errors of the receiver are reported
+	err = (&Webhook{URL: srv.URL + "/missing"}).Notify(context.Background(), testRule, testAlert)
+	assert.Equals(t, true, err != nil)
+}
+
+func TestCommand_Notify(t *testing.T) {
+	err := (&Command{Name: "sh", Args: []string{"-c", `test "$MGO_ALERT_RULE_ID" = apple-drop && grep -q 'has lost' -`}}).Notify(context.Background(), testRule, testAlert)
+	assert.NoError(t, err)
+
+	err = (&Command{Name: "false"}).Notify(context.Background(), testRule, testAlert)
+	assert.Equals(t, true, err != nil)
+}
+
+func TestSMTP_Notify(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	defer l.Close()
+
+	// A minimal SMTP server that accepts a single message and hands over its
+	// data
+	data := make(chan string, 1)
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		var (
+			r      = bufio.NewReader(conn)
+			msg    strings.Builder
+			inData bool
+		)
+
+		io.WriteString(conn, "220 localhost\r\n")
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				return
+			}
+
+			switch {
+			case inData && line == ".\r\n":
+				inData = false
+				data <- msg.String()
+				io.WriteString(conn, "250 OK\r\n")
+			case inData:
+				msg.WriteString(line)
+			case strings.HasPrefix(line, "DATA"):
+				inData = true
+				io.WriteString(conn, "354 Go ahead\r\n")
+			case strings.HasPrefix(line, "QUIT"):
+				io.WriteString(conn, "221 Bye\r\n")
+				return
+			default:
+				io.WriteString(conn, "250 OK\r\n")
+			}
+		}
+	}()
+
+	rule := &portfoliov1.AlertRule{Id: "apple-drop", DisplayName: "Äpfel fallen"}
+
+	err = (&SMTP{Addr: l.Addr().String(), From: "gopher@example.com", To: []string{"money@example.com"}}).Notify(context.Background(), rule, testAlert)
+	assert.NoError(t, err)
+
+	msg := <-data
+	assert.Equals(t, true, strings.Contains(msg, "Subject: =?utf-8?q?[Money_Gopher]_=C3=84pfel_fallen?=\r\n"))
+	assert.Equals(t, true, strings.Contains(msg, testAlert.Message))
+}
+
+func TestSMTP_Notify_timeout(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	defer l.Close()
+
+	// A server that accepts connections, but never answers
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	err = (&SMTP{Addr: l.Addr().String(), From: "gopher@example.com", To: []string{"money@example.com"}}).Notify(ctx, testRule, testAlert)
+	assert.Equals(t, true, err != nil)
+}
@@ -0,0 +1,332 @@
+// Copyright 2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+// package alerts contains the code for the AlertService implementation. Alert
+// rules are evaluated against portfolio snapshots after every change of a
+// portfolio or quote and triggered alerts are delivered to pluggable
+// notification channels.
+package alerts
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/oxisto/money-gopher/auth"
+	portfoliov1 "github.com/oxisto/money-gopher/gen"
+	"github.com/oxisto/money-gopher/gen/portfoliov1connect"
+	"github.com/oxisto/money-gopher/persistence"
+	"github.com/oxisto/money-gopher/service/audit"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// DefaultPageSize is the number of triggered alerts that are returned if
+	// no page size is requested.
+	DefaultPageSize = 100
+
+	// MaxPageSize is the maximum number of triggered alerts that are returned
+	// at once.
+	MaxPageSize = 1000
+)
+
+var (
+	ErrUnauthenticated    = errors.New("no authenticated user")
+	ErrAlreadyExists      = errors.New("an alert rule with this ID already exists")
+	ErrAlertRuleNotFound  = errors.New("alert rule not found")
+	ErrMissingAlertRule   = errors.New("an alert rule requires an ID and a portfolio")
+	ErrInvalidAlertType   = errors.New("invalid alert rule type")
+	ErrMissingPercentage  = errors.New("a position drop requires a percentage between 0 and 1")
+	ErrMissingMaxQuoteAge = errors.New("a stale quote requires a positive maximum quote age")
+	ErrMissingThreshold   = errors.New("a portfolio value condition requires a threshold")
+	ErrUnknownChannel     = errors.New("unknown notification channel")
+	ErrThresholdCurrency  = errors.New("the currency of the threshold does not match the currency of the portfolio")
+	ErrInvalidDisplayName = errors.New("the display name of an alert rule must not contain line breaks")
+)
+
+// Options contains the options of the alert service and the [Evaluator].
+type Options struct {
+	// Queries is used to store alert rules and triggered alerts.
+	Queries *persistence.Queries
+
+	// Portfolios is used to check the access to portfolios and to take the
+	// snapshots that alert rules are evaluated against. It is called with the
+	// identity of the owner of a rule.
+	Portfolios portfoliov1connect.PortfolioServiceHandler
+}
+
+// service is the main struct for the [AlertService] implementation.
+type service struct {
+	q          *persistence.Queries
+	portfolios portfoliov1connect.PortfolioServiceHandler
+
+	portfoliov1connect.UnimplementedAlertServiceHandler
+}
+
+func NewService(opts Options) portfoliov1connect.AlertServiceHandler {
+	return &service{q: opts.Queries, portfolios: opts.Portfolios}
+}
+
+func (svc *service) CreateAlertRule(ctx context.Context, req *connect.Request[portfoliov1.CreateAlertRuleRequest]) (res *connect.Response[portfoliov1.AlertRule], err error) {
+	var (
+		r      = req.Msg.AlertRule
+		params persistence.CreateAlertRuleParams
+		dbr    *persistence.AlertRule
+	)
+
+	u, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = validateRule(r)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	// Only portfolios that the user can see can be watched
+	_, err = svc.portfolios.GetPortfolio(ctx, connect.NewRequest(&portfoliov1.GetPortfolioRequest{Id: r.PortfolioId}))
+	if err != nil {
+		return nil, err
+	}
+
+	params = persistence.CreateAlertRuleParams{
+		ID:          r.Id,
+		DisplayName: r.DisplayName,
+		Owner:       u.Subject,
+		Type:        int64(r.Type),
+		PortfolioID: r.PortfolioId,
+		SecurityID:  r.SecurityId,
+		Percentage:  r.Percentage,
+		MaxQuoteAge: int64(r.MaxQuoteAge.AsDuration() / time.Second),
+		Channels:    strings.Join(r.Channels, ","),
+	}
+	if r.Threshold != nil {
+		params.Threshold = sql.NullInt64{Int64: int64(r.Threshold.Value), Valid: true}
+		params.ThresholdCurrency = sql.NullString{String: r.Threshold.Symbol, Valid: true}
+	}
+
+	dbr, err = svc.q.CreateAlertRule(ctx, params)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeAlreadyExists, ErrAlreadyExists)
+	} else if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	r = ruleToProto(dbr)
+
+	audit.Record(ctx, audit.Event{
+		Action: portfoliov1.AuditAction_AUDIT_ACTION_CREATE,
+		After:  r,
+	})
+
+	return connect.NewResponse(r), nil
+}
+
+func (svc *service) ListAlertRules(ctx context.Context, req *connect.Request[portfoliov1.ListAlertRulesRequest]) (res *connect.Response[portfoliov1.ListAlertRulesResponse], err error) {
+	var list []*persistence.AlertRule
+
+	u, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	list, err = svc.q.ListAlertRulesByOwner(ctx, u.Subject)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	res = connect.NewResponse(&portfoliov1.ListAlertRulesResponse{
+		AlertRules: make([]*portfoliov1.AlertRule, 0, len(list)),
+	})
+
+	for _, dbr := range list {
+		res.Msg.AlertRules = append(res.Msg.AlertRules, ruleToProto(dbr))
+	}
+
+	return
+}
+
+func (svc *service) DeleteAlertRule(ctx context.Context, req *connect.Request[portfoliov1.DeleteAlertRuleRequest]) (res *connect.Response[emptypb.Empty], err error) {
+	var dbr *persistence.AlertRule
+
+	u, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Rules of other users are reported as not found
+	dbr, err = svc.q.GetAlertRule(ctx, persistence.GetAlertRuleParams{
+		ID:    req.Msg.Id,
+		Owner: u.Subject,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeNotFound, ErrAlertRuleNotFound)
+	} else if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	err = svc.q.DeleteTriggeredAlerts(ctx, dbr.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	_, err = svc.q.DeleteAlertRule(ctx, persistence.DeleteAlertRuleParams{
+		ID:    dbr.ID,
+		Owner: dbr.Owner,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	audit.Record(ctx, audit.Event{
+		Action: portfoliov1.AuditAction_AUDIT_ACTION_DELETE,
+		Before: ruleToProto(dbr),
+	})
+
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+func (svc *service) ListTriggeredAlerts(ctx context.Context, req *connect.Request[portfoliov1.ListTriggeredAlertsRequest]) (res *connect.Response[portfoliov1.ListTriggeredAlertsResponse], err error) {
+	var list []*persistence.TriggeredAlert
+
+	u, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	params := persistence.ListTriggeredAlertsParams{
+		Owner:    u.Subject,
+		RuleID:   sql.NullString{String: req.Msg.RuleId, Valid: req.Msg.RuleId != ""},
+		PageSize: DefaultPageSize,
+	}
+	if req.Msg.PageSize > 0 {
+		params.PageSize = int64(min(req.Msg.PageSize, MaxPageSize))
+	}
+
+	list, err = svc.q.ListTriggeredAlerts(ctx, params)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	res = connect.NewResponse(&portfoliov1.ListTriggeredAlertsResponse{
+		TriggeredAlerts: make([]*portfoliov1.TriggeredAlert, 0, len(list)),
+	})
+
+	for _, dba := range list {
+		res.Msg.TriggeredAlerts = append(res.Msg.TriggeredAlerts, alertToProto(dba))
+	}
+
+	return
+}
+
+// validateRule checks, whether the rule contains everything that its type
+// requires and only refers to known notification channels.
+func validateRule(r *portfoliov1.AlertRule) error {
+	if r.GetId() == "" || r.PortfolioId == "" {
+		return ErrMissingAlertRule
+	}
+
+	// The display name ends up in the subject of e-mails
+	if strings.ContainsAny(r.DisplayName, "\r\n") {
+		return ErrInvalidDisplayName
+	}
+
+	switch r.Type {
+	case portfoliov1.AlertRuleType_ALERT_RULE_TYPE_POSITION_DROP:
+		if r.Percentage <= 0 || r.Percentage > 1 {
+			return ErrMissingPercentage
+		}
+	case portfoliov1.AlertRuleType_ALERT_RULE_TYPE_STALE_QUOTE:
+		if r.MaxQuoteAge.AsDuration() <= 0 {
+			return ErrMissingMaxQuoteAge
+		}
+	case portfoliov1.AlertRuleType_ALERT_RULE_TYPE_PORTFOLIO_VALUE_ABOVE,
+		portfoliov1.AlertRuleType_ALERT_RULE_TYPE_PORTFOLIO_VALUE_BELOW:
+		if r.Threshold == nil {
+			return ErrMissingThreshold
+		}
+	default:
+		return ErrInvalidAlertType
+	}
+
+	for _, ch := range r.Channels {
+		if _, ok := notifier(ch); !ok {
+			return ErrUnknownChannel
+		}
+	}
+
+	return nil
+}
+
+// ruleToProto converts an alert rule of the database into its API
+// representation.
+func ruleToProto(dbr *persistence.AlertRule) (r *portfoliov1.AlertRule) {
+	r = &portfoliov1.AlertRule{
+		Id:          dbr.ID,
+		DisplayName: dbr.DisplayName,
+		Owner:       dbr.Owner,
+		Type:        portfoliov1.AlertRuleType(dbr.Type),
+		PortfolioId: dbr.PortfolioID,
+		SecurityId:  dbr.SecurityID,
+		Percentage:  dbr.Percentage,
+		Active:      dbr.Active,
+	}
+
+	if dbr.Threshold.Valid {
+		r.Threshold = &portfoliov1.Currency{
+			Value:  int32(dbr.Threshold.Int64),
+			Symbol: dbr.ThresholdCurrency.String,
+		}
+	}
+
+	if dbr.MaxQuoteAge > 0 {
+		r.MaxQuoteAge = durationpb.New(time.Duration(dbr.MaxQuoteAge) * time.Second)
+	}
+
+	if dbr.Channels != "" {
+		r.Channels = strings.Split(dbr.Channels, ",")
+	}
+
+	return
+}
+
+// alertToProto converts a triggered alert of the database into its API
+// representation.
+func alertToProto(dba *persistence.TriggeredAlert) *portfoliov1.TriggeredAlert {
+	return &portfoliov1.TriggeredAlert{
+		Id:      dba.ID,
+		RuleId:  dba.RuleID,
+		Time:    timestamppb.New(dba.Time),
+		Message: dba.Message,
+	}
+}
+
+// currentUser returns the authenticated user of the request.
+func currentUser(ctx context.Context) (u *auth.User, err error) {
+	u, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, ErrUnauthenticated)
+	}
+
+	return u, nil
+}
@@ -0,0 +1,304 @@
+// Copyright 2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package alerts
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/oxisto/money-gopher/auth"
+	portfoliov1 "github.com/oxisto/money-gopher/gen"
+	"github.com/oxisto/money-gopher/gen/portfoliov1connect"
+	"github.com/oxisto/money-gopher/internal"
+	"github.com/oxisto/money-gopher/persistence"
+
+	"connectrpc.com/connect"
+	"github.com/oxisto/assert"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// mockPortfolios only knows the portfolio "mybank-myportfolio" of our test
+// user, whose snapshot can be changed by the test.
+type mockPortfolios struct {
+	snapshot *portfoliov1.PortfolioSnapshot
+
+	portfoliov1connect.UnimplementedPortfolioServiceHandler
+}
+
+func (m *mockPortfolios) GetPortfolio(ctx context.Context, req *connect.Request[portfoliov1.GetPortfolioRequest]) (*connect.Response[portfoliov1.Portfolio], error) {
+	if err := m.access(ctx, req.Msg.Id); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&portfoliov1.Portfolio{Id: req.Msg.Id}), nil
+}
+
+func (m *mockPortfolios) GetPortfolioSnapshot(ctx context.Context, req *connect.Request[portfoliov1.GetPortfolioSnapshotRequest]) (*connect.Response[portfoliov1.PortfolioSnapshot], error) {
+	if err := m.access(ctx, req.Msg.PortfolioId); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(m.snapshot), nil
+}
+
+func (m *mockPortfolios) access(ctx context.Context, id string) error {
+	u, ok := auth.FromContext(ctx)
+	if !ok || u.Subject != internal.TestUser || id != "mybank-myportfolio" {
+		return connect.NewError(connect.CodeNotFound, nil)
+	}
+
+	return nil
+}
+
+// snapshotWith returns a snapshot of a single Apple position with the
+// specified gains, whose quote is from quoteTime.
+func snapshotWith(gains float64, quoteTime time.Time) *portfoliov1.PortfolioSnapshot {
+	return &portfoliov1.PortfolioSnapshot{
+		Positions: map[string]*portfoliov1.PortfolioPosition{
+			"US0378331005": {
+				Security: &portfoliov1.Security{
+					Id:          "US0378331005",
+					DisplayName: "Apple Inc.",
+					ListedOn: []*portfoliov1.ListedSecurity{
+						{SecurityId: "US0378331005", Ticker: "APC.F", LatestQuoteTimestamp: timestamppb.New(quoteTime)},
+					},
+				},
+				Gains: gains,
+			},
+		},
+		TotalPortfolioValue: portfoliov1.Value(int32(100000 * (1 + gains))),
+	}
+}
+
+func newTestService(t *testing.T) (svc portfoliov1connect.AlertServiceHandler, q *persistence.Queries, portfolios *mockPortfolios) {
+	q = persistence.New(internal.NewTestDB(t))
+	portfolios = &mockPortfolios{snapshot: snapshotWith(0, time.Now())}
+	svc = NewService(Options{Queries: q, Portfolios: portfolios})
+	return
+}
+
+func Test_service_CreateAlertRule(t *testing.T) {
+	RegisterNotifier("test", &recorder{})
+
+	svc, _, _ := newTestService(t)
+
+	type args struct {
+		ctx  context.Context
+		rule *portfoliov1.AlertRule
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantRes  assert.Want[*portfoliov1.AlertRule]
+		wantCode connect.Code
+	}{
+		{
+			name: "position drop",
+			args: args{
+				ctx: internal.WithTestUser(context.Background()),
+				rule: &portfoliov1.AlertRule{
+					Id:          "apple-drop",
+					DisplayName: "Apple drops",
+					Type:        portfoliov1.AlertRuleType_ALERT_RULE_TYPE_POSITION_DROP,
+					PortfolioId: "mybank-myportfolio",
+					SecurityId:  "US0378331005",
+					Percentage:  0.1,
+					Channels:    []string{"test"},
+				},
+			},
+			wantRes: func(t *testing.T, r *portfoliov1.AlertRule) bool {
+				return assert.Equals(t, &portfoliov1.AlertRule{
+					Id:          "apple-drop",
+					DisplayName: "Apple drops",
+					Owner:       internal.TestUser,
+					Type:        portfoliov1.AlertRuleType_ALERT_RULE_TYPE_POSITION_DROP,
+					PortfolioId: "mybank-myportfolio",
+					SecurityId:  "US0378331005",
+					Percentage:  0.1,
+					Channels:    []string{"test"},
+				}, r, protocmp.Transform())
+			},
+		},
+		{
+			name: "portfolio value",
+			args: args{
+				ctx: internal.WithTestUser(context.Background()),
+				rule: &portfoliov1.AlertRule{
+					Id:          "millionaire",
+					Type:        portfoliov1.AlertRuleType_ALERT_RULE_TYPE_PORTFOLIO_VALUE_ABOVE,
+					PortfolioId: "mybank-myportfolio",
+					Threshold:   portfoliov1.Value(100000000),
+				},
+			},
+			wantRes: func(t *testing.T, r *portfoliov1.AlertRule) bool {
+				return assert.Equals(t, portfoliov1.Value(100000000), r.Threshold, protocmp.Transform()) &&
+					assert.Equals(t, 0, len(r.Channels))
+			},
+		},
+		{
+			name: "stale quote",
+			args: args{
+				ctx: internal.WithTestUser(context.Background()),
+				rule: &portfoliov1.AlertRule{
+					Id:          "stale",
+					Type:        portfoliov1.AlertRuleType_ALERT_RULE_TYPE_STALE_QUOTE,
+					PortfolioId: "mybank-myportfolio",
+					MaxQuoteAge: durationpb.New(24 * time.Hour),
+				},
+			},
+			wantRes: func(t *testing.T, r *portfoliov1.AlertRule) bool {
+				return assert.Equals(t, 24*time.Hour, r.MaxQuoteAge.AsDuration())
+			},
+		},
+		{
+			name: "already exists",
+			args: args{
+				ctx: internal.WithTestUser(context.Background()),
+				rule: &portfoliov1.AlertRule{
+					Id:          "apple-drop",
+					Type:        portfoliov1.AlertRuleType_ALERT_RULE_TYPE_POSITION_DROP,
+					PortfolioId: "mybank-myportfolio",
+					Percentage:  0.2,
+				},
+			},
+			wantCode: connect.CodeAlreadyExists,
+		},
+		{
+			name: "missing percentage",
+			args: args{
+				ctx: internal.WithTestUser(context.Background()),
+				rule: &portfoliov1.AlertRule{
+					Id:          "drop",
+					Type:        portfoliov1.AlertRuleType_ALERT_RULE_TYPE_POSITION_DROP,
+					PortfolioId: "mybank-myportfolio",
+				},
+			},
+			wantCode: connect.CodeInvalidArgument,
+		},
+		{
+			name: "line break in display name",
+			args: args{
+				ctx: internal.WithTestUser(context.Background()),
+				rule: &portfoliov1.AlertRule{
+					Id:          "drop",
+					DisplayName: "Apple drops\r\nBcc: someone@example.com",
+					Type:        portfoliov1.AlertRuleType_ALERT_RULE_TYPE_POSITION_DROP,
+					PortfolioId: "mybank-myportfolio",
+					Percentage:  0.1,
+				},
+			},
+			wantCode: connect.CodeInvalidArgument,
+		},
+		{
+			name: "missing type",
+			args: args{
+				ctx: internal.WithTestUser(context.Background()),
+				rule: &portfoliov1.AlertRule{
+					Id:          "drop",
+					PortfolioId: "mybank-myportfolio",
+				},
+			},
+			wantCode: connect.CodeInvalidArgument,
+		},
+		{
+			name: "unknown channel",
+			args: args{
+				ctx: internal.WithTestUser(context.Background()),
+				rule: &portfoliov1.AlertRule{
+					Id:          "drop",
+					Type:        portfoliov1.AlertRuleType_ALERT_RULE_TYPE_POSITION_DROP,
+					PortfolioId: "mybank-myportfolio",
+					Percentage:  0.1,
+					Channels:    []string{"pigeon"},
+				},
+			},
+			wantCode: connect.CodeInvalidArgument,
+		},
+		{
+			name: "portfolio of another user",
+			args: args{
+				ctx: auth.NewContext(context.Background(), &auth.User{Subject: "gopher", Roles: []auth.Role{auth.RoleEditor}}),
+				rule: &portfoliov1.AlertRule{
+					Id:          "drop",
+					Type:        portfoliov1.AlertRuleType_ALERT_RULE_TYPE_POSITION_DROP,
+					PortfolioId: "mybank-myportfolio",
+					Percentage:  0.1,
+				},
+			},
+			wantCode: connect.CodeNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := svc.CreateAlertRule(tt.args.ctx, connect.NewRequest(&portfoliov1.CreateAlertRuleRequest{
+				AlertRule: tt.args.rule,
+			}))
+			if tt.wantCode != 0 {
+				assert.Equals(t, tt.wantCode, connect.CodeOf(err))
+				return
+			}
+
+			assert.NoError(t, err)
+			tt.wantRes(t, res.Msg)
+		})
+	}
+}
+
+func Test_service_DeleteAlertRule(t *testing.T) {
+	svc, q, _ := newTestService(t)
+	ctx := internal.WithTestUser(context.Background())
+
+	_, err := svc.CreateAlertRule(ctx, connect.NewRequest(&portfoliov1.CreateAlertRuleRequest{
+		AlertRule: &portfoliov1.AlertRule{
+			Id:          "apple-drop",
+			Type:        portfoliov1.AlertRuleType_ALERT_RULE_TYPE_POSITION_DROP,
+			PortfolioId: "mybank-myportfolio",
+			Percentage:  0.1,
+		},
+	}))
+	assert.NoError(t, err)
+
+	_, err = q.CreateTriggeredAlert(ctx, persistence.CreateTriggeredAlertParams{
+		RuleID:  "apple-drop",
+		Owner:   internal.TestUser,
+		Time:    time.Now(),
+		Message: "Apple Inc. has lost 10.00 % of its purchase value",
+	})
+	assert.NoError(t, err)
+
+	// Rules of other users cannot be deleted
+	_, err = svc.DeleteAlertRule(
+		auth.NewContext(context.Background(), &auth.User{Subject: "gopher", Roles: []auth.Role{auth.RoleEditor}}),
+		connect.NewRequest(&portfoliov1.DeleteAlertRuleRequest{Id: "apple-drop"}),
+	)
+	assert.Equals(t, connect.CodeNotFound, connect.CodeOf(err))
+
+	_, err = svc.DeleteAlertRule(ctx, connect.NewRequest(&portfoliov1.DeleteAlertRuleRequest{Id: "apple-drop"}))
+	assert.NoError(t, err)
+
+	rules, err := svc.ListAlertRules(ctx, connect.NewRequest(&portfoliov1.ListAlertRulesRequest{}))
+	assert.NoError(t, err)
+	assert.Equals(t, 0, len(rules.Msg.AlertRules))
+
+	// The triggered alerts of the rule are deleted as well
+	alerts, err := svc.ListTriggeredAlerts(ctx, connect.NewRequest(&portfoliov1.ListTriggeredAlertsRequest{}))
+	assert.NoError(t, err)
+	assert.Equals(t, 0, len(alerts.Msg.TriggeredAlerts))
+}
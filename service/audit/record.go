@@ -0,0 +1,230 @@
+// Copyright 2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package audit
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/oxisto/money-gopher/auth"
+	portfoliov1 "github.com/oxisto/money-gopher/gen"
+	"github.com/oxisto/money-gopher/persistence"
+
+	"connectrpc.com/connect"
+	"github.com/lmittmann/tint"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// Event is a change of a resource that is recorded in the audit log.
+type Event struct {
+	Action portfoliov1.AuditAction
+
+	// ResourceType defaults to the message name of Before or After.
+	ResourceType string
+
+	// ResourceID defaults to the "id" field of Before or After.
+	ResourceID string
+
+	// Paths contains the updated fields of an update.
+	Paths []string
+
+	Before proto.Message
+	After  proto.Message
+}
+
+// Recorder writes audit events to the database.
+type Recorder struct {
+	q *persistence.Queries
+}
+
+// NewRecorder creates a new recorder that writes to q.
+func NewRecorder(q *persistence.Queries) *Recorder {
+	return &Recorder{q: q}
+}
+
+// scope is the context of a request in which changes are recorded.
+type scope struct {
+	r         *Recorder
+	procedure string
+}
+
+type scopeKeyType struct{}
+
+// scopeKey is the key for the scope in the context.
+var scopeKey scopeKeyType
+
+// NewContext returns a new context, in which changes made by procedure are
+// recorded by r.
+func NewContext(ctx context.Context, r *Recorder, procedure string) context.Context {
+	return context.WithValue(ctx, scopeKey, &scope{r: r, procedure: procedure})
+}
+
+// Enabled returns whether changes are recorded in ctx. It can be used to skip
+// fetching the state of a resource before a change.
+func Enabled(ctx context.Context) bool {
+	_, ok := ctx.Value(scopeKey).(*scope)
+	return ok
+}
+
+// NewInterceptor returns an interceptor that records the changes made by all
+// RPCs with r.
+func NewInterceptor(r *Recorder) connect.Interceptor {
+	return &interceptor{r: r}
+}
+
+// interceptor records the changes of unary and streaming RPCs, e.g., uploads.
+type interceptor struct {
+	r *Recorder
+}
+
+func (i *interceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		return next(NewContext(ctx, i.r, req.Spec().Procedure), req)
+	}
+}
+
+func (*interceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i *interceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		return next(NewContext(ctx, i.r, conn.Spec().Procedure), conn)
+	}
+}
+
+// Record records e together with the authenticated user of ctx. Nothing is
+// recorded if ctx was not created by [NewContext]. Since the change itself
+// already happened, errors are only logged.
+func Record(ctx context.Context, e Event) {
+	var (
+		s       *scope
+		ok      bool
+		u       *auth.User
+		subject string
+		err     error
+	)
+
+	s, ok = ctx.Value(scopeKey).(*scope)
+	if !ok {
+		return
+	}
+
+	if u, ok = auth.FromContext(ctx); ok {
+		subject = u.Subject
+	}
+
+	for _, m := range []proto.Message{e.After, e.Before} {
+		// The type is also known for typed nil pointers, e.g., if the
+		// previous state of a deleted resource could not be retrieved
+		if e.ResourceType == "" && m != nil {
+			e.ResourceType = string(m.ProtoReflect().Descriptor().Name())
+		}
+
+		if e.ResourceID == "" && valid(m) {
+			e.ResourceID = id(m)
+		}
+	}
+
+	params := persistence.CreateAuditEventParams{
+		Time:         time.Now().UTC(),
+		Subject:      subject,
+		Procedure:    s.procedure,
+		Action:       actionName(e.Action),
+		ResourceType: e.ResourceType,
+		ResourceID:   e.ResourceID,
+		UpdateMask:   strings.Join(e.Paths, ","),
+	}
+
+	params.Before, err = marshal(e.Before)
+	if err == nil {
+		params.After, err = marshal(e.After)
+	}
+
+	// The change should be recorded, even if the request is canceled in the
+	// meantime
+	if err == nil {
+		_, err = s.r.q.CreateAuditEvent(context.WithoutCancel(ctx), params)
+	}
+
+	if err != nil {
+		slog.Error("Could not record audit event", tint.Err(err),
+			"procedure", s.procedure,
+			"resource", e.ResourceID)
+	}
+}
+
+// Key converts a (composite) key of [persistence.StorageOperations] into a
+// resource ID. The parts of a composite key are separated by a slash.
+func Key(key any) string {
+	keys, ok := key.([]any)
+	if !ok {
+		return fmt.Sprint(key)
+	}
+
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		parts = append(parts, fmt.Sprint(k))
+	}
+
+	return strings.Join(parts, "/")
+}
+
+// valid checks, whether m is set. This also excludes typed nil pointers.
+func valid(m proto.Message) bool {
+	return m != nil && m.ProtoReflect().IsValid()
+}
+
+// id returns the value of the "id" field of m, if it has one.
+func id(m proto.Message) string {
+	fd := m.ProtoReflect().Descriptor().Fields().ByName("id")
+	if fd == nil {
+		return ""
+	}
+
+	return m.ProtoReflect().Get(fd).String()
+}
+
+// marshal converts m into its JSON representation.
+func marshal(m proto.Message) (s sql.NullString, err error) {
+	if !valid(m) {
+		return
+	}
+
+	b, err := protojson.Marshal(m)
+	if err != nil {
+		return s, fmt.Errorf("could not marshal %T: %w", m, err)
+	}
+
+	return sql.NullString{String: string(b), Valid: true}, nil
+}
+
+// actionName returns the name of the action, as it is stored in the database,
+// e.g., "create".
+func actionName(a portfoliov1.AuditAction) string {
+	return strings.ToLower(strings.TrimPrefix(a.String(), "AUDIT_ACTION_"))
+}
+
+// parseAction is the inverse of [actionName].
+func parseAction(s string) portfoliov1.AuditAction {
+	return portfoliov1.AuditAction(portfoliov1.AuditAction_value["AUDIT_ACTION_"+strings.ToUpper(s)])
+}
@@ -0,0 +1,120 @@
+// Copyright 2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	portfoliov1 "github.com/oxisto/money-gopher/gen"
+	"github.com/oxisto/money-gopher/internal"
+	"github.com/oxisto/money-gopher/persistence"
+
+	"github.com/oxisto/assert"
+)
+
+func TestRecord(t *testing.T) {
+	type args struct {
+		ctx func(r *Recorder) context.Context
+		e   Event
+	}
+	tests := []struct {
+		name string
+		args args
+		want assert.Want[[]*persistence.AuditEvent]
+	}{
+		{
+			name: "create",
+			args: args{
+				ctx: func(r *Recorder) context.Context {
+					return NewContext(internal.WithTestUser(context.Background()), r, "/test/Create")
+				},
+				e: Event{
+					Action: portfoliov1.AuditAction_AUDIT_ACTION_CREATE,
+					After:  &portfoliov1.Portfolio{Id: "mybank-myportfolio", DisplayName: "My Portfolio"},
+				},
+			},
+			want: func(t *testing.T, list []*persistence.AuditEvent) bool {
+				return assert.Equals(t, 1, len(list)) &&
+					assert.Equals(t, internal.TestUser, list[0].Subject) &&
+					assert.Equals(t, "/test/Create", list[0].Procedure) &&
+					assert.Equals(t, "create", list[0].Action) &&
+					assert.Equals(t, "Portfolio", list[0].ResourceType) &&
+					assert.Equals(t, "mybank-myportfolio", list[0].ResourceID) &&
+					assert.Equals(t, false, list[0].Before.Valid) &&
+					assert.Equals(t, `{"id":"mybank-myportfolio","displayName":"My Portfolio"}`, compact(list[0].After.String))
+			},
+		},
+		{
+			name: "delete without previous state",
+			args: args{
+				ctx: func(r *Recorder) context.Context {
+					return NewContext(internal.WithTestUser(context.Background()), r, "/test/Delete")
+				},
+				e: Event{
+					Action:     portfoliov1.AuditAction_AUDIT_ACTION_DELETE,
+					ResourceID: Key([]any{"mybank-myportfolio", "gopher"}),
+					Before:     (*portfoliov1.PortfolioShare)(nil),
+				},
+			},
+			want: func(t *testing.T, list []*persistence.AuditEvent) bool {
+				return assert.Equals(t, 1, len(list)) &&
+					assert.Equals(t, "PortfolioShare", list[0].ResourceType) &&
+					assert.Equals(t, "mybank-myportfolio/gopher", list[0].ResourceID) &&
+					assert.Equals(t, false, list[0].Before.Valid)
+			},
+		},
+		{
+			name: "not enabled",
+			args: args{
+				ctx: func(r *Recorder) context.Context {
+					return internal.WithTestUser(context.Background())
+				},
+				e: Event{
+					Action: portfoliov1.AuditAction_AUDIT_ACTION_CREATE,
+					After:  &portfoliov1.Portfolio{Id: "mybank-myportfolio"},
+				},
+			},
+			want: func(t *testing.T, list []*persistence.AuditEvent) bool {
+				return assert.Equals(t, 0, len(list))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := persistence.New(internal.NewTestDB(t))
+
+			Record(tt.args.ctx(NewRecorder(q)), tt.args.e)
+
+			list, err := q.ListAuditEvents(context.Background(), persistence.ListAuditEventsParams{PageSize: 10})
+			assert.NoError(t, err)
+			tt.want(t, list)
+		})
+	}
+}
+
+// compact removes the (randomized) whitespace of protojson.
+func compact(s string) string {
+	var b bytes.Buffer
+	if err := json.Compact(&b, []byte(s)); err != nil {
+		return s
+	}
+
+	return b.String()
+}
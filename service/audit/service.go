@@ -0,0 +1,182 @@
+// Copyright 2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+// package audit contains the audit log, which records all changes made by
+// users, and the code for the AuditService implementation.
+package audit
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	portfoliov1 "github.com/oxisto/money-gopher/gen"
+	"github.com/oxisto/money-gopher/gen/portfoliov1connect"
+	"github.com/oxisto/money-gopher/persistence"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// DefaultPageSize is the number of events that are returned if no page
+	// size is requested.
+	DefaultPageSize = 100
+
+	// MaxPageSize is the maximum number of events that are returned at once.
+	MaxPageSize = 1000
+)
+
+// service is the main struct for the [AuditService] implementation.
+type service struct {
+	q *persistence.Queries
+
+	portfoliov1connect.UnimplementedAuditServiceHandler
+}
+
+func NewService(q *persistence.Queries) portfoliov1connect.AuditServiceHandler {
+	return &service{q: q}
+}
+
+func (svc *service) ListAuditEvents(ctx context.Context, req *connect.Request[portfoliov1.ListAuditEventsRequest]) (res *connect.Response[portfoliov1.ListAuditEventsResponse], err error) {
+	var (
+		f      = req.Msg.Filter
+		params = persistence.ListAuditEventsParams{PageSize: DefaultPageSize}
+		list   []*persistence.AuditEvent
+		e      *portfoliov1.AuditEvent
+	)
+
+	if req.Msg.PageSize > 0 {
+		params.PageSize = int64(min(req.Msg.PageSize, MaxPageSize))
+	}
+
+	if f != nil {
+		params.Subject = nullString(f.Subject)
+		params.ResourceType = nullString(f.ResourceType)
+		params.ResourceID = nullString(f.ResourceId)
+
+		if f.Action != portfoliov1.AuditAction_AUDIT_ACTION_UNSPECIFIED {
+			params.Action = nullString(actionName(f.Action))
+		}
+
+		if f.StartTime != nil {
+			params.StartTime = sql.NullTime{Time: f.StartTime.AsTime(), Valid: true}
+		}
+
+		if f.EndTime != nil {
+			params.EndTime = sql.NullTime{Time: f.EndTime.AsTime(), Valid: true}
+		}
+	}
+
+	list, err = svc.q.ListAuditEvents(ctx, params)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	res = connect.NewResponse(&portfoliov1.ListAuditEventsResponse{
+		AuditEvents: make([]*portfoliov1.AuditEvent, 0, len(list)),
+	})
+
+	for _, dbe := range list {
+		e, err = ToProto(dbe)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+
+		res.Msg.AuditEvents = append(res.Msg.AuditEvents, e)
+	}
+
+	return
+}
+
+// ToProto converts an audit event of the database into its API
+// representation.
+func ToProto(dbe *persistence.AuditEvent) (e *portfoliov1.AuditEvent, err error) {
+	e = &portfoliov1.AuditEvent{
+		Id:           dbe.ID,
+		Time:         timestamppb.New(dbe.Time),
+		Subject:      dbe.Subject,
+		Procedure:    dbe.Procedure,
+		Action:       parseAction(dbe.Action),
+		ResourceType: dbe.ResourceType,
+		ResourceId:   dbe.ResourceID,
+	}
+
+	if dbe.UpdateMask != "" {
+		e.UpdateMask = &fieldmaskpb.FieldMask{Paths: strings.Split(dbe.UpdateMask, ",")}
+	}
+
+	e.Before, err = unmarshal(dbe.Before)
+	if err != nil {
+		return nil, err
+	}
+
+	e.After, err = unmarshal(dbe.After)
+	if err != nil {
+		return nil, err
+	}
+
+	return
+}
+
+// FromProto is the inverse of [ToProto].
+func FromProto(e *portfoliov1.AuditEvent) (dbe *persistence.AuditEvent, err error) {
+	dbe = &persistence.AuditEvent{
+		ID:           e.Id,
+		Time:         e.Time.AsTime(),
+		Subject:      e.Subject,
+		Procedure:    e.Procedure,
+		Action:       actionName(e.Action),
+		ResourceType: e.ResourceType,
+		ResourceID:   e.ResourceId,
+		UpdateMask:   strings.Join(e.UpdateMask.GetPaths(), ","),
+	}
+
+	dbe.Before, err = marshal(e.Before)
+	if err != nil {
+		return nil, err
+	}
+
+	dbe.After, err = marshal(e.After)
+	if err != nil {
+		return nil, err
+	}
+
+	return
+}
+
+// unmarshal converts the JSON representation of a resource into a struct.
+func unmarshal(s sql.NullString) (st *structpb.Struct, err error) {
+	if !s.Valid {
+		return nil, nil
+	}
+
+	st = new(structpb.Struct)
+	err = protojson.Unmarshal([]byte(s.String), st)
+	if err != nil {
+		return nil, err
+	}
+
+	return st, nil
+}
+
+// nullString returns a NULL string, if s is empty.
+func nullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
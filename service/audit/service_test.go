@@ -0,0 +1,140 @@
+// Copyright 2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package audit
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	portfoliov1 "github.com/oxisto/money-gopher/gen"
+	"github.com/oxisto/money-gopher/internal"
+	"github.com/oxisto/money-gopher/persistence"
+
+	"connectrpc.com/connect"
+	"github.com/oxisto/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func Test_service_ListAuditEvents(t *testing.T) {
+	var (
+		q   = persistence.New(internal.NewTestDB(t))
+		now = time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	)
+
+	for _, p := range []persistence.CreateAuditEventParams{
+		{
+			Time:         now.Add(-48 * time.Hour),
+			Subject:      "money",
+			Procedure:    "/mgo.portfolio.v1.PortfolioService/CreatePortfolioTransaction",
+			Action:       "create",
+			ResourceType: "PortfolioEvent",
+			ResourceID:   "buy",
+			After:        sql.NullString{String: `{"id":"buy","amount":10}`, Valid: true},
+		},
+		{
+			Time:         now,
+			Subject:      "gopher",
+			Procedure:    "/mgo.portfolio.v1.PortfolioService/UpdatePortfolioTransaction",
+			Action:       "update",
+			ResourceType: "PortfolioEvent",
+			ResourceID:   "buy",
+			UpdateMask:   "amount",
+			Before:       sql.NullString{String: `{"id":"buy","amount":10}`, Valid: true},
+			After:        sql.NullString{String: `{"id":"buy","amount":20}`, Valid: true},
+		},
+	} {
+		_, err := q.CreateAuditEvent(context.Background(), p)
+		assert.NoError(t, err)
+	}
+
+	tests := []struct {
+		name    string
+		req     *portfoliov1.ListAuditEventsRequest
+		wantRes assert.Want[*portfoliov1.ListAuditEventsResponse]
+	}{
+		{
+			name: "all events",
+			req:  &portfoliov1.ListAuditEventsRequest{},
+			wantRes: func(t *testing.T, res *portfoliov1.ListAuditEventsResponse) bool {
+				e := res.AuditEvents[0]
+
+				// The most recent event comes first
+				return assert.Equals(t, 2, len(res.AuditEvents)) &&
+					assert.Equals(t, "gopher", e.Subject) &&
+					assert.Equals(t, portfoliov1.AuditAction_AUDIT_ACTION_UPDATE, e.Action) &&
+					assert.Equals(t, []string{"amount"}, e.UpdateMask.GetPaths()) &&
+					assert.Equals(t, 10.0, e.Before.Fields["amount"].GetNumberValue()) &&
+					assert.Equals(t, 20.0, e.After.Fields["amount"].GetNumberValue())
+			},
+		},
+		{
+			name: "filter by subject",
+			req: &portfoliov1.ListAuditEventsRequest{
+				Filter: &portfoliov1.ListAuditEventsRequest_Filter{Subject: "money"},
+			},
+			wantRes: func(t *testing.T, res *portfoliov1.ListAuditEventsResponse) bool {
+				return assert.Equals(t, 1, len(res.AuditEvents)) &&
+					assert.Equals(t, portfoliov1.AuditAction_AUDIT_ACTION_CREATE, res.AuditEvents[0].Action) &&
+					assert.Equals(t, true, res.AuditEvents[0].Before == nil)
+			},
+		},
+		{
+			name: "filter by resource and action",
+			req: &portfoliov1.ListAuditEventsRequest{
+				Filter: &portfoliov1.ListAuditEventsRequest_Filter{
+					ResourceType: "PortfolioEvent",
+					ResourceId:   "buy",
+					Action:       portfoliov1.AuditAction_AUDIT_ACTION_UPDATE,
+				},
+			},
+			wantRes: func(t *testing.T, res *portfoliov1.ListAuditEventsResponse) bool {
+				return assert.Equals(t, 1, len(res.AuditEvents)) &&
+					assert.Equals(t, "gopher", res.AuditEvents[0].Subject)
+			},
+		},
+		{
+			name: "filter by time",
+			req: &portfoliov1.ListAuditEventsRequest{
+				Filter: &portfoliov1.ListAuditEventsRequest_Filter{
+					StartTime: timestamppb.New(now.Add(-72 * time.Hour)),
+					EndTime:   timestamppb.New(now.Add(-24 * time.Hour)),
+				},
+			},
+			wantRes: func(t *testing.T, res *portfoliov1.ListAuditEventsResponse) bool {
+				return assert.Equals(t, 1, len(res.AuditEvents)) &&
+					assert.Equals(t, "money", res.AuditEvents[0].Subject)
+			},
+		},
+		{
+			name: "page size",
+			req:  &portfoliov1.ListAuditEventsRequest{PageSize: 1},
+			wantRes: func(t *testing.T, res *portfoliov1.ListAuditEventsResponse) bool {
+				return assert.Equals(t, 1, len(res.AuditEvents))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := NewService(q)
+			res, err := svc.ListAuditEvents(context.Background(), connect.NewRequest(tt.req))
+			assert.NoError(t, err)
+			tt.wantRes(t, res.Msg)
+		})
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright 2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+// package events contains a simple in-process broker that notifies
+// subscribers about changes, e.g., to stream updates to clients.
+package events
+
+import (
+	"log/slog"
+	"sync"
+)
+
+// bufferSize is the number of events that a subscriber can lag behind before
+// events are dropped.
+const bufferSize = 16
+
+// Event notifies subscribers that entities have changed.
+type Event struct {
+	// PortfolioID is set if the portfolio or its events have changed.
+	PortfolioID string
+
+	// SecurityIDs is set if the quotes of these securities have changed.
+	SecurityIDs []string
+}
+
+// Broker distributes published events to all subscribers. A nil broker
+// discards all events.
+type Broker struct {
+	mu     sync.Mutex
+	subs   map[chan Event]struct{}
+	closed bool
+}
+
+// NewBroker creates a new [Broker].
+func NewBroker() *Broker {
+	return &Broker{
+		subs: make(map[chan Event]struct{}),
+	}
+}
+
+// Publish publishes the event to all subscribers. It never blocks; if a
+// subscriber does not keep up, the event is dropped for this subscriber.
+func (b *Broker) Publish(e Event) {
+	if b == nil {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for ch := range b.subs {
+		select {
+		case ch <- e:
+		default:
+			slog.Warn("Dropping event for slow subscriber", "event", e)
+		}
+	}
+}
+
+// Subscribe subscribes to all events that are published after this call. The
+// returned cancel function needs to be called once the subscriber is not
+// interested in events anymore; it closes the channel.
+func (b *Broker) Subscribe() (events <-chan Event, cancel func()) {
+	ch := make(chan Event, bufferSize)
+
+	if b == nil {
+		return ch, func() {}
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	// Subscribers of a closed broker do not receive any events
+	if b.closed {
+		close(ch)
+		return ch, func() {}
+	}
+
+	b.subs[ch] = struct{}{}
+
+	return ch, func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+
+		// The channel might already be closed by Close
+		if _, ok := b.subs[ch]; ok {
+			delete(b.subs, ch)
+			close(ch)
+		}
+	}
+}
+
+// Close closes the channels of all subscribers, e.g., to end all streams on
+// shutdown. Later subscribers receive a closed channel.
+func (b *Broker) Close() {
+	if b == nil {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for ch := range b.subs {
+		delete(b.subs, ch)
+		close(ch)
+	}
+
+	b.closed = true
+}
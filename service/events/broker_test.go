@@ -0,0 +1,80 @@
+// Copyright 2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package events
+
+import (
+	"testing"
+
+	"github.com/oxisto/assert"
+)
+
+func TestBroker(t *testing.T) {
+	var b = NewBroker()
+
+	ch1, cancel1 := b.Subscribe()
+	ch2, cancel2 := b.Subscribe()
+	defer cancel2()
+
+	b.Publish(Event{PortfolioID: "mybank-myportfolio"})
+	assert.Equals(t, Event{PortfolioID: "mybank-myportfolio"}, <-ch1)
+	assert.Equals(t, Event{PortfolioID: "mybank-myportfolio"}, <-ch2)
+
+	// Cancelled subscribers do not receive events anymore
+	cancel1()
+	cancel1()
+	b.Publish(Event{SecurityIDs: []string{"US0378331005"}})
+
+	_, ok := <-ch1
+	assert.Equals(t, false, ok)
+	assert.Equals(t, Event{SecurityIDs: []string{"US0378331005"}}, <-ch2)
+
+	// Slow subscribers do not block the publisher
+	for i := 0; i < bufferSize+1; i++ {
+		b.Publish(Event{PortfolioID: "mybank-myportfolio"})
+	}
+	assert.Equals(t, bufferSize, len(ch2))
+}
+
+func TestBroker_nil(t *testing.T) {
+	var b *Broker
+
+	ch, cancel := b.Subscribe()
+	defer cancel()
+
+	b.Publish(Event{PortfolioID: "mybank-myportfolio"})
+	assert.Equals(t, 0, len(ch))
+}
+
+func TestBroker_Close(t *testing.T) {
+	var b = NewBroker()
+
+	ch1, cancel1 := b.Subscribe()
+
+	// Closing the broker ends all subscriptions
+	b.Close()
+	_, ok := <-ch1
+	assert.Equals(t, false, ok)
+
+	// Cancelling afterwards is still safe
+	cancel1()
+
+	ch2, cancel2 := b.Subscribe()
+	defer cancel2()
+
+	_, ok = <-ch2
+	assert.Equals(t, false, ok)
+}
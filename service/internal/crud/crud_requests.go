@@ -20,17 +20,22 @@
 package crud
 
 import (
+	"context"
 	"fmt"
 	"log/slog"
 	"strings"
 
+	portfoliov1 "github.com/oxisto/money-gopher/gen"
 	"github.com/oxisto/money-gopher/persistence"
+	"github.com/oxisto/money-gopher/service/audit"
+	"github.com/oxisto/money-gopher/service/internal/pagination"
 
 	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
-func Create[T any, S persistence.StorageObject](obj S, op persistence.StorageOperations[S], convert func(obj S) *T) (res *connect.Response[T], err error) {
+func Create[T any, S persistence.StorageObject](ctx context.Context, obj S, op persistence.StorageOperations[S], convert func(obj S) *T) (res *connect.Response[T], err error) {
 	var typ = fmt.Sprintf("%T", obj)
 
 	_, typ, _ = strings.Cut(typ, ".")
@@ -42,18 +47,23 @@ func Create[T any, S persistence.StorageObject](obj S, op persistence.StorageOpe
 	)
 
 	// TODO(oxisto): We probably want to have a pure create instead of replace here
-	err = op.Replace(obj)
+	err = op.Replace(ctx, obj)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
+	audit.Record(ctx, audit.Event{
+		Action: portfoliov1.AuditAction_AUDIT_ACTION_CREATE,
+		After:  message(obj),
+	})
+
 	res = connect.NewResponse(convert(obj))
 
 	return
 }
 
-func List[T any, S persistence.StorageObject](op persistence.StorageOperations[S], setter func(res *connect.Response[T], list []S) error, args ...any) (res *connect.Response[T], err error) {
-	obj, err := op.List(args...)
+func List[T any, S persistence.StorageObject](ctx context.Context, op persistence.StorageOperations[S], setter func(res *connect.Response[T], list []S) error, args ...any) (res *connect.Response[T], err error) {
+	obj, err := op.List(ctx, args...)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
@@ -64,8 +74,24 @@ func List[T any, S persistence.StorageObject](op persistence.StorageOperations[S
 	return
 }
 
-func Get[T any, S persistence.StorageObject](key any, op persistence.StorageOperations[S], convert func(obj S) *T) (res *connect.Response[T], err error) {
-	obj, err := op.Get(key)
+// ListWithOptions lists a page of objects, which are filtered and sorted
+// according to opts. The setter receives the token of the next page, if there
+// is one.
+func ListWithOptions[T any, S persistence.StorageObject](ctx context.Context, op persistence.StorageOperations[S], opts persistence.ListOptions, setter func(res *connect.Response[T], list []S, nextPageToken string) error, args ...any) (res *connect.Response[T], err error) {
+	obj, err := op.ListWithOptions(ctx, opts, args...)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	res = connect.NewResponse(new(T))
+	obj, next := pagination.Page(opts, obj)
+	err = setter(res, obj, next)
+
+	return
+}
+
+func Get[T any, S persistence.StorageObject](ctx context.Context, key any, op persistence.StorageOperations[S], convert func(obj S) *T) (res *connect.Response[T], err error) {
+	obj, err := op.Get(ctx, key)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
@@ -75,24 +101,58 @@ func Get[T any, S persistence.StorageObject](key any, op persistence.StorageOper
 	return
 }
 
-func Update[T any, S persistence.StorageObject](key any, in S, paths []string, op persistence.StorageOperations[S], convert func(obj S) *T) (res *connect.Response[T], err error) {
-	out, err := op.Update(key, in, paths)
+func Update[T any, S persistence.StorageObject](ctx context.Context, key any, in S, paths []string, op persistence.StorageOperations[S], convert func(obj S) *T) (res *connect.Response[T], err error) {
+	var before S
+
+	// The previous state is only needed for the audit log
+	if audit.Enabled(ctx) {
+		before, _ = op.Get(ctx, key)
+	}
+
+	out, err := op.Update(ctx, key, in, paths)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
+	audit.Record(ctx, audit.Event{
+		Action:     portfoliov1.AuditAction_AUDIT_ACTION_UPDATE,
+		ResourceID: audit.Key(key),
+		Paths:      paths,
+		Before:     message(before),
+		After:      message(out),
+	})
+
 	res = connect.NewResponse(convert(out))
 
 	return
 }
 
-func Delete[S persistence.StorageObject](key any, op persistence.StorageOperations[S]) (res *connect.Response[emptypb.Empty], err error) {
-	err = op.Delete(key)
+func Delete[S persistence.StorageObject](ctx context.Context, key any, op persistence.StorageOperations[S]) (res *connect.Response[emptypb.Empty], err error) {
+	var before S
+
+	// The previous state is only needed for the audit log
+	if audit.Enabled(ctx) {
+		before, _ = op.Get(ctx, key)
+	}
+
+	err = op.Delete(ctx, key)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
+	audit.Record(ctx, audit.Event{
+		Action:     portfoliov1.AuditAction_AUDIT_ACTION_DELETE,
+		ResourceID: audit.Key(key),
+		Before:     message(before),
+	})
+
 	res = connect.NewResponse(&emptypb.Empty{})
 
 	return
 }
+
+// message returns obj as a protobuf message for the audit log, if it is one.
+func message[S persistence.StorageObject](obj S) proto.Message {
+	m, _ := any(obj).(proto.Message)
+	return m
+}
@@ -17,13 +17,16 @@
 package crud
 
 import (
+	"context"
 	"errors"
 	"reflect"
+	"strings"
 	"testing"
 
 	portfoliov1 "github.com/oxisto/money-gopher/gen"
 	"github.com/oxisto/money-gopher/internal"
 	"github.com/oxisto/money-gopher/persistence"
+	"github.com/oxisto/money-gopher/service/audit"
 
 	"connectrpc.com/connect"
 	"github.com/oxisto/assert"
@@ -55,7 +58,7 @@ func TestCreate(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			gotRes, err := Create(tt.args.obj, tt.args.op, tt.args.convert)
+			gotRes, err := Create(context.Background(), tt.args.obj, tt.args.op, tt.args.convert)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Create() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -94,7 +97,7 @@ func TestList(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			gotRes, err := List(tt.args.op, tt.args.setter, tt.args.args...)
+			gotRes, err := List(context.Background(), tt.args.op, tt.args.setter, tt.args.args...)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("List() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -132,7 +135,7 @@ func TestGet(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			gotRes, err := Get(tt.args.key, tt.args.op, tt.args.convert)
+			gotRes, err := Get(context.Background(), tt.args.key, tt.args.op, tt.args.convert)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Get() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -172,7 +175,7 @@ func TestUpdate(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			gotRes, err := Update(tt.args.key, tt.args.in, tt.args.paths, tt.args.op, tt.args.convert)
+			gotRes, err := Update(context.Background(), tt.args.key, tt.args.in, tt.args.paths, tt.args.op, tt.args.convert)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Update() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -206,7 +209,7 @@ func TestDelete(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			gotRes, err := Delete(tt.args.key, tt.args.op)
+			gotRes, err := Delete(context.Background(), tt.args.key, tt.args.op)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Delete() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -217,3 +220,27 @@ func TestDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdate_audit(t *testing.T) {
+	var (
+		db  = internal.NewTestDB(t)
+		q   = persistence.New(db)
+		ops = persistence.Ops[*portfoliov1.Portfolio](db)
+		ctx = audit.NewContext(internal.WithTestUser(context.Background()), audit.NewRecorder(q), "/test/Update")
+	)
+
+	assert.NoError(t, ops.Replace(ctx, &portfoliov1.Portfolio{Id: "mybank-myportfolio", DisplayName: "Old"}))
+
+	_, err := Update(ctx, "mybank-myportfolio", &portfoliov1.Portfolio{DisplayName: "New"}, []string{"display_name"}, ops,
+		func(obj *portfoliov1.Portfolio) *portfoliov1.Portfolio { return obj })
+	assert.NoError(t, err)
+
+	list, err := q.ListAuditEvents(context.Background(), persistence.ListAuditEventsParams{PageSize: 10})
+	assert.NoError(t, err)
+	assert.Equals(t, 1, len(list))
+	assert.Equals(t, "update", list[0].Action)
+	assert.Equals(t, "mybank-myportfolio", list[0].ResourceID)
+	assert.Equals(t, "display_name", list[0].UpdateMask)
+	assert.Equals(t, true, strings.Contains(list[0].Before.String, "Old"))
+	assert.Equals(t, true, strings.Contains(list[0].After.String, "New"))
+}
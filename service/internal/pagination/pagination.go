@@ -0,0 +1,118 @@
+// Copyright 2023 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+// package pagination contains helpers to implement AIP-158 style pagination
+// and AIP-132 style sort orders on top of [persistence.ListOptions].
+package pagination
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/oxisto/money-gopher/persistence"
+
+	"connectrpc.com/connect"
+)
+
+var (
+	ErrInvalidPageSize  = errors.New("page size must not be negative")
+	ErrInvalidPageToken = errors.New("invalid page token")
+	ErrInvalidOrderBy   = errors.New("invalid order")
+)
+
+// Options converts the page size, page token and order of a list request
+// into [persistence.ListOptions]. The order can be any of the fields, which are
+// also columns, optionally followed by " desc". If it is empty, the first
+// field is used.
+func Options(pageSize int32, pageToken string, orderBy string, fields ...string) (opts persistence.ListOptions, err error) {
+	if pageSize < 0 {
+		return opts, connect.NewError(connect.CodeInvalidArgument, ErrInvalidPageSize)
+	}
+
+	opts.Offset, err = parseToken(pageToken)
+	if err != nil {
+		return opts, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	opts.OrderBy, opts.Descending, err = parseOrderBy(orderBy, fields)
+	if err != nil {
+		return opts, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	// We fetch one more object than requested, so that we know whether there
+	// is a next page
+	if pageSize > 0 {
+		opts.Limit = int64(pageSize) + 1
+	}
+
+	return opts, nil
+}
+
+// Page trims list, which was retrieved with opts, to the requested page size
+// and returns the token of the next page, if there is one.
+func Page[T any](opts persistence.ListOptions, list []T) (page []T, nextPageToken string) {
+	if opts.Limit == 0 || int64(len(list)) < opts.Limit {
+		return list, ""
+	}
+
+	n := opts.Limit - 1
+
+	return list[:n], base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(opts.Offset+n, 10)))
+}
+
+// parseToken returns the offset contained in token. An empty token starts at
+// the beginning.
+func parseToken(token string) (offset int64, err error) {
+	if token == "" {
+		return 0, nil
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, ErrInvalidPageToken
+	}
+
+	offset, err = strconv.ParseInt(string(b), 10, 64)
+	if err != nil || offset < 0 {
+		return 0, ErrInvalidPageToken
+	}
+
+	return offset, nil
+}
+
+// parseOrderBy parses an order such as "display_name desc".
+func parseOrderBy(orderBy string, fields []string) (field string, desc bool, err error) {
+	parts := strings.Fields(orderBy)
+	switch {
+	case len(parts) == 0:
+		return fields[0], false, nil
+	case len(parts) == 2 && strings.EqualFold(parts[1], "desc"):
+		desc = true
+	case len(parts) == 2 && strings.EqualFold(parts[1], "asc"):
+	case len(parts) != 1:
+		return "", false, fmt.Errorf("%w: %q", ErrInvalidOrderBy, orderBy)
+	}
+
+	if !slices.Contains(fields, parts[0]) {
+		return "", false, fmt.Errorf("%w: %q", ErrInvalidOrderBy, orderBy)
+	}
+
+	return parts[0], desc, nil
+}
@@ -0,0 +1,124 @@
+// Copyright 2023 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package pagination
+
+import (
+	"testing"
+
+	"github.com/oxisto/money-gopher/persistence"
+
+	"connectrpc.com/connect"
+	"github.com/oxisto/assert"
+)
+
+func TestOptions(t *testing.T) {
+	type args struct {
+		pageSize  int32
+		pageToken string
+		orderBy   string
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantOpts persistence.ListOptions
+		wantErr  error
+	}{
+		{
+			name:     "defaults",
+			args:     args{},
+			wantOpts: persistence.ListOptions{OrderBy: "id"},
+		},
+		{
+			name: "second page in descending order",
+			args: args{
+				pageSize:  10,
+				pageToken: "MTA",
+				orderBy:   "display_name desc",
+			},
+			wantOpts: persistence.ListOptions{OrderBy: "display_name", Descending: true, Limit: 11, Offset: 10},
+		},
+		{
+			name:    "negative page size",
+			args:    args{pageSize: -1},
+			wantErr: ErrInvalidPageSize,
+		},
+		{
+			name:    "invalid page token",
+			args:    args{pageToken: "!"},
+			wantErr: ErrInvalidPageToken,
+		},
+		{
+			name:    "unknown field",
+			args:    args{orderBy: "owner"},
+			wantErr: ErrInvalidOrderBy,
+		},
+		{
+			name:    "invalid order",
+			args:    args{orderBy: "id up"},
+			wantErr: ErrInvalidOrderBy,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotOpts, err := Options(tt.args.pageSize, tt.args.pageToken, tt.args.orderBy, "id", "display_name")
+			assert.ErrorIs(t, tt.wantErr, err)
+			if tt.wantErr != nil {
+				assert.Equals(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+				return
+			}
+
+			assert.Equals(t, tt.wantOpts, gotOpts)
+		})
+	}
+}
+
+func TestPage(t *testing.T) {
+	type args struct {
+		opts persistence.ListOptions
+		list []int
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantPage []int
+		wantNext string
+	}{
+		{
+			name:     "unlimited",
+			args:     args{list: []int{1, 2, 3}},
+			wantPage: []int{1, 2, 3},
+		},
+		{
+			name:     "next page",
+			args:     args{opts: persistence.ListOptions{Limit: 3, Offset: 8}, list: []int{1, 2, 3}},
+			wantPage: []int{1, 2},
+			wantNext: "MTA",
+		},
+		{
+			name:     "last page",
+			args:     args{opts: persistence.ListOptions{Limit: 3}, list: []int{1, 2}},
+			wantPage: []int{1, 2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotPage, gotNext := Page(tt.args.opts, tt.args.list)
+			assert.Equals(t, tt.wantPage, gotPage)
+			assert.Equals(t, tt.wantNext, gotNext)
+		})
+	}
+}
@@ -0,0 +1,144 @@
+// Copyright 2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package portfolio
+
+import (
+	"context"
+	"errors"
+	"math"
+
+	"github.com/oxisto/money-gopher/auth"
+	portfoliov1 "github.com/oxisto/money-gopher/gen"
+
+	"connectrpc.com/connect"
+)
+
+var (
+	ErrUnauthenticated     = errors.New("no authenticated user")
+	ErrPortfolioNotFound   = errors.New("portfolio not found")
+	ErrBankAccountNotFound = errors.New("bank account not found")
+	ErrTransactionNotFound = errors.New("transaction not found")
+	ErrAlreadyExists       = errors.New("an entity with this ID already exists")
+	ErrInsufficientAccess  = errors.New("insufficient access")
+)
+
+// accessOwner is the access the owner of a portfolio has. It supersedes all
+// access that can be granted by sharing a portfolio.
+const accessOwner portfoliov1.PortfolioAccess = math.MaxInt32
+
+// currentUser returns the authenticated user of the request.
+func currentUser(ctx context.Context) (u *auth.User, err error) {
+	u, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, ErrUnauthenticated)
+	}
+
+	return u, nil
+}
+
+// portfolioAccess returns the access the authenticated user has to the
+// portfolio with the given ID. Portfolios that the user has no access to at
+// all are reported as not found, so that we do not reveal their existence.
+func (svc *service) portfolioAccess(ctx context.Context, id string) (access portfoliov1.PortfolioAccess, err error) {
+	var (
+		u     *auth.User
+		p     *portfoliov1.Portfolio
+		share *portfoliov1.PortfolioShare
+	)
+
+	u, err = currentUser(ctx)
+	if err != nil {
+		return
+	}
+
+	p, err = svc.portfolios.Get(ctx, id)
+	if err != nil {
+		return access, connect.NewError(connect.CodeInternal, err)
+	} else if p == nil {
+		return access, connect.NewError(connect.CodeNotFound, ErrPortfolioNotFound)
+	} else if p.Owner == u.Subject {
+		return accessOwner, nil
+	}
+
+	share, err = svc.shares.Get(ctx, []any{id, u.Subject})
+	if err != nil {
+		return access, connect.NewError(connect.CodeInternal, err)
+	} else if share == nil {
+		return access, connect.NewError(connect.CodeNotFound, ErrPortfolioNotFound)
+	}
+
+	return share.Access, nil
+}
+
+// requirePortfolioAccess checks, whether the authenticated user has at least
+// the wanted access to the portfolio with the given ID.
+func (svc *service) requirePortfolioAccess(ctx context.Context, id string, want portfoliov1.PortfolioAccess) (err error) {
+	access, err := svc.portfolioAccess(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if access < want {
+		return connect.NewError(connect.CodePermissionDenied, ErrInsufficientAccess)
+	}
+
+	return nil
+}
+
+// requireTransactionAccess checks, whether the authenticated user has at
+// least the wanted access to the portfolio of the transaction with the given
+// ID.
+func (svc *service) requireTransactionAccess(ctx context.Context, id string, want portfoliov1.PortfolioAccess) (tx *portfoliov1.PortfolioEvent, err error) {
+	tx, err = svc.events.Get(ctx, id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	} else if tx == nil {
+		return nil, connect.NewError(connect.CodeNotFound, ErrTransactionNotFound)
+	}
+
+	err = svc.requirePortfolioAccess(ctx, tx.PortfolioId, want)
+	if connect.CodeOf(err) == connect.CodeNotFound {
+		// Do not reveal that the transaction exists
+		return nil, connect.NewError(connect.CodeNotFound, ErrTransactionNotFound)
+	} else if err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+// requireBankAccountOwner checks, whether the authenticated user owns the bank
+// account with the given ID and returns it.
+func (svc *service) requireBankAccountOwner(ctx context.Context, id string) (acc *portfoliov1.BankAccount, err error) {
+	var (
+		u *auth.User
+	)
+
+	u, err = currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	acc, err = svc.bankAccounts.Get(ctx, id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	} else if acc == nil || acc.Owner != u.Subject {
+		return nil, connect.NewError(connect.CodeNotFound, ErrBankAccountNotFound)
+	}
+
+	return acc, nil
+}
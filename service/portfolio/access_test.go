@@ -0,0 +1,214 @@
+// Copyright 2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package portfolio
+
+import (
+	"context"
+	"testing"
+
+	"github.com/oxisto/money-gopher/auth"
+	portfoliov1 "github.com/oxisto/money-gopher/gen"
+	"github.com/oxisto/money-gopher/internal"
+
+	"connectrpc.com/connect"
+	"github.com/oxisto/assert"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestMultipleUsers(t *testing.T) {
+	var (
+		svc    = NewService(Options{DB: internal.NewTestDB(t)})
+		money  = internal.WithTestUser(context.Background())
+		gopher = auth.NewContext(context.Background(), &auth.User{Subject: "gopher"})
+		err    error
+	)
+
+	getPortfolio := func(ctx context.Context) error {
+		_, err := svc.GetPortfolio(ctx, connect.NewRequest(&portfoliov1.GetPortfolioRequest{
+			Id: "mybank-myportfolio",
+		}))
+		return err
+	}
+
+	updatePortfolio := func(ctx context.Context) error {
+		_, err := svc.UpdatePortfolio(ctx, connect.NewRequest(&portfoliov1.UpdatePortfolioRequest{
+			Portfolio:  &portfoliov1.Portfolio{Id: "mybank-myportfolio", DisplayName: "Our Portfolio"},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"display_name"}},
+		}))
+		return err
+	}
+
+	share := func(ctx context.Context, access portfoliov1.PortfolioAccess) error {
+		_, err := svc.SharePortfolio(ctx, connect.NewRequest(&portfoliov1.SharePortfolioRequest{
+			Share: &portfoliov1.PortfolioShare{
+				PortfolioId: "mybank-myportfolio",
+				Subject:     "gopher",
+				Access:      access,
+			},
+		}))
+		return err
+	}
+
+	listPortfolios := func(ctx context.Context) int {
+		res, err := svc.ListPortfolios(ctx, connect.NewRequest(&portfoliov1.ListPortfoliosRequest{}))
+		assert.NoError(t, err)
+		return len(res.Msg.Portfolios)
+	}
+
+	// Requests without a user are rejected
+	err = getPortfolio(context.Background())
+	assert.Equals(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+
+	_, err = svc.CreatePortfolio(money, connect.NewRequest(&portfoliov1.CreatePortfolioRequest{
+		Portfolio: &portfoliov1.Portfolio{Id: "mybank-myportfolio", DisplayName: "My Portfolio"},
+	}))
+	assert.NoError(t, err)
+
+	// Another user cannot see or take over the portfolio
+	assert.Equals(t, 1, listPortfolios(money))
+	assert.Equals(t, 0, listPortfolios(gopher))
+	assert.Equals(t, connect.CodeNotFound, connect.CodeOf(getPortfolio(gopher)))
+
+	_, err = svc.CreatePortfolio(gopher, connect.NewRequest(&portfoliov1.CreatePortfolioRequest{
+		Portfolio: &portfoliov1.Portfolio{Id: "mybank-myportfolio", DisplayName: "Stolen Portfolio"},
+	}))
+	assert.Equals(t, connect.CodeAlreadyExists, connect.CodeOf(err))
+
+	// A request needs a share
+	_, err = svc.SharePortfolio(money, connect.NewRequest(&portfoliov1.SharePortfolioRequest{}))
+	assert.Equals(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	// Only the owner can share
+	assert.Equals(t, connect.CodeNotFound, connect.CodeOf(share(gopher, portfoliov1.PortfolioAccess_PORTFOLIO_ACCESS_WRITE)))
+	assert.Equals(t, connect.CodeInvalidArgument, connect.CodeOf(share(money, portfoliov1.PortfolioAccess_PORTFOLIO_ACCESS_UNSPECIFIED)))
+
+	// Read-only
+	assert.NoError(t, share(money, portfoliov1.PortfolioAccess_PORTFOLIO_ACCESS_READ))
+	assert.Equals(t, 1, listPortfolios(gopher))
+	assert.NoError(t, getPortfolio(gopher))
+	assert.Equals(t, connect.CodePermissionDenied, connect.CodeOf(updatePortfolio(gopher)))
+	assert.Equals(t, connect.CodePermissionDenied, connect.CodeOf(share(gopher, portfoliov1.PortfolioAccess_PORTFOLIO_ACCESS_WRITE)))
+
+	// Read-write
+	assert.NoError(t, share(money, portfoliov1.PortfolioAccess_PORTFOLIO_ACCESS_WRITE))
+	assert.NoError(t, updatePortfolio(gopher))
+
+	// Only the owner can move the portfolio to another bank account, so that
+	// its settlements cannot be moved to the cash ledger of someone else
+	_, err = svc.CreateBankAccount(gopher, connect.NewRequest(&portfoliov1.CreateBankAccountRequest{
+		BankAccount: &portfoliov1.BankAccount{Id: "gopherbank-cash", DisplayName: "Gopher's Cash"},
+	}))
+	assert.NoError(t, err)
+
+	_, err = svc.UpdatePortfolio(gopher, connect.NewRequest(&portfoliov1.UpdatePortfolioRequest{
+		Portfolio:  &portfoliov1.Portfolio{Id: "mybank-myportfolio", BankAccountId: "gopherbank-cash"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"bank_account_id"}},
+	}))
+	assert.Equals(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+	_, err = svc.DeletePortfolio(gopher, connect.NewRequest(&portfoliov1.DeletePortfolioRequest{Id: "mybank-myportfolio"}))
+	assert.Equals(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+	res, err := svc.ListPortfolioShares(money, connect.NewRequest(&portfoliov1.ListPortfolioSharesRequest{
+		PortfolioId: "mybank-myportfolio",
+	}))
+	assert.NoError(t, err)
+	assert.Equals(t, 1, len(res.Msg.Shares))
+
+	// Revoke access
+	_, err = svc.UnsharePortfolio(money, connect.NewRequest(&portfoliov1.UnsharePortfolioRequest{
+		PortfolioId: "mybank-myportfolio",
+		Subject:     "gopher",
+	}))
+	assert.NoError(t, err)
+	assert.Equals(t, 0, listPortfolios(gopher))
+	assert.Equals(t, connect.CodeNotFound, connect.CodeOf(getPortfolio(gopher)))
+}
+
+func TestMultipleUsers_transactions(t *testing.T) {
+	var (
+		svc    = NewService(Options{DB: internal.NewTestDB(t)})
+		money  = internal.WithTestUser(context.Background())
+		gopher = auth.NewContext(context.Background(), &auth.User{Subject: "gopher"})
+	)
+
+	_, err := svc.CreatePortfolio(money, connect.NewRequest(&portfoliov1.CreatePortfolioRequest{
+		Portfolio: &portfoliov1.Portfolio{Id: "mybank-myportfolio", DisplayName: "My Portfolio"},
+	}))
+	assert.NoError(t, err)
+
+	tx, err := svc.CreatePortfolioTransaction(money, connect.NewRequest(&portfoliov1.CreatePortfolioTransactionRequest{
+		Transaction: &portfoliov1.PortfolioEvent{
+			PortfolioId: "mybank-myportfolio",
+			Type:        portfoliov1.PortfolioEventType_PORTFOLIO_EVENT_TYPE_DEPOSIT_CASH,
+			Price:       portfoliov1.Value(10000),
+		},
+	}))
+	assert.NoError(t, err)
+
+	_, err = svc.CreatePortfolioTransaction(gopher, connect.NewRequest(&portfoliov1.CreatePortfolioTransactionRequest{
+		Transaction: &portfoliov1.PortfolioEvent{
+			PortfolioId: "mybank-myportfolio",
+			Type:        portfoliov1.PortfolioEventType_PORTFOLIO_EVENT_TYPE_WITHDRAW_CASH,
+			Price:       portfoliov1.Value(10000),
+		},
+	}))
+	assert.Equals(t, connect.CodeNotFound, connect.CodeOf(err))
+
+	_, err = svc.GetPortfolioTransaction(gopher, connect.NewRequest(&portfoliov1.GetPortfolioTransactionRequest{
+		Id: tx.Msg.Id,
+	}))
+	assert.Equals(t, connect.CodeNotFound, connect.CodeOf(err))
+
+	_, err = svc.ListPortfolioTransactions(gopher, connect.NewRequest(&portfoliov1.ListPortfolioTransactionsRequest{
+		PortfolioId: "mybank-myportfolio",
+	}))
+	assert.Equals(t, connect.CodeNotFound, connect.CodeOf(err))
+
+	_, err = svc.GetPortfolioSnapshot(gopher, connect.NewRequest(&portfoliov1.GetPortfolioSnapshotRequest{
+		PortfolioId: "mybank-myportfolio",
+	}))
+	assert.Equals(t, connect.CodeNotFound, connect.CodeOf(err))
+}
+
+func TestMultipleUsers_bankAccounts(t *testing.T) {
+	var (
+		svc    = NewService(Options{DB: internal.NewTestDB(t)})
+		money  = internal.WithTestUser(context.Background())
+		gopher = auth.NewContext(context.Background(), &auth.User{Subject: "gopher"})
+	)
+
+	_, err := svc.CreateBankAccount(money, connect.NewRequest(&portfoliov1.CreateBankAccountRequest{
+		BankAccount: &portfoliov1.BankAccount{Id: "mybank-mycash", DisplayName: "My Cash"},
+	}))
+	assert.NoError(t, err)
+
+	_, err = svc.CreateBankAccount(gopher, connect.NewRequest(&portfoliov1.CreateBankAccountRequest{
+		BankAccount: &portfoliov1.BankAccount{Id: "mybank-mycash", DisplayName: "Stolen Cash"},
+	}))
+	assert.Equals(t, connect.CodeAlreadyExists, connect.CodeOf(err))
+
+	_, err = svc.DeleteBankAccount(gopher, connect.NewRequest(&portfoliov1.DeleteBankAccountRequest{
+		Id: "mybank-mycash",
+	}))
+	assert.Equals(t, connect.CodeNotFound, connect.CodeOf(err))
+
+	_, err = svc.DeleteBankAccount(money, connect.NewRequest(&portfoliov1.DeleteBankAccountRequest{
+		Id: "mybank-mycash",
+	}))
+	assert.NoError(t, err)
+}
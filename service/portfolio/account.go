@@ -18,12 +18,33 @@ package portfolio
 
 import (
 	"context"
+	"errors"
+	"math/big"
+	"regexp"
+	"strings"
 
+	"github.com/oxisto/money-gopher/auth"
+	"github.com/oxisto/money-gopher/finance"
 	portfoliov1 "github.com/oxisto/money-gopher/gen"
+	"github.com/oxisto/money-gopher/persistence"
 
 	"connectrpc.com/connect"
 	"github.com/oxisto/money-gopher/service/internal/crud"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	ErrInvalidCurrency = errors.New("the currency must be an ISO 4217 code, e.g., EUR")
+	ErrInvalidIBAN     = errors.New("the IBAN is not valid")
+)
+
+// defaultCurrency is the currency of bank accounts that do not specify one.
+const defaultCurrency = "EUR"
+
+var (
+	currencyPattern = regexp.MustCompile(`^[A-Z]{3}$`)
+	ibanPattern     = regexp.MustCompile(`^[A-Z]{2}[0-9]{2}[A-Z0-9]{11,30}$`)
 )
 
 var bankAccountSetter = func(obj *portfoliov1.BankAccount) *portfoliov1.BankAccount {
@@ -31,7 +52,33 @@ var bankAccountSetter = func(obj *portfoliov1.BankAccount) *portfoliov1.BankAcco
 }
 
 func (svc *service) CreateBankAccount(ctx context.Context, req *connect.Request[portfoliov1.CreateBankAccountRequest]) (res *connect.Response[portfoliov1.BankAccount], err error) {
+	var (
+		u        *auth.User
+		existing *portfoliov1.BankAccount
+	)
+
+	u, err = currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Make sure that we do not replace the bank account of another user
+	existing, err = svc.bankAccounts.Get(ctx, req.Msg.BankAccount.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	} else if existing != nil && existing.Owner != u.Subject {
+		return nil, connect.NewError(connect.CodeAlreadyExists, ErrAlreadyExists)
+	}
+
+	err = normalizeBankAccount(req.Msg.BankAccount)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Msg.BankAccount.Owner = u.Subject
+
 	return crud.Create(
+		ctx,
 		req.Msg.BankAccount,
 		svc.bankAccounts,
 		bankAccountSetter,
@@ -39,17 +86,190 @@ func (svc *service) CreateBankAccount(ctx context.Context, req *connect.Request[
 }
 
 func (svc *service) UpdateBankAccount(ctx context.Context, req *connect.Request[portfoliov1.UpdateBankAccountRequest]) (res *connect.Response[portfoliov1.BankAccount], err error) {
+	_, err = svc.requireBankAccountOwner(ctx, req.Msg.Account.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = normalizeBankAccount(req.Msg.Account)
+	if err != nil {
+		return nil, err
+	}
+
 	return crud.Update(
+		ctx,
 		req.Msg.Account.Id,
 		req.Msg.Account,
 		req.Msg.UpdateMask.Paths,
 		svc.bankAccounts,
-		func(obj *portfoliov1.BankAccount) *portfoliov1.BankAccount {
-			return obj
-		},
+		bankAccountSetter,
 	)
 }
 
 func (svc *service) DeleteBankAccount(ctx context.Context, req *connect.Request[portfoliov1.DeleteBankAccountRequest]) (res *connect.Response[emptypb.Empty], err error) {
-	return crud.Delete(req.Msg.Id, svc.bankAccounts)
+	_, err = svc.requireBankAccountOwner(ctx, req.Msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return crud.Delete(ctx, req.Msg.Id, svc.bankAccounts)
+}
+
+func (svc *service) ListBankAccounts(ctx context.Context, req *connect.Request[portfoliov1.ListBankAccountsRequest]) (res *connect.Response[portfoliov1.ListBankAccountsResponse], err error) {
+	u, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return crud.List(
+		ctx,
+		svc.bankAccounts,
+		func(
+			res *connect.Response[portfoliov1.ListBankAccountsResponse],
+			list []*portfoliov1.BankAccount,
+		) error {
+			res.Msg.BankAccounts = list
+
+			for _, acc := range list {
+				ledger, err := svc.cashLedger(ctx, acc, nil)
+				if err != nil {
+					return connect.NewError(connect.CodeInternal, err)
+				}
+
+				acc.Balance = ledger.Balance
+			}
+
+			return nil
+		},
+		u.Subject,
+	)
+}
+
+func (svc *service) GetBankAccount(ctx context.Context, req *connect.Request[portfoliov1.GetBankAccountRequest]) (res *connect.Response[portfoliov1.BankAccount], err error) {
+	acc, err := svc.requireBankAccountOwner(ctx, req.Msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	ledger, err := svc.cashLedger(ctx, acc, nil)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	acc.Balance = ledger.Balance
+
+	return connect.NewResponse(acc), nil
+}
+
+func (svc *service) GetCashLedger(ctx context.Context, req *connect.Request[portfoliov1.GetCashLedgerRequest]) (res *connect.Response[portfoliov1.CashLedger], err error) {
+	acc, err := svc.requireBankAccountOwner(ctx, req.Msg.BankAccountId)
+	if err != nil {
+		return nil, err
+	}
+
+	ledger, err := svc.cashLedger(ctx, acc, req.Msg.EndTime)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(ledger), nil
+}
+
+// cashLedger builds the cash ledger of a bank account out of the events of all
+// portfolios that settle against it. If end is set, only events before it are
+// considered.
+func (svc *service) cashLedger(ctx context.Context, acc *portfoliov1.BankAccount, end *timestamppb.Timestamp) (ledger *portfoliov1.CashLedger, err error) {
+	rows, err := svc.q.ListCashLedgerEvents(ctx, acc.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	ledger = &portfoliov1.CashLedger{
+		BankAccountId: acc.Id,
+		Balance:       &portfoliov1.Currency{Symbol: acc.Currency},
+	}
+
+	for _, row := range rows {
+		tx := eventToProto(row)
+		if end != nil && !tx.Time.AsTime().Before(end.AsTime()) {
+			break
+		}
+
+		amount := finance.CashFlow(tx)
+		if amount.IsZero() {
+			continue
+		}
+
+		amount.Symbol = acc.Currency
+		ledger.Balance = ledger.Balance.Plus(amount)
+
+		ledger.Entries = append(ledger.Entries, &portfoliov1.CashLedgerEntry{
+			Time:          tx.Time,
+			TransactionId: tx.Id,
+			PortfolioId:   tx.PortfolioId,
+			Type:          tx.Type,
+			SecurityId:    tx.SecurityId,
+			Amount:        amount,
+			Balance:       ledger.Balance,
+		})
+	}
+
+	return ledger, nil
+}
+
+// eventToProto converts a portfolio event of the cash ledger query into its
+// protobuf message.
+func eventToProto(row *persistence.ListCashLedgerEventsRow) *portfoliov1.PortfolioEvent {
+	return &portfoliov1.PortfolioEvent{
+		Id:          row.ID,
+		Type:        portfoliov1.PortfolioEventType(row.Type),
+		Time:        timestamppb.New(row.Time),
+		PortfolioId: row.PortfolioID,
+		SecurityId:  row.SecurityID,
+		Amount:      row.Amount.Float64,
+		Price:       portfoliov1.Value(int32(row.Price.Int64)),
+		Fees:        portfoliov1.Value(int32(row.Fees.Int64)),
+		Taxes:       portfoliov1.Value(int32(row.Taxes.Int64)),
+	}
+}
+
+// normalizeBankAccount validates the currency and IBAN of a bank account and
+// brings them into their canonical form.
+func normalizeBankAccount(acc *portfoliov1.BankAccount) error {
+	acc.Currency = strings.ToUpper(strings.TrimSpace(acc.Currency))
+	if acc.Currency == "" {
+		acc.Currency = defaultCurrency
+	} else if !currencyPattern.MatchString(acc.Currency) {
+		return connect.NewError(connect.CodeInvalidArgument, ErrInvalidCurrency)
+	}
+
+	acc.Iban = strings.ToUpper(strings.ReplaceAll(acc.Iban, " ", ""))
+	if acc.Iban != "" && !validIBAN(acc.Iban) {
+		return connect.NewError(connect.CodeInvalidArgument, ErrInvalidIBAN)
+	}
+
+	return nil
+}
+
+// validIBAN checks the format and the check digits of a normalized IBAN
+// according to ISO 13616.
+func validIBAN(iban string) bool {
+	if !ibanPattern.MatchString(iban) {
+		return false
+	}
+
+	// Move the country code and check digits to the end and replace all
+	// letters with numbers, i.e., A = 10, B = 11, ...
+	var digits strings.Builder
+	for _, r := range iban[4:] + iban[:4] {
+		if r >= 'A' && r <= 'Z' {
+			digits.WriteString(big.NewInt(int64(r - 'A' + 10)).String())
+		} else {
+			digits.WriteRune(r)
+		}
+	}
+
+	n, ok := new(big.Int).SetString(digits.String(), 10)
+
+	return ok && n.Mod(n, big.NewInt(97)).Int64() == 1
 }
@@ -19,7 +19,9 @@ package portfolio
 import (
 	"context"
 	"testing"
+	"time"
 
+	"github.com/oxisto/money-gopher/auth"
 	portfoliov1 "github.com/oxisto/money-gopher/gen"
 	"github.com/oxisto/money-gopher/gen/portfoliov1connect"
 	"github.com/oxisto/money-gopher/internal"
@@ -28,6 +30,7 @@ import (
 	"connectrpc.com/connect"
 	"github.com/oxisto/assert"
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func Test_service_CreateBankAccount(t *testing.T) {
@@ -65,13 +68,89 @@ func Test_service_CreateBankAccount(t *testing.T) {
 			wantRes: func(t *testing.T, r *connect.Response[portfoliov1.BankAccount]) bool {
 				return true &&
 					assert.Equals(t, "mybank-mycash", r.Msg.Id) &&
-					assert.Equals(t, "My Cash Account", r.Msg.DisplayName)
+					assert.Equals(t, "My Cash Account", r.Msg.DisplayName) &&
+					assert.Equals(t, internal.TestUser, r.Msg.Owner)
 			},
 			wantSvc: func(t *testing.T, s *service) bool {
-				list, _ := s.bankAccounts.List()
-				return assert.Equals(t, 1, len(list))
+				list, _ := s.bankAccounts.List(context.Background(), internal.TestUser)
+				return assert.Equals(t, 1, len(list)) &&
+					assert.Equals(t, "EUR", list[0].Currency)
 			},
 		},
+		{
+			name: "with IBAN",
+			fields: fields{
+				portfolios:   internal.NewTestDBOps[*portfoliov1.Portfolio](t),
+				bankAccounts: internal.NewTestDBOps[*portfoliov1.BankAccount](t),
+			},
+			args: args{
+				req: connect.NewRequest(&portfoliov1.CreateBankAccountRequest{
+					BankAccount: &portfoliov1.BankAccount{
+						Id:          "mybank-mycash",
+						DisplayName: "My Cash Account",
+						Currency:    "usd",
+						Iban:        "de89 3704 0044 0532 0130 00",
+						Institution: "My Bank",
+					},
+				}),
+			},
+			wantRes: func(t *testing.T, r *connect.Response[portfoliov1.BankAccount]) bool {
+				return true &&
+					assert.Equals(t, "USD", r.Msg.Currency) &&
+					assert.Equals(t, "DE89370400440532013000", r.Msg.Iban)
+			},
+			wantSvc: func(t *testing.T, s *service) bool {
+				acc, _ := s.bankAccounts.Get(context.Background(), "mybank-mycash")
+				return assert.Equals(t, "DE89370400440532013000", acc.Iban) &&
+					assert.Equals(t, "My Bank", acc.Institution)
+			},
+		},
+		{
+			name: "invalid IBAN",
+			fields: fields{
+				portfolios:   internal.NewTestDBOps[*portfoliov1.Portfolio](t),
+				bankAccounts: internal.NewTestDBOps[*portfoliov1.BankAccount](t),
+			},
+			args: args{
+				req: connect.NewRequest(&portfoliov1.CreateBankAccountRequest{
+					BankAccount: &portfoliov1.BankAccount{
+						Id:          "mybank-mycash",
+						DisplayName: "My Cash Account",
+						Iban:        "DE89370400440532013001",
+					},
+				}),
+			},
+			wantRes: func(t *testing.T, r *connect.Response[portfoliov1.BankAccount]) bool {
+				return assert.Equals(t, nil, r)
+			},
+			wantSvc: func(t *testing.T, s *service) bool {
+				list, _ := s.bankAccounts.List(context.Background(), internal.TestUser)
+				return assert.Equals(t, 0, len(list))
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid currency",
+			fields: fields{
+				portfolios:   internal.NewTestDBOps[*portfoliov1.Portfolio](t),
+				bankAccounts: internal.NewTestDBOps[*portfoliov1.BankAccount](t),
+			},
+			args: args{
+				req: connect.NewRequest(&portfoliov1.CreateBankAccountRequest{
+					BankAccount: &portfoliov1.BankAccount{
+						Id:       "mybank-mycash",
+						Currency: "Euro",
+					},
+				}),
+			},
+			wantRes: func(t *testing.T, r *connect.Response[portfoliov1.BankAccount]) bool {
+				return assert.Equals(t, nil, r)
+			},
+			wantSvc: func(t *testing.T, s *service) bool {
+				return true
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -82,7 +161,7 @@ func Test_service_CreateBankAccount(t *testing.T) {
 				bankAccounts: tt.fields.bankAccounts,
 				securities:   tt.fields.securities,
 			}
-			gotRes, err := svc.CreateBankAccount(tt.args.ctx, tt.args.req)
+			gotRes, err := svc.CreateBankAccount(internal.WithTestUser(tt.args.ctx), tt.args.req)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("service.CreateBankAccount() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -136,7 +215,7 @@ func Test_service_UpdateBankAccount(t *testing.T) {
 				portfolios:   tt.fields.portfolios,
 				bankAccounts: tt.fields.bankAccounts,
 			}
-			gotRes, err := svc.UpdateBankAccount(tt.args.ctx, tt.args.req)
+			gotRes, err := svc.UpdateBankAccount(internal.WithTestUser(tt.args.ctx), tt.args.req)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("service.UpdateBankAccount() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -174,7 +253,7 @@ func Test_service_DeleteBankAccount(t *testing.T) {
 				}),
 			},
 			wantSvc: func(t *testing.T, s *service) bool {
-				list, _ := s.bankAccounts.List()
+				list, _ := s.bankAccounts.List(context.Background(), internal.TestUser)
 				return assert.Equals(t, 0, len(list))
 			},
 		},
@@ -186,7 +265,7 @@ func Test_service_DeleteBankAccount(t *testing.T) {
 				portfolios:   tt.fields.portfolios,
 				bankAccounts: tt.fields.bankAccounts,
 			}
-			_, err := svc.DeleteBankAccount(tt.args.ctx, tt.args.req)
+			_, err := svc.DeleteBankAccount(internal.WithTestUser(tt.args.ctx), tt.args.req)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("service.DeleteBankAccount() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -195,3 +274,123 @@ func Test_service_DeleteBankAccount(t *testing.T) {
 		})
 	}
 }
+
+func TestCashLedger(t *testing.T) {
+	var (
+		db     = internal.NewTestDB(t)
+		svc    = NewService(Options{DB: db})
+		events = persistence.Ops[*portfoliov1.PortfolioEvent](db)
+		money  = internal.WithTestUser(context.Background())
+		gopher = auth.NewContext(context.Background(), &auth.User{Subject: "gopher", Roles: []auth.Role{auth.RoleEditor}})
+		err    error
+	)
+
+	_, err = svc.CreateBankAccount(money, connect.NewRequest(&portfoliov1.CreateBankAccountRequest{
+		BankAccount: &portfoliov1.BankAccount{Id: "mybank-mycash", DisplayName: "My Cash", Currency: "USD"},
+	}))
+	assert.NoError(t, err)
+
+	// Only the owner of the bank account can settle against it
+	_, err = svc.CreatePortfolio(gopher, connect.NewRequest(&portfoliov1.CreatePortfolioRequest{
+		Portfolio: &portfoliov1.Portfolio{Id: "gopher-portfolio", BankAccountId: "mybank-mycash"},
+	}))
+	assert.Equals(t, connect.CodeNotFound, connect.CodeOf(err))
+
+	_, err = svc.CreatePortfolio(money, connect.NewRequest(&portfoliov1.CreatePortfolioRequest{
+		Portfolio: &portfoliov1.Portfolio{Id: "mybank-myportfolio", DisplayName: "My Portfolio", BankAccountId: "mybank-mycash"},
+	}))
+	assert.NoError(t, err)
+
+	for _, tx := range []*portfoliov1.PortfolioEvent{
+		{
+			Id:    "deposit",
+			Type:  portfoliov1.PortfolioEventType_PORTFOLIO_EVENT_TYPE_DEPOSIT_CASH,
+			Price: portfoliov1.Value(100000),
+			Time:  timestamppb.New(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)),
+		},
+		{
+			Id:         "buy",
+			Type:       portfoliov1.PortfolioEventType_PORTFOLIO_EVENT_TYPE_BUY,
+			SecurityId: "US0378331005",
+			Amount:     5,
+			Price:      portfoliov1.Value(10000),
+			Fees:       portfoliov1.Value(500),
+			Time:       timestamppb.New(time.Date(2020, 2, 1, 0, 0, 0, 0, time.UTC)),
+		},
+		{
+			Id:         "delivery",
+			Type:       portfoliov1.PortfolioEventType_PORTFOLIO_EVENT_TYPE_DELIVERY_INBOUND,
+			SecurityId: "US0378331005",
+			Amount:     1,
+			Price:      portfoliov1.Value(10000),
+			Time:       timestamppb.New(time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC)),
+		},
+		{
+			Id:         "sell",
+			Type:       portfoliov1.PortfolioEventType_PORTFOLIO_EVENT_TYPE_SELL,
+			SecurityId: "US0378331005",
+			Amount:     2,
+			Price:      portfoliov1.Value(12000),
+			Fees:       portfoliov1.Value(500),
+			Taxes:      portfoliov1.Value(300),
+			Time:       timestamppb.New(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+		},
+		{
+			Id:            "pending",
+			Type:          portfoliov1.PortfolioEventType_PORTFOLIO_EVENT_TYPE_BUY,
+			SecurityId:    "US0378331005",
+			Amount:        1,
+			Price:         portfoliov1.Value(12000),
+			Time:          timestamppb.New(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+			SavingsPlanId: "apple",
+			Pending:       true,
+		},
+	} {
+		tx.PortfolioId = "mybank-myportfolio"
+		assert.NoError(t, events.Replace(money, tx))
+	}
+
+	res, err := svc.GetCashLedger(money, connect.NewRequest(&portfoliov1.GetCashLedgerRequest{
+		BankAccountId: "mybank-mycash",
+	}))
+	assert.NoError(t, err)
+
+	// The delivery does not involve any cash and the pending transaction is
+	// not executed yet
+	assert.Equals(t, 3, len(res.Msg.Entries))
+	assert.Equals(t, "buy", res.Msg.Entries[1].TransactionId)
+	assert.Equals(t, int32(-50500), res.Msg.Entries[1].Amount.Value)
+	assert.Equals(t, int32(49500), res.Msg.Entries[1].Balance.Value)
+	assert.Equals(t, int32(23200), res.Msg.Entries[2].Amount.Value)
+	assert.Equals(t, int32(72700), res.Msg.Balance.Value)
+	assert.Equals(t, "USD", res.Msg.Balance.Symbol)
+
+	// The ledger can end at a certain time
+	res, err = svc.GetCashLedger(money, connect.NewRequest(&portfoliov1.GetCashLedgerRequest{
+		BankAccountId: "mybank-mycash",
+		EndTime:       timestamppb.New(time.Date(2020, 12, 31, 0, 0, 0, 0, time.UTC)),
+	}))
+	assert.NoError(t, err)
+	assert.Equals(t, 2, len(res.Msg.Entries))
+	assert.Equals(t, int32(49500), res.Msg.Balance.Value)
+
+	// The balance is also part of the bank account
+	acc, err := svc.GetBankAccount(money, connect.NewRequest(&portfoliov1.GetBankAccountRequest{Id: "mybank-mycash"}))
+	assert.NoError(t, err)
+	assert.Equals(t, int32(72700), acc.Msg.Balance.Value)
+
+	list, err := svc.ListBankAccounts(money, connect.NewRequest(&portfoliov1.ListBankAccountsRequest{}))
+	assert.NoError(t, err)
+	assert.Equals(t, 1, len(list.Msg.BankAccounts))
+	assert.Equals(t, int32(72700), list.Msg.BankAccounts[0].Balance.Value)
+
+	// Other users cannot see the bank account or its ledger
+	_, err = svc.GetCashLedger(gopher, connect.NewRequest(&portfoliov1.GetCashLedgerRequest{
+		BankAccountId: "mybank-mycash",
+	}))
+	assert.Equals(t, connect.CodeNotFound, connect.CodeOf(err))
+
+	list, err = svc.ListBankAccounts(gopher, connect.NewRequest(&portfoliov1.ListBankAccountsRequest{}))
+	assert.NoError(t, err)
+	assert.Equals(t, 0, len(list.Msg.BankAccounts))
+}
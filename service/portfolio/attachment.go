@@ -0,0 +1,287 @@
+// Copyright 2023 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package portfolio
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"io"
+	"time"
+
+	portfoliov1 "github.com/oxisto/money-gopher/gen"
+	"github.com/oxisto/money-gopher/persistence"
+	"github.com/oxisto/money-gopher/service/audit"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// MaxAttachmentSize is the maximum size of an attachment in bytes.
+	MaxAttachmentSize = 32 << 20
+
+	// attachmentChunkSize is the size of the chunks in which an attachment is
+	// downloaded.
+	attachmentChunkSize = 64 << 10
+)
+
+var (
+	ErrMissingAttachment    = errors.New("the first message needs to contain the transaction ID and the file name of the attachment")
+	ErrAttachmentNotFound   = errors.New("attachment not found")
+	ErrAttachmentExists     = errors.New("this content is already attached to the transaction")
+	ErrAttachmentTooLarge   = errors.New("attachment is too large")
+	ErrAttachmentIncomplete = errors.New("the content of the attachment is missing")
+)
+
+func (svc *service) UploadAttachment(ctx context.Context, stream *connect.ClientStream[portfoliov1.UploadAttachmentRequest]) (res *connect.Response[portfoliov1.Attachment], err error) {
+	var (
+		a       *portfoliov1.Attachment
+		tx      *portfoliov1.PortfolioEvent
+		r       *uploadReader
+		content []byte
+		sum     [sha256.Size]byte
+		hash    string
+		dba     *persistence.Attachment
+	)
+
+	if !stream.Receive() {
+		if err = stream.Err(); err != nil {
+			return nil, err
+		}
+
+		return nil, connect.NewError(connect.CodeInvalidArgument, ErrMissingAttachment)
+	}
+
+	a = stream.Msg().GetAttachment()
+	if a.GetTransactionId() == "" || a.GetFileName() == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, ErrMissingAttachment)
+	}
+
+	tx, err = svc.requireTransactionAccess(ctx, a.TransactionId, portfoliov1.PortfolioAccess_PORTFOLIO_ACCESS_WRITE)
+	if err != nil {
+		return nil, err
+	}
+
+	// The content is received completely before anything is stored, so that
+	// the attachment and its content can be stored in one transaction. We read
+	// one byte more than allowed to detect content that is too large.
+	r = &uploadReader{stream: stream}
+	content, err = io.ReadAll(io.LimitReader(r, MaxAttachmentSize+1))
+	if r.err != nil {
+		return nil, r.err
+	} else if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	if len(content) > MaxAttachmentSize {
+		return nil, connect.NewError(connect.CodeInvalidArgument, ErrAttachmentTooLarge)
+	} else if len(content) == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, ErrAttachmentIncomplete)
+	}
+
+	sum = sha256.Sum256(content)
+	hash = hex.EncodeToString(sum[:])
+
+	dba, err = persistence.StoreAttachment(ctx, svc.db, svc.blobs, persistence.CreateAttachmentParams{
+		ID:          attachmentID(tx.Id, hash),
+		EventID:     tx.Id,
+		FileName:    a.FileName,
+		ContentType: a.ContentType,
+		Size:        int64(len(content)),
+		Hash:        hash,
+		CreateTime:  time.Now(),
+	}, content)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeAlreadyExists, ErrAttachmentExists)
+	} else if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	a = attachmentToProto(dba)
+
+	audit.Record(ctx, audit.Event{
+		Action: portfoliov1.AuditAction_AUDIT_ACTION_CREATE,
+		After:  a,
+	})
+
+	return connect.NewResponse(a), nil
+}
+
+// uploadReader reads the chunks of an upload stream. It remembers the error
+// of the stream, so that it can be told apart from errors of the store.
+type uploadReader struct {
+	stream *connect.ClientStream[portfoliov1.UploadAttachmentRequest]
+	chunk  []byte
+	err    error
+}
+
+func (r *uploadReader) Read(p []byte) (n int, err error) {
+	for len(r.chunk) == 0 {
+		if !r.stream.Receive() {
+			r.err = r.stream.Err()
+			if r.err != nil {
+				return 0, r.err
+			}
+
+			return 0, io.EOF
+		}
+
+		r.chunk = r.stream.Msg().GetChunk()
+	}
+
+	n = copy(p, r.chunk)
+	r.chunk = r.chunk[n:]
+
+	return n, nil
+}
+
+// attachmentID derives the ID of an attachment from its transaction and the
+// hash of its content.
+func attachmentID(txID string, hash string) string {
+	h := sha256.Sum256([]byte(txID + "/" + hash))
+
+	return hex.EncodeToString(h[:8])
+}
+
+func (svc *service) DownloadAttachment(ctx context.Context, req *connect.Request[portfoliov1.DownloadAttachmentRequest], stream *connect.ServerStream[portfoliov1.DownloadAttachmentResponse]) (err error) {
+	var (
+		a   *persistence.Attachment
+		rc  io.ReadCloser
+		buf = make([]byte, attachmentChunkSize)
+		n   int
+	)
+
+	a, err = svc.requireAttachmentAccess(ctx, req.Msg.Id, portfoliov1.PortfolioAccess_PORTFOLIO_ACCESS_READ)
+	if err != nil {
+		return err
+	}
+
+	rc, err = svc.blobs.Open(ctx, a.Hash)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	defer rc.Close()
+
+	err = stream.Send(&portfoliov1.DownloadAttachmentResponse{
+		Data: &portfoliov1.DownloadAttachmentResponse_Attachment{Attachment: attachmentToProto(a)},
+	})
+	if err != nil {
+		return err
+	}
+
+	for {
+		n, err = io.ReadFull(rc, buf)
+		if n > 0 {
+			serr := stream.Send(&portfoliov1.DownloadAttachmentResponse{
+				Data: &portfoliov1.DownloadAttachmentResponse_Chunk{Chunk: buf[:n]},
+			})
+			if serr != nil {
+				return serr
+			}
+		}
+
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil
+		} else if err != nil {
+			return connect.NewError(connect.CodeInternal, err)
+		}
+	}
+}
+
+func (svc *service) ListAttachments(ctx context.Context, req *connect.Request[portfoliov1.ListAttachmentsRequest]) (res *connect.Response[portfoliov1.ListAttachmentsResponse], err error) {
+	var list []*persistence.Attachment
+
+	_, err = svc.requireTransactionAccess(ctx, req.Msg.TransactionId, portfoliov1.PortfolioAccess_PORTFOLIO_ACCESS_READ)
+	if err != nil {
+		return nil, err
+	}
+
+	list, err = svc.q.ListAttachments(ctx, req.Msg.TransactionId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	res = connect.NewResponse(&portfoliov1.ListAttachmentsResponse{
+		Attachments: make([]*portfoliov1.Attachment, 0, len(list)),
+	})
+
+	for _, dba := range list {
+		res.Msg.Attachments = append(res.Msg.Attachments, attachmentToProto(dba))
+	}
+
+	return
+}
+
+func (svc *service) DeleteAttachment(ctx context.Context, req *connect.Request[portfoliov1.DeleteAttachmentRequest]) (res *connect.Response[emptypb.Empty], err error) {
+	var a *persistence.Attachment
+
+	a, err = svc.requireAttachmentAccess(ctx, req.Msg.Id, portfoliov1.PortfolioAccess_PORTFOLIO_ACCESS_WRITE)
+	if err != nil {
+		return nil, err
+	}
+
+	// The content is only removed if it is not attached somewhere else
+	err = persistence.RemoveAttachment(ctx, svc.db, svc.blobs, a.ID, a.Hash)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	audit.Record(ctx, audit.Event{
+		Action: portfoliov1.AuditAction_AUDIT_ACTION_DELETE,
+		Before: attachmentToProto(a),
+	})
+
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+// requireAttachmentAccess checks, whether the authenticated user has at least
+// the wanted access to the transaction of the attachment with the given ID and
+// returns the attachment.
+func (svc *service) requireAttachmentAccess(ctx context.Context, id string, want portfoliov1.PortfolioAccess) (a *persistence.Attachment, err error) {
+	a, err = svc.q.GetAttachment(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeNotFound, ErrAttachmentNotFound)
+	} else if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	_, err = svc.requireTransactionAccess(ctx, a.EventID, want)
+	if connect.CodeOf(err) == connect.CodeNotFound {
+		// Do not reveal that the attachment exists
+		return nil, connect.NewError(connect.CodeNotFound, ErrAttachmentNotFound)
+	} else if err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+func attachmentToProto(a *persistence.Attachment) *portfoliov1.Attachment {
+	return &portfoliov1.Attachment{
+		Id:            a.ID,
+		TransactionId: a.EventID,
+		FileName:      a.FileName,
+		ContentType:   a.ContentType,
+		Size:          a.Size,
+		Sha256:        a.Hash,
+		CreateTime:    timestamppb.New(a.CreateTime),
+	}
+}
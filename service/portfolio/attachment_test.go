@@ -0,0 +1,166 @@
+// Copyright 2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package portfolio
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/oxisto/money-gopher/auth"
+	portfoliov1 "github.com/oxisto/money-gopher/gen"
+	"github.com/oxisto/money-gopher/gen/portfoliov1connect"
+	"github.com/oxisto/money-gopher/internal"
+	"github.com/oxisto/money-gopher/persistence"
+
+	"connectrpc.com/connect"
+	"github.com/oxisto/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestAttachments(t *testing.T) {
+	var (
+		db      = internal.NewTestDB(t)
+		blobs   = persistence.NewDBBlobStore(persistence.New(db))
+		svc     = NewService(Options{DB: db, Attachments: blobs})
+		money   = internal.WithTestUser(context.Background())
+		gopher  = auth.NewContext(context.Background(), &auth.User{Subject: "gopher", Roles: []auth.Role{auth.RoleEditor}})
+		content = bytes.Repeat([]byte("contract note "), 10000)
+		err     error
+	)
+
+	_, err = svc.CreatePortfolio(money, connect.NewRequest(&portfoliov1.CreatePortfolioRequest{
+		Portfolio: &portfoliov1.Portfolio{Id: "mybank-myportfolio", DisplayName: "My Portfolio"},
+	}))
+	assert.NoError(t, err)
+
+	for _, id := range []string{"buy", "sell"} {
+		err = persistence.Ops[*portfoliov1.PortfolioEvent](db).Replace(money, &portfoliov1.PortfolioEvent{
+			Id:          id,
+			Type:        portfoliov1.PortfolioEventType_PORTFOLIO_EVENT_TYPE_BUY,
+			PortfolioId: "mybank-myportfolio",
+			SecurityId:  "US0378331005",
+			Amount:      1,
+			Price:       portfoliov1.Value(100),
+			Time:        timestamppb.New(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)),
+		})
+		assert.NoError(t, err)
+	}
+
+	// Requests are made by whoever is in the "user" header
+	mux := http.NewServeMux()
+	mux.Handle(portfoliov1connect.NewPortfolioServiceHandler(svc))
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := money
+		if r.Header.Get("user") == "gopher" {
+			ctx = gopher
+		}
+		mux.ServeHTTP(w, r.WithContext(ctx))
+	}))
+	defer srv.Close()
+
+	client := portfoliov1connect.NewPortfolioServiceClient(srv.Client(), srv.URL)
+
+	upload := func(user string, txID string, content []byte) (*portfoliov1.Attachment, error) {
+		stream := client.UploadAttachment(context.Background())
+		stream.RequestHeader().Set("user", user)
+		_ = stream.Send(&portfoliov1.UploadAttachmentRequest{
+			Data: &portfoliov1.UploadAttachmentRequest_Attachment{
+				Attachment: &portfoliov1.Attachment{TransactionId: txID, FileName: "note.pdf", ContentType: "application/pdf"},
+			},
+		})
+		for i := 0; i < len(content); i += 4096 {
+			_ = stream.Send(&portfoliov1.UploadAttachmentRequest{
+				Data: &portfoliov1.UploadAttachmentRequest_Chunk{Chunk: content[i:min(i+4096, len(content))]},
+			})
+		}
+		res, err := stream.CloseAndReceive()
+		if err != nil {
+			return nil, err
+		}
+		return res.Msg, nil
+	}
+
+	download := func(user string, id string) (a *portfoliov1.Attachment, content []byte, err error) {
+		req := connect.NewRequest(&portfoliov1.DownloadAttachmentRequest{Id: id})
+		req.Header().Set("user", user)
+		stream, err := client.DownloadAttachment(context.Background(), req)
+		assert.NoError(t, err)
+		defer stream.Close()
+		for stream.Receive() {
+			if msg := stream.Msg().GetAttachment(); msg != nil {
+				a = msg
+			}
+			content = append(content, stream.Msg().GetChunk()...)
+		}
+		return a, content, stream.Err()
+	}
+
+	list := func(txID string) []*portfoliov1.Attachment {
+		res, err := svc.ListAttachments(money, connect.NewRequest(&portfoliov1.ListAttachmentsRequest{TransactionId: txID}))
+		assert.NoError(t, err)
+		return res.Msg.Attachments
+	}
+
+	// Users without access to the portfolio cannot attach anything
+	_, err = upload("gopher", "buy", content)
+	assert.Equals(t, connect.CodeNotFound, connect.CodeOf(err))
+
+	// The content needs to follow the attachment
+	_, err = upload("money", "buy", nil)
+	assert.Equals(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	a, err := upload("money", "buy", content)
+	assert.NoError(t, err)
+	assert.Equals(t, "note.pdf", a.FileName)
+	assert.Equals(t, int64(len(content)), a.Size)
+	assert.Equals(t, 1, len(list("buy")))
+
+	// Attaching the same content twice is not possible, but attaching it to
+	// another transaction is and stores it only once
+	_, err = upload("money", "buy", content)
+	assert.Equals(t, connect.CodeAlreadyExists, connect.CodeOf(err))
+
+	b, err := upload("money", "sell", content)
+	assert.NoError(t, err)
+	assert.Equals(t, a.Sha256, b.Sha256)
+
+	got, downloaded, err := download("money", a.Id)
+	assert.NoError(t, err)
+	assert.Equals(t, a.Id, got.Id)
+	assert.Equals(t, content, downloaded)
+
+	_, _, err = download("gopher", a.Id)
+	assert.Equals(t, connect.CodeNotFound, connect.CodeOf(err))
+
+	// The content is only removed together with its last attachment
+	_, err = svc.DeleteAttachment(money, connect.NewRequest(&portfoliov1.DeleteAttachmentRequest{Id: a.Id}))
+	assert.NoError(t, err)
+	assert.Equals(t, 0, len(list("buy")))
+
+	_, _, err = download("money", b.Id)
+	assert.NoError(t, err)
+
+	_, err = svc.DeleteAttachment(money, connect.NewRequest(&portfoliov1.DeleteAttachmentRequest{Id: b.Id}))
+	assert.NoError(t, err)
+
+	_, err = blobs.Open(money, b.Sha256)
+	assert.ErrorIs(t, err, persistence.ErrBlobNotFound)
+}
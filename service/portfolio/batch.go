@@ -0,0 +1,278 @@
+// Copyright 2023 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package portfolio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	portfoliov1 "github.com/oxisto/money-gopher/gen"
+	"github.com/oxisto/money-gopher/persistence"
+	"github.com/oxisto/money-gopher/service/audit"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// ErrDuplicateTransaction is returned, if a batch contains the same
+// transaction more than once.
+var ErrDuplicateTransaction = errors.New("the batch contains the same transaction more than once")
+
+// The batch operations first validate all transactions and check whether the
+// user is allowed to change them. Only then, all changes are written within a
+// single database transaction, so that either all or none of them are applied.
+// The audit log is written after the changes were committed.
+
+func (svc *service) BatchCreatePortfolioTransactions(ctx context.Context, req *connect.Request[portfoliov1.BatchCreatePortfolioTransactionsRequest]) (res *connect.Response[portfoliov1.BatchCreatePortfolioTransactionsResponse], err error) {
+	var (
+		txs        = make([]*portfoliov1.PortfolioEvent, 0, len(req.Msg.Requests))
+		portfolios []string
+	)
+
+	for i, r := range req.Msg.Requests {
+		err = validateTransaction(r.Transaction)
+		if err != nil {
+			return nil, batchError(i, err)
+		}
+
+		if !slices.Contains(portfolios, r.Transaction.PortfolioId) {
+			err = svc.requirePortfolioAccess(ctx, r.Transaction.PortfolioId, portfoliov1.PortfolioAccess_PORTFOLIO_ACCESS_WRITE)
+			if err != nil {
+				return nil, batchError(i, err)
+			}
+
+			portfolios = append(portfolios, r.Transaction.PortfolioId)
+		}
+
+		// Create a unique name for the transaction. Identical transactions end
+		// up with the same name and would overwrite each other.
+		r.Transaction.MakeUniqueID()
+		if slices.ContainsFunc(txs, func(tx *portfoliov1.PortfolioEvent) bool { return tx.Id == r.Transaction.Id }) {
+			return nil, batchError(i, connect.NewError(connect.CodeInvalidArgument, ErrDuplicateTransaction))
+		}
+
+		txs = append(txs, r.Transaction)
+	}
+
+	slog.Info("Creating transactions", "count", len(txs))
+
+	err = persistence.InTx(ctx, svc.events, func(op persistence.StorageOperations[*portfoliov1.PortfolioEvent]) error {
+		for i, tx := range txs {
+			err := op.Replace(ctx, tx)
+			if err != nil {
+				return batchError(i, connect.NewError(connect.CodeInternal, err))
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tx := range txs {
+		audit.Record(ctx, audit.Event{
+			Action: portfoliov1.AuditAction_AUDIT_ACTION_CREATE,
+			After:  tx,
+		})
+	}
+
+	svc.publish(sorted(portfolios)...)
+
+	return connect.NewResponse(&portfoliov1.BatchCreatePortfolioTransactionsResponse{
+		Transactions: txs,
+	}), nil
+}
+
+func (svc *service) BatchUpdatePortfolioTransactions(ctx context.Context, req *connect.Request[portfoliov1.BatchUpdatePortfolioTransactionsRequest]) (res *connect.Response[portfoliov1.BatchUpdatePortfolioTransactionsResponse], err error) {
+	var (
+		before     = make([]*portfoliov1.PortfolioEvent, 0, len(req.Msg.Requests))
+		after      = make([]*portfoliov1.PortfolioEvent, 0, len(req.Msg.Requests))
+		portfolios []string
+	)
+
+	for i, r := range req.Msg.Requests {
+		var old *portfoliov1.PortfolioEvent
+
+		if r.Transaction == nil {
+			return nil, batchError(i, connect.NewError(connect.CodeInvalidArgument, ErrMissingTransaction))
+		}
+
+		if slices.ContainsFunc(before, func(tx *portfoliov1.PortfolioEvent) bool { return tx.Id == r.Transaction.Id }) {
+			return nil, batchError(i, connect.NewError(connect.CodeInvalidArgument, ErrDuplicateTransaction))
+		}
+
+		old, err = svc.requireTransactionAccess(ctx, r.Transaction.Id, portfoliov1.PortfolioAccess_PORTFOLIO_ACCESS_WRITE)
+		if err != nil {
+			return nil, batchError(i, err)
+		}
+
+		err = validateUpdate(old, r.Transaction, r.GetUpdateMask().GetPaths())
+		if err != nil {
+			return nil, batchError(i, err)
+		}
+
+		portfolios = append(portfolios, old.PortfolioId)
+
+		// If the transaction is moved to another portfolio, we also need to be
+		// allowed to write to it
+		if slices.Contains(r.GetUpdateMask().GetPaths(), "portfolio_id") {
+			err = svc.requirePortfolioAccess(ctx, r.Transaction.PortfolioId, portfoliov1.PortfolioAccess_PORTFOLIO_ACCESS_WRITE)
+			if err != nil {
+				return nil, batchError(i, err)
+			}
+
+			portfolios = append(portfolios, r.Transaction.PortfolioId)
+		}
+
+		before = append(before, old)
+	}
+
+	slog.Info("Updating transactions", "count", len(before))
+
+	err = persistence.InTx(ctx, svc.events, func(op persistence.StorageOperations[*portfoliov1.PortfolioEvent]) error {
+		for i, r := range req.Msg.Requests {
+			out, err := op.Update(ctx, r.Transaction.Id, r.Transaction, r.GetUpdateMask().GetPaths())
+			if err != nil {
+				return batchError(i, connect.NewError(connect.CodeInternal, err))
+			}
+
+			after = append(after, out)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i, r := range req.Msg.Requests {
+		audit.Record(ctx, audit.Event{
+			Action:     portfoliov1.AuditAction_AUDIT_ACTION_UPDATE,
+			ResourceID: r.Transaction.Id,
+			Paths:      r.GetUpdateMask().GetPaths(),
+			Before:     before[i],
+			After:      after[i],
+		})
+	}
+
+	svc.publish(sorted(portfolios)...)
+
+	return connect.NewResponse(&portfoliov1.BatchUpdatePortfolioTransactionsResponse{
+		Transactions: after,
+	}), nil
+}
+
+func (svc *service) BatchDeletePortfolioTransactions(ctx context.Context, req *connect.Request[portfoliov1.BatchDeletePortfolioTransactionsRequest]) (res *connect.Response[emptypb.Empty], err error) {
+	var (
+		ids        = make([]string, 0, len(req.Msg.Ids))
+		before     = make([]*portfoliov1.PortfolioEvent, 0, len(req.Msg.Ids))
+		portfolios []string
+
+		// reqs contains the index of the request that caused the deletion of
+		// each entry of ids, since they also contain the other sides of
+		// transfers
+		reqs = make([]int, 0, len(req.Msg.Ids))
+	)
+
+	for i, id := range req.Msg.Ids {
+		var (
+			old   *portfoliov1.PortfolioEvent
+			sides []string
+		)
+
+		if slices.Contains(ids, id) {
+			continue
+		}
+
+		old, err = svc.requireTransactionAccess(ctx, id, portfoliov1.PortfolioAccess_PORTFOLIO_ACCESS_WRITE)
+		if err != nil {
+			return nil, batchError(i, err)
+		}
+
+		// Transactions that are part of a transfer are always deleted together
+		// with the other side(s) of the transfer
+		sides, err = svc.transferSides(ctx, old)
+		if err != nil {
+			return nil, batchError(i, err)
+		}
+
+		for _, side := range sides {
+			tx := old
+			if side != id {
+				tx, err = svc.requireTransactionAccess(ctx, side, portfoliov1.PortfolioAccess_PORTFOLIO_ACCESS_WRITE)
+				if err != nil {
+					return nil, batchError(i, err)
+				}
+			}
+
+			ids = append(ids, side)
+			reqs = append(reqs, i)
+			portfolios = append(portfolios, tx.PortfolioId)
+			before = append(before, tx)
+		}
+	}
+
+	slog.Info("Deleting transactions", "count", len(before))
+
+	err = persistence.InTx(ctx, svc.events, func(op persistence.StorageOperations[*portfoliov1.PortfolioEvent]) error {
+		for i, id := range ids {
+			err := op.Delete(ctx, id)
+			if err != nil {
+				return batchError(reqs[i], connect.NewError(connect.CodeInternal, err))
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i, id := range ids {
+		audit.Record(ctx, audit.Event{
+			Action:     portfoliov1.AuditAction_AUDIT_ACTION_DELETE,
+			ResourceID: id,
+			Before:     before[i],
+		})
+	}
+
+	svc.publish(sorted(portfolios)...)
+
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+// batchError adds the index of the failed request to err, but keeps its code.
+func batchError(i int, err error) error {
+	var cerr *connect.Error
+
+	code := connect.CodeOf(err)
+	if errors.As(err, &cerr) {
+		err = cerr.Unwrap()
+	}
+
+	return connect.NewError(code, fmt.Errorf("request %d: %w", i, err))
+}
+
+// sorted sorts ids, so that duplicates can be removed by [service.publish].
+func sorted(ids []string) []string {
+	slices.Sort(ids)
+	return ids
+}
@@ -0,0 +1,341 @@
+// Copyright 2023 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package portfolio
+
+import (
+	"context"
+	"testing"
+
+	portfoliov1 "github.com/oxisto/money-gopher/gen"
+	"github.com/oxisto/money-gopher/internal"
+	"github.com/oxisto/money-gopher/persistence"
+
+	"connectrpc.com/connect"
+	"github.com/oxisto/assert"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func Test_service_BatchCreatePortfolioTransactions(t *testing.T) {
+	type args struct {
+		req *connect.Request[portfoliov1.BatchCreatePortfolioTransactionsRequest]
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantRes  assert.Want[*connect.Response[portfoliov1.BatchCreatePortfolioTransactionsResponse]]
+		wantSvc  assert.Want[*service]
+		wantCode connect.Code
+	}{
+		{
+			name: "happy path",
+			args: args{
+				req: connect.NewRequest(&portfoliov1.BatchCreatePortfolioTransactionsRequest{
+					Requests: []*portfoliov1.CreatePortfolioTransactionRequest{
+						{Transaction: &portfoliov1.PortfolioEvent{
+							PortfolioId: "mybank-myportfolio",
+							Type:        portfoliov1.PortfolioEventType_PORTFOLIO_EVENT_TYPE_BUY,
+							SecurityId:  "My Security",
+							Amount:      1,
+							Price:       portfoliov1.Value(2000),
+						}},
+						{Transaction: &portfoliov1.PortfolioEvent{
+							PortfolioId: "mybank-myportfolio",
+							Type:        portfoliov1.PortfolioEventType_PORTFOLIO_EVENT_TYPE_DEPOSIT_CASH,
+							Price:       portfoliov1.Value(5000),
+						}},
+					},
+				}),
+			},
+			wantRes: func(t *testing.T, r *connect.Response[portfoliov1.BatchCreatePortfolioTransactionsResponse]) bool {
+				return assert.Equals(t, 2, len(r.Msg.Transactions)) &&
+					assert.NotEquals(t, "", r.Msg.Transactions[0].Id)
+			},
+			wantSvc: func(t *testing.T, s *service) bool {
+				list, _ := s.events.List(context.Background(), "mybank-myportfolio")
+				return assert.Equals(t, 4, len(list))
+			},
+		},
+		{
+			name: "one invalid transaction",
+			args: args{
+				req: connect.NewRequest(&portfoliov1.BatchCreatePortfolioTransactionsRequest{
+					Requests: []*portfoliov1.CreatePortfolioTransactionRequest{
+						{Transaction: &portfoliov1.PortfolioEvent{
+							PortfolioId: "mybank-myportfolio",
+							Type:        portfoliov1.PortfolioEventType_PORTFOLIO_EVENT_TYPE_BUY,
+							SecurityId:  "My Security",
+							Amount:      1,
+							Price:       portfoliov1.Value(2000),
+						}},
+						{Transaction: &portfoliov1.PortfolioEvent{
+							PortfolioId: "mybank-myportfolio",
+							Type:        portfoliov1.PortfolioEventType_PORTFOLIO_EVENT_TYPE_BUY,
+							Price:       portfoliov1.Value(2000),
+						}},
+					},
+				}),
+			},
+			wantRes: func(t *testing.T, r *connect.Response[portfoliov1.BatchCreatePortfolioTransactionsResponse]) bool {
+				return assert.Equals(t, nil, r)
+			},
+			wantSvc: func(t *testing.T, s *service) bool {
+				list, _ := s.events.List(context.Background(), "mybank-myportfolio")
+				return assert.Equals(t, 2, len(list))
+			},
+			wantCode: connect.CodeInvalidArgument,
+		},
+		{
+			name: "same transaction twice",
+			args: args{
+				req: connect.NewRequest(&portfoliov1.BatchCreatePortfolioTransactionsRequest{
+					Requests: []*portfoliov1.CreatePortfolioTransactionRequest{
+						{Transaction: &portfoliov1.PortfolioEvent{
+							PortfolioId: "mybank-myportfolio",
+							Type:        portfoliov1.PortfolioEventType_PORTFOLIO_EVENT_TYPE_DEPOSIT_CASH,
+							Price:       portfoliov1.Value(5000),
+						}},
+						{Transaction: &portfoliov1.PortfolioEvent{
+							PortfolioId: "mybank-myportfolio",
+							Type:        portfoliov1.PortfolioEventType_PORTFOLIO_EVENT_TYPE_DEPOSIT_CASH,
+							Price:       portfoliov1.Value(5000),
+						}},
+					},
+				}),
+			},
+			wantRes: func(t *testing.T, r *connect.Response[portfoliov1.BatchCreatePortfolioTransactionsResponse]) bool {
+				return assert.Equals(t, nil, r)
+			},
+			wantSvc: func(t *testing.T, s *service) bool {
+				list, _ := s.events.List(context.Background(), "mybank-myportfolio")
+				return assert.Equals(t, 2, len(list))
+			},
+			wantCode: connect.CodeInvalidArgument,
+		},
+		{
+			name: "unknown portfolio",
+			args: args{
+				req: connect.NewRequest(&portfoliov1.BatchCreatePortfolioTransactionsRequest{
+					Requests: []*portfoliov1.CreatePortfolioTransactionRequest{
+						{Transaction: &portfoliov1.PortfolioEvent{
+							PortfolioId: "does-not-exist",
+							Type:        portfoliov1.PortfolioEventType_PORTFOLIO_EVENT_TYPE_DEPOSIT_CASH,
+							Price:       portfoliov1.Value(5000),
+						}},
+					},
+				}),
+			},
+			wantRes: func(t *testing.T, r *connect.Response[portfoliov1.BatchCreatePortfolioTransactionsResponse]) bool {
+				return assert.Equals(t, nil, r)
+			},
+			wantCode: connect.CodeNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			portfolios := myPortfolio(t)
+			svc := &service{
+				portfolios: portfolios,
+				events:     persistence.Relationship[*portfoliov1.PortfolioEvent](portfolios),
+				shares:     persistence.Relationship[*portfoliov1.PortfolioShare](portfolios),
+			}
+			gotRes, err := svc.BatchCreatePortfolioTransactions(internal.WithTestUser(context.Background()), tt.args.req)
+			if (err != nil || tt.wantCode != 0) && !assert.Equals(t, tt.wantCode, connect.CodeOf(err)) {
+				return
+			}
+			tt.wantRes(t, gotRes)
+			if tt.wantSvc != nil {
+				tt.wantSvc(t, svc)
+			}
+		})
+	}
+}
+
+func Test_service_BatchUpdatePortfolioTransactions(t *testing.T) {
+	type args struct {
+		req *connect.Request[portfoliov1.BatchUpdatePortfolioTransactionsRequest]
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantRes  assert.Want[*connect.Response[portfoliov1.BatchUpdatePortfolioTransactionsResponse]]
+		wantSvc  assert.Want[*service]
+		wantCode connect.Code
+	}{
+		{
+			name: "happy path",
+			args: args{
+				req: connect.NewRequest(&portfoliov1.BatchUpdatePortfolioTransactionsRequest{
+					Requests: []*portfoliov1.UpdatePortfolioTransactionRequest{
+						{
+							Transaction: &portfoliov1.PortfolioEvent{Id: "buy", SecurityId: "DE0005190003"},
+							UpdateMask:  &fieldmaskpb.FieldMask{Paths: []string{"security_id"}},
+						},
+						{
+							Transaction: &portfoliov1.PortfolioEvent{Id: "sell", SecurityId: "DE0005190003"},
+							UpdateMask:  &fieldmaskpb.FieldMask{Paths: []string{"security_id"}},
+						},
+					},
+				}),
+			},
+			wantRes: func(t *testing.T, r *connect.Response[portfoliov1.BatchUpdatePortfolioTransactionsResponse]) bool {
+				return assert.Equals(t, 2, len(r.Msg.Transactions)) &&
+					assert.Equals(t, "DE0005190003", r.Msg.Transactions[1].SecurityId) &&
+					assert.Equals(t, 10.0, r.Msg.Transactions[1].Amount)
+			},
+			wantSvc: func(t *testing.T, s *service) bool {
+				list, _ := s.events.List(context.Background(), "mybank-myportfolio")
+				return assert.Equals(t, "DE0005190003", list[0].SecurityId) &&
+					assert.Equals(t, "DE0005190003", list[1].SecurityId)
+			},
+		},
+		{
+			name: "failure rolls back all updates",
+			args: args{
+				req: connect.NewRequest(&portfoliov1.BatchUpdatePortfolioTransactionsRequest{
+					Requests: []*portfoliov1.UpdatePortfolioTransactionRequest{
+						{
+							Transaction: &portfoliov1.PortfolioEvent{Id: "buy", SecurityId: "DE0005190003"},
+							UpdateMask:  &fieldmaskpb.FieldMask{Paths: []string{"security_id"}},
+						},
+						{
+							Transaction: &portfoliov1.PortfolioEvent{Id: "sell", SecurityId: "DE0005190003"},
+							UpdateMask:  &fieldmaskpb.FieldMask{Paths: []string{"no_such_column"}},
+						},
+					},
+				}),
+			},
+			wantRes: func(t *testing.T, r *connect.Response[portfoliov1.BatchUpdatePortfolioTransactionsResponse]) bool {
+				return assert.Equals(t, nil, r)
+			},
+			wantSvc: func(t *testing.T, s *service) bool {
+				list, _ := s.events.List(context.Background(), "mybank-myportfolio")
+				return assert.Equals(t, "US0378331005", list[0].SecurityId) &&
+					assert.Equals(t, "US0378331005", list[1].SecurityId)
+			},
+			wantCode: connect.CodeInternal,
+		},
+		{
+			name: "same transaction twice",
+			args: args{
+				req: connect.NewRequest(&portfoliov1.BatchUpdatePortfolioTransactionsRequest{
+					Requests: []*portfoliov1.UpdatePortfolioTransactionRequest{
+						{
+							Transaction: &portfoliov1.PortfolioEvent{Id: "buy", SecurityId: "DE0005190003"},
+							UpdateMask:  &fieldmaskpb.FieldMask{Paths: []string{"security_id"}},
+						},
+						{
+							Transaction: &portfoliov1.PortfolioEvent{Id: "buy", Amount: 1},
+							UpdateMask:  &fieldmaskpb.FieldMask{Paths: []string{"amount"}},
+						},
+					},
+				}),
+			},
+			wantRes: func(t *testing.T, r *connect.Response[portfoliov1.BatchUpdatePortfolioTransactionsResponse]) bool {
+				return assert.Equals(t, nil, r)
+			},
+			wantCode: connect.CodeInvalidArgument,
+		},
+		{
+			name: "unknown transaction",
+			args: args{
+				req: connect.NewRequest(&portfoliov1.BatchUpdatePortfolioTransactionsRequest{
+					Requests: []*portfoliov1.UpdatePortfolioTransactionRequest{
+						{
+							Transaction: &portfoliov1.PortfolioEvent{Id: "does-not-exist"},
+							UpdateMask:  &fieldmaskpb.FieldMask{Paths: []string{"security_id"}},
+						},
+					},
+				}),
+			},
+			wantRes: func(t *testing.T, r *connect.Response[portfoliov1.BatchUpdatePortfolioTransactionsResponse]) bool {
+				return assert.Equals(t, nil, r)
+			},
+			wantCode: connect.CodeNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			portfolios := myPortfolio(t)
+			svc := &service{
+				portfolios: portfolios,
+				events:     persistence.Relationship[*portfoliov1.PortfolioEvent](portfolios),
+				shares:     persistence.Relationship[*portfoliov1.PortfolioShare](portfolios),
+			}
+			gotRes, err := svc.BatchUpdatePortfolioTransactions(internal.WithTestUser(context.Background()), tt.args.req)
+			if (err != nil || tt.wantCode != 0) && !assert.Equals(t, tt.wantCode, connect.CodeOf(err)) {
+				return
+			}
+			tt.wantRes(t, gotRes)
+			if tt.wantSvc != nil {
+				tt.wantSvc(t, svc)
+			}
+		})
+	}
+}
+
+func Test_service_BatchDeletePortfolioTransactions(t *testing.T) {
+	type args struct {
+		req *connect.Request[portfoliov1.BatchDeletePortfolioTransactionsRequest]
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantSvc  assert.Want[*service]
+		wantCode connect.Code
+	}{
+		{
+			name: "happy path",
+			args: args{
+				req: connect.NewRequest(&portfoliov1.BatchDeletePortfolioTransactionsRequest{
+					Ids: []string{"buy", "sell"},
+				}),
+			},
+			wantSvc: func(t *testing.T, s *service) bool {
+				list, _ := s.events.List(context.Background(), "mybank-myportfolio")
+				return assert.Equals(t, 0, len(list))
+			},
+		},
+		{
+			name: "unknown transaction",
+			args: args{
+				req: connect.NewRequest(&portfoliov1.BatchDeletePortfolioTransactionsRequest{
+					Ids: []string{"buy", "does-not-exist"},
+				}),
+			},
+			wantSvc: func(t *testing.T, s *service) bool {
+				list, _ := s.events.List(context.Background(), "mybank-myportfolio")
+				return assert.Equals(t, 2, len(list))
+			},
+			wantCode: connect.CodeNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			portfolios := myPortfolio(t)
+			svc := &service{
+				portfolios: portfolios,
+				events:     persistence.Relationship[*portfoliov1.PortfolioEvent](portfolios),
+				shares:     persistence.Relationship[*portfoliov1.PortfolioShare](portfolios),
+			}
+			_, err := svc.BatchDeletePortfolioTransactions(internal.WithTestUser(context.Background()), tt.args.req)
+			if (err != nil || tt.wantCode != 0) && !assert.Equals(t, tt.wantCode, connect.CodeOf(err)) {
+				return
+			}
+			tt.wantSvc(t, svc)
+		})
+	}
+}
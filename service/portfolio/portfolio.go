@@ -18,9 +18,15 @@ package portfolio
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"slices"
 
+	"github.com/oxisto/money-gopher/auth"
 	portfoliov1 "github.com/oxisto/money-gopher/gen"
+	"github.com/oxisto/money-gopher/persistence"
 	"github.com/oxisto/money-gopher/service/internal/crud"
+	"github.com/oxisto/money-gopher/service/internal/pagination"
 
 	"connectrpc.com/connect"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -31,7 +37,45 @@ var portfolioSetter = func(obj *portfoliov1.Portfolio) *portfoliov1.Portfolio {
 }
 
 func (svc *service) CreatePortfolio(ctx context.Context, req *connect.Request[portfoliov1.CreatePortfolioRequest]) (res *connect.Response[portfoliov1.Portfolio], err error) {
+	var (
+		u        *auth.User
+		existing *portfoliov1.Portfolio
+	)
+
+	u, err = currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Make sure that we do not replace the portfolio of another user
+	existing, err = svc.portfolios.Get(ctx, req.Msg.Portfolio.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	} else if existing != nil && existing.Owner != u.Subject {
+		return nil, connect.NewError(connect.CodeAlreadyExists, ErrAlreadyExists)
+	}
+
+	// A portfolio in the trash can only be restored, otherwise we would mix
+	// its transactions with the ones of the new portfolio
+	_, err = svc.q.GetTrashedPortfolio(ctx, req.Msg.Portfolio.Id)
+	if err == nil {
+		return nil, connect.NewError(connect.CodeAlreadyExists, ErrAlreadyExists)
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	// Transactions can only settle against a bank account of the user
+	if req.Msg.Portfolio.BankAccountId != "" {
+		_, err = svc.requireBankAccountOwner(ctx, req.Msg.Portfolio.BankAccountId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	req.Msg.Portfolio.Owner = u.Subject
+
 	return crud.Create(
+		ctx,
 		req.Msg.Portfolio,
 		svc.portfolios,
 		portfolioSetter,
@@ -39,16 +83,34 @@ func (svc *service) CreatePortfolio(ctx context.Context, req *connect.Request[po
 }
 
 func (svc *service) ListPortfolios(ctx context.Context, req *connect.Request[portfoliov1.ListPortfoliosRequest]) (res *connect.Response[portfoliov1.ListPortfoliosResponse], err error) {
-	return crud.List(
+	u, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	opts, err := pagination.Options(req.Msg.PageSize, req.Msg.PageToken, req.Msg.OrderBy, "id", "display_name")
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Msg.Tag != "" {
+		opts.Filters = append(opts.Filters, persistence.Filter{Column: "tags", Operator: persistence.OperatorContains, Value: req.Msg.Tag})
+	}
+
+	return crud.ListWithOptions(
+		ctx,
 		svc.portfolios,
+		opts,
 		func(
 			res *connect.Response[portfoliov1.ListPortfoliosResponse],
 			list []*portfoliov1.Portfolio,
+			nextPageToken string,
 		) error {
 			res.Msg.Portfolios = list
+			res.Msg.NextPageToken = nextPageToken
 
 			for _, p := range res.Msg.Portfolios {
-				p.Events, err = svc.events.List(p.Id)
+				p.Events, err = svc.events.List(ctx, p.Id)
 				if err != nil {
 					return err
 				}
@@ -56,15 +118,22 @@ func (svc *service) ListPortfolios(ctx context.Context, req *connect.Request[por
 
 			return nil
 		},
+		u.Subject,
 	)
 }
 
 func (svc *service) GetPortfolio(ctx context.Context, req *connect.Request[portfoliov1.GetPortfolioRequest]) (res *connect.Response[portfoliov1.Portfolio], err error) {
+	err = svc.requirePortfolioAccess(ctx, req.Msg.Id, portfoliov1.PortfolioAccess_PORTFOLIO_ACCESS_READ)
+	if err != nil {
+		return nil, err
+	}
+
 	return crud.Get(
+		ctx,
 		req.Msg.Id,
 		svc.portfolios,
 		func(obj *portfoliov1.Portfolio) *portfoliov1.Portfolio {
-			obj.Events, _ = svc.events.List(obj.Id)
+			obj.Events, _ = svc.events.List(ctx, obj.Id)
 
 			return obj
 		},
@@ -72,17 +141,51 @@ func (svc *service) GetPortfolio(ctx context.Context, req *connect.Request[portf
 }
 
 func (svc *service) UpdatePortfolio(ctx context.Context, req *connect.Request[portfoliov1.UpdatePortfolioRequest]) (res *connect.Response[portfoliov1.Portfolio], err error) {
+	err = svc.requirePortfolioAccess(ctx, req.Msg.Portfolio.Id, portfoliov1.PortfolioAccess_PORTFOLIO_ACCESS_WRITE)
+	if err != nil {
+		return nil, err
+	}
+
+	// The bank account decides whose cash ledger the portfolio settles
+	// against, so only the owner of the portfolio can change it and only to
+	// one of their own accounts
+	if slices.Contains(req.Msg.UpdateMask.Paths, "bank_account_id") {
+		err = svc.requirePortfolioAccess(ctx, req.Msg.Portfolio.Id, accessOwner)
+		if err != nil {
+			return nil, err
+		}
+
+		if req.Msg.Portfolio.BankAccountId != "" {
+			_, err = svc.requireBankAccountOwner(ctx, req.Msg.Portfolio.BankAccountId)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	return crud.Update(
+		ctx,
 		req.Msg.Portfolio.Id,
 		req.Msg.Portfolio,
 		req.Msg.UpdateMask.Paths,
 		svc.portfolios,
-		func(obj *portfoliov1.Portfolio) *portfoliov1.Portfolio {
-			return obj
-		},
+		portfolioSetter,
 	)
 }
 
 func (svc *service) DeletePortfolio(ctx context.Context, req *connect.Request[portfoliov1.DeletePortfolioRequest]) (res *connect.Response[emptypb.Empty], err error) {
-	return crud.Delete(req.Msg.Id, svc.portfolios)
+	err = svc.requirePortfolioAccess(ctx, req.Msg.Id, accessOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	// The portfolio and its transactions are only moved to the trash. Its
+	// shares are kept, so that they apply again once it is restored, and are
+	// removed when the trash is purged.
+	res, err = crud.Delete(ctx, req.Msg.Id, svc.portfolios)
+	if err == nil {
+		svc.publish(req.Msg.Id)
+	}
+
+	return
 }
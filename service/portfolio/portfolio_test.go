@@ -34,12 +34,14 @@ import (
 
 func myPortfolio(t *testing.T) persistence.StorageOperations[*portfoliov1.Portfolio] {
 	return internal.NewTestDBOps(t, func(ops persistence.StorageOperations[*portfoliov1.Portfolio]) {
-		assert.NoError(t, ops.Replace(&portfoliov1.Portfolio{
+		assert.NoError(t, ops.Replace(context.Background(), &portfoliov1.Portfolio{
 			Id:          "mybank-myportfolio",
 			DisplayName: "My Portfolio",
+			Owner:       internal.TestUser,
+			Tags:        []string{"retirement"},
 		}))
 		rel := persistence.Relationship[*portfoliov1.PortfolioEvent](ops)
-		assert.NoError(t, rel.Replace(&portfoliov1.PortfolioEvent{
+		assert.NoError(t, rel.Replace(context.Background(), &portfoliov1.PortfolioEvent{
 			Id:          "buy",
 			Type:        portfoliov1.PortfolioEventType_PORTFOLIO_EVENT_TYPE_BUY,
 			PortfolioId: "mybank-myportfolio",
@@ -48,8 +50,10 @@ func myPortfolio(t *testing.T) persistence.StorageOperations[*portfoliov1.Portfo
 			Price:       portfoliov1.Value(10708),
 			Fees:        portfoliov1.Value(1025),
 			Time:        timestamppb.New(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)),
+			Note:        "Bought for the long term",
+			Tags:        []string{"long-term"},
 		}))
-		assert.NoError(t, rel.Replace(&portfoliov1.PortfolioEvent{
+		assert.NoError(t, rel.Replace(context.Background(), &portfoliov1.PortfolioEvent{
 			Id:          "sell",
 			Type:        portfoliov1.PortfolioEventType_PORTFOLIO_EVENT_TYPE_SELL,
 			PortfolioId: "mybank-myportfolio",
@@ -64,21 +68,23 @@ func myPortfolio(t *testing.T) persistence.StorageOperations[*portfoliov1.Portfo
 
 func myCash(t *testing.T) persistence.StorageOperations[*portfoliov1.BankAccount] {
 	return internal.NewTestDBOps(t, func(ops persistence.StorageOperations[*portfoliov1.BankAccount]) {
-		assert.NoError(t, ops.Replace(&portfoliov1.BankAccount{
+		assert.NoError(t, ops.Replace(context.Background(), &portfoliov1.BankAccount{
 			Id:          "mybank-mycash",
 			DisplayName: "My Cash",
+			Owner:       internal.TestUser,
 		}))
 	})
 }
 
 func zeroPositions(t *testing.T) persistence.StorageOperations[*portfoliov1.Portfolio] {
 	return internal.NewTestDBOps(t, func(ops persistence.StorageOperations[*portfoliov1.Portfolio]) {
-		assert.NoError(t, ops.Replace(&portfoliov1.Portfolio{
+		assert.NoError(t, ops.Replace(context.Background(), &portfoliov1.Portfolio{
 			Id:          "mybank-myportfolio",
 			DisplayName: "My Portfolio",
+			Owner:       internal.TestUser,
 		}))
 		rel := persistence.Relationship[*portfoliov1.PortfolioEvent](ops)
-		assert.NoError(t, rel.Replace(&portfoliov1.PortfolioEvent{
+		assert.NoError(t, rel.Replace(context.Background(), &portfoliov1.PortfolioEvent{
 			Id:          "buy",
 			Type:        portfoliov1.PortfolioEventType_PORTFOLIO_EVENT_TYPE_BUY,
 			PortfolioId: "mybank-myportfolio",
@@ -88,7 +94,7 @@ func zeroPositions(t *testing.T) persistence.StorageOperations[*portfoliov1.Port
 			Fees:        portfoliov1.Zero(),
 			Time:        timestamppb.New(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)),
 		}))
-		assert.NoError(t, rel.Replace(&portfoliov1.PortfolioEvent{
+		assert.NoError(t, rel.Replace(context.Background(), &portfoliov1.PortfolioEvent{
 			Id:          "sell",
 			Type:        portfoliov1.PortfolioEventType_PORTFOLIO_EVENT_TYPE_SELL,
 			PortfolioId: "mybank-myportfolio",
@@ -103,17 +109,21 @@ func zeroPositions(t *testing.T) persistence.StorageOperations[*portfoliov1.Port
 
 func emptyPortfolio(t *testing.T) persistence.StorageOperations[*portfoliov1.Portfolio] {
 	return internal.NewTestDBOps(t, func(ops persistence.StorageOperations[*portfoliov1.Portfolio]) {
-		assert.NoError(t, ops.Replace(&portfoliov1.Portfolio{
+		assert.NoError(t, ops.Replace(context.Background(), &portfoliov1.Portfolio{
 			Id:          "mybank-myportfolio",
 			DisplayName: "My Portfolio",
+			Owner:       internal.TestUser,
 		}))
 	})
 }
 
 func Test_service_CreatePortfolio(t *testing.T) {
+	db := internal.NewTestDB(t)
+
 	type fields struct {
 		portfolios persistence.StorageOperations[*portfoliov1.Portfolio]
 		securities portfoliov1connect.SecuritiesServiceClient
+		q          *persistence.Queries
 	}
 	type args struct {
 		ctx context.Context
@@ -130,7 +140,8 @@ func Test_service_CreatePortfolio(t *testing.T) {
 		{
 			name: "happy path",
 			fields: fields{
-				portfolios: internal.NewTestDBOps[*portfoliov1.Portfolio](t),
+				portfolios: persistence.Ops[*portfoliov1.Portfolio](db),
+				q:          persistence.New(db),
 			},
 			args: args{
 				req: connect.NewRequest(&portfoliov1.CreatePortfolioRequest{
@@ -143,10 +154,11 @@ func Test_service_CreatePortfolio(t *testing.T) {
 			wantRes: func(t *testing.T, r *connect.Response[portfoliov1.Portfolio]) bool {
 				return true &&
 					assert.Equals(t, "mybank-myportfolio", r.Msg.Id) &&
-					assert.Equals(t, "My Portfolio", r.Msg.DisplayName)
+					assert.Equals(t, "My Portfolio", r.Msg.DisplayName) &&
+					assert.Equals(t, internal.TestUser, r.Msg.Owner)
 			},
 			wantSvc: func(t *testing.T, s *service) bool {
-				list, _ := s.portfolios.List()
+				list, _ := s.portfolios.List(context.Background(), internal.TestUser)
 				return assert.Equals(t, 1, len(list))
 			},
 		},
@@ -157,8 +169,9 @@ func Test_service_CreatePortfolio(t *testing.T) {
 				portfolios: tt.fields.portfolios,
 				events:     persistence.Relationship[*portfoliov1.PortfolioEvent](tt.fields.portfolios),
 				securities: tt.fields.securities,
+				q:          tt.fields.q,
 			}
-			gotRes, err := svc.CreatePortfolio(tt.args.ctx, tt.args.req)
+			gotRes, err := svc.CreatePortfolio(internal.WithTestUser(tt.args.ctx), tt.args.req)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("service.CreatePortfolio() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -190,6 +203,9 @@ func Test_service_ListPortfolios(t *testing.T) {
 			fields: fields{
 				portfolios: myPortfolio(t),
 			},
+			args: args{
+				req: connect.NewRequest(&portfoliov1.ListPortfoliosRequest{}),
+			},
 			wantRes: func(t *testing.T, r *connect.Response[portfoliov1.ListPortfoliosResponse]) bool {
 				return true &&
 					assert.Equals(t, "mybank-myportfolio", r.Msg.Portfolios[0].Id) &&
@@ -197,6 +213,18 @@ func Test_service_ListPortfolios(t *testing.T) {
 					assert.Equals(t, 2, len(r.Msg.Portfolios[0].Events))
 			},
 		},
+		{
+			name: "filtered by tag",
+			fields: fields{
+				portfolios: myPortfolio(t),
+			},
+			args: args{
+				req: connect.NewRequest(&portfoliov1.ListPortfoliosRequest{Tag: "speculation"}),
+			},
+			wantRes: func(t *testing.T, r *connect.Response[portfoliov1.ListPortfoliosResponse]) bool {
+				return assert.Equals(t, 0, len(r.Msg.Portfolios))
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -205,7 +233,7 @@ func Test_service_ListPortfolios(t *testing.T) {
 				events:     persistence.Relationship[*portfoliov1.PortfolioEvent](tt.fields.portfolios),
 				securities: tt.fields.securities,
 			}
-			gotRes, err := svc.ListPortfolios(tt.args.ctx, tt.args.req)
+			gotRes, err := svc.ListPortfolios(internal.WithTestUser(tt.args.ctx), tt.args.req)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("service.ListPortfolios() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -254,7 +282,7 @@ func Test_service_GetPortfolio(t *testing.T) {
 				events:     persistence.Relationship[*portfoliov1.PortfolioEvent](tt.fields.portfolios),
 				securities: tt.fields.securities,
 			}
-			gotRes, err := svc.GetPortfolio(tt.args.ctx, tt.args.req)
+			gotRes, err := svc.GetPortfolio(internal.WithTestUser(tt.args.ctx), tt.args.req)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("service.GetPortfolio() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -306,7 +334,7 @@ func Test_service_UpdatePortfolio(t *testing.T) {
 				events:     persistence.Relationship[*portfoliov1.PortfolioEvent](tt.fields.portfolios),
 				securities: tt.fields.securities,
 			}
-			gotRes, err := svc.UpdatePortfolio(tt.args.ctx, tt.args.req)
+			gotRes, err := svc.UpdatePortfolio(internal.WithTestUser(tt.args.ctx), tt.args.req)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("service.UpdatePortfolio() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -345,7 +373,7 @@ func Test_service_DeletePortfolio(t *testing.T) {
 				}),
 			},
 			wantSvc: func(t *testing.T, s *service) bool {
-				list, _ := s.portfolios.List()
+				list, _ := s.portfolios.List(context.Background(), internal.TestUser)
 				return assert.Equals(t, 0, len(list))
 			},
 		},
@@ -355,10 +383,11 @@ func Test_service_DeletePortfolio(t *testing.T) {
 			svc := &service{
 				portfolios:                           tt.fields.portfolios,
 				events:                               tt.fields.events,
+				shares:                               persistence.Relationship[*portfoliov1.PortfolioShare](tt.fields.portfolios),
 				securities:                           tt.fields.securities,
 				UnimplementedPortfolioServiceHandler: tt.fields.UnimplementedPortfolioServiceHandler,
 			}
-			_, err := svc.DeletePortfolio(tt.args.ctx, tt.args.req)
+			_, err := svc.DeletePortfolio(internal.WithTestUser(tt.args.ctx), tt.args.req)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("service.DeletePortfolio() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -0,0 +1,603 @@
+// Copyright 2023 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package portfolio
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"time"
+
+	portfoliov1 "github.com/oxisto/money-gopher/gen"
+	"github.com/oxisto/money-gopher/persistence"
+	"github.com/oxisto/money-gopher/service/audit"
+
+	"connectrpc.com/connect"
+	"github.com/lmittmann/tint"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	ErrMissingSavingsPlan  = errors.New("a savings plan requires an ID, a portfolio, a security, an interval and a start time")
+	ErrSavingsPlanVolume   = errors.New("a savings plan requires either an amount or a value")
+	ErrSavingsPlanNotFound = errors.New("savings plan not found")
+	ErrNotPending          = errors.New("the transaction is not pending")
+)
+
+// executeInterval is the interval in which due executions of savings plans
+// are created.
+var executeInterval = time.Hour
+
+func (svc *service) CreateSavingsPlan(ctx context.Context, req *connect.Request[portfoliov1.CreateSavingsPlanRequest]) (res *connect.Response[portfoliov1.SavingsPlan], err error) {
+	var (
+		p   = req.Msg.SavingsPlan
+		dbp *persistence.SavingsPlan
+	)
+
+	if p.GetId() == "" || p.PortfolioId == "" || p.SecurityId == "" || p.IntervalMonths <= 0 || p.StartTime == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, ErrMissingSavingsPlan)
+	}
+
+	err = validateSavingsPlanVolume(p.Amount, p.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	err = svc.requirePortfolioAccess(ctx, p.PortfolioId, portfoliov1.PortfolioAccess_PORTFOLIO_ACCESS_WRITE)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = svc.requireSecurities(ctx, []string{p.SecurityId})
+	if err != nil {
+		return nil, err
+	}
+
+	dbp, err = svc.q.CreateSavingsPlan(ctx, persistence.CreateSavingsPlanParams{
+		ID:             p.Id,
+		PortfolioID:    p.PortfolioId,
+		SecurityID:     p.SecurityId,
+		DisplayName:    p.DisplayName,
+		Amount:         p.Amount,
+		Value:          int64(p.Value.GetValue()),
+		IntervalMonths: int64(p.IntervalMonths),
+		StartTime:      p.StartTime.AsTime(),
+		EndTime:        nullTime(p.EndTime),
+		FixedFee:       int64(p.FixedFee.GetValue()),
+		FeePercentage:  p.FeePercentage,
+		CreateTime:     time.Now(),
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeAlreadyExists, ErrAlreadyExists)
+	} else if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	p = savingsPlanToProto(dbp)
+
+	audit.Record(ctx, audit.Event{
+		Action: portfoliov1.AuditAction_AUDIT_ACTION_CREATE,
+		After:  p,
+	})
+
+	return connect.NewResponse(p), nil
+}
+
+func (svc *service) ListSavingsPlans(ctx context.Context, req *connect.Request[portfoliov1.ListSavingsPlansRequest]) (res *connect.Response[portfoliov1.ListSavingsPlansResponse], err error) {
+	var list []*persistence.SavingsPlan
+
+	err = svc.requirePortfolioAccess(ctx, req.Msg.PortfolioId, portfoliov1.PortfolioAccess_PORTFOLIO_ACCESS_READ)
+	if err != nil {
+		return nil, err
+	}
+
+	list, err = svc.q.ListSavingsPlans(ctx, req.Msg.PortfolioId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	res = connect.NewResponse(&portfoliov1.ListSavingsPlansResponse{
+		SavingsPlans: make([]*portfoliov1.SavingsPlan, 0, len(list)),
+	})
+
+	for _, dbp := range list {
+		res.Msg.SavingsPlans = append(res.Msg.SavingsPlans, savingsPlanToProto(dbp))
+	}
+
+	return
+}
+
+func (svc *service) GetSavingsPlan(ctx context.Context, req *connect.Request[portfoliov1.GetSavingsPlanRequest]) (res *connect.Response[portfoliov1.SavingsPlan], err error) {
+	dbp, err := svc.requireSavingsPlanAccess(ctx, req.Msg.Id, portfoliov1.PortfolioAccess_PORTFOLIO_ACCESS_READ)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(savingsPlanToProto(dbp)), nil
+}
+
+func (svc *service) UpdateSavingsPlan(ctx context.Context, req *connect.Request[portfoliov1.UpdateSavingsPlanRequest]) (res *connect.Response[portfoliov1.SavingsPlan], err error) {
+	var (
+		p      = req.Msg.SavingsPlan
+		before *portfoliov1.SavingsPlan
+		after  *portfoliov1.SavingsPlan
+	)
+
+	dbp, err := svc.requireSavingsPlanAccess(ctx, p.GetId(), portfoliov1.PortfolioAccess_PORTFOLIO_ACCESS_WRITE)
+	if err != nil {
+		return nil, err
+	}
+
+	before = savingsPlanToProto(dbp)
+
+	for _, path := range req.Msg.GetUpdateMask().GetPaths() {
+		switch path {
+		case "display_name":
+			dbp.DisplayName = p.DisplayName
+		case "amount":
+			dbp.Amount = p.Amount
+		case "value":
+			dbp.Value = int64(p.Value.GetValue())
+		case "interval_months":
+			if p.IntervalMonths <= 0 {
+				return nil, connect.NewError(connect.CodeInvalidArgument, ErrMissingSavingsPlan)
+			}
+			dbp.IntervalMonths = int64(p.IntervalMonths)
+		case "end_time":
+			dbp.EndTime = nullTime(p.EndTime)
+		case "fixed_fee":
+			dbp.FixedFee = int64(p.FixedFee.GetValue())
+		case "fee_percentage":
+			dbp.FeePercentage = p.FeePercentage
+		}
+	}
+
+	err = validateSavingsPlanVolume(dbp.Amount, portfoliov1.Value(int32(dbp.Value)))
+	if err != nil {
+		return nil, err
+	}
+
+	dbp, err = svc.q.UpdateSavingsPlan(ctx, persistence.UpdateSavingsPlanParams{
+		ID:             dbp.ID,
+		DisplayName:    dbp.DisplayName,
+		Amount:         dbp.Amount,
+		Value:          dbp.Value,
+		IntervalMonths: dbp.IntervalMonths,
+		EndTime:        dbp.EndTime,
+		FixedFee:       dbp.FixedFee,
+		FeePercentage:  dbp.FeePercentage,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	after = savingsPlanToProto(dbp)
+
+	audit.Record(ctx, audit.Event{
+		Action: portfoliov1.AuditAction_AUDIT_ACTION_UPDATE,
+		Paths:  req.Msg.GetUpdateMask().GetPaths(),
+		Before: before,
+		After:  after,
+	})
+
+	return connect.NewResponse(after), nil
+}
+
+func (svc *service) DeleteSavingsPlan(ctx context.Context, req *connect.Request[portfoliov1.DeleteSavingsPlanRequest]) (res *connect.Response[emptypb.Empty], err error) {
+	dbp, err := svc.requireSavingsPlanAccess(ctx, req.Msg.Id, portfoliov1.PortfolioAccess_PORTFOLIO_ACCESS_WRITE)
+	if err != nil {
+		return nil, err
+	}
+
+	// The transactions of the plan are kept, including pending ones
+	_, err = svc.q.DeleteSavingsPlan(ctx, dbp.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	audit.Record(ctx, audit.Event{
+		Action: portfoliov1.AuditAction_AUDIT_ACTION_DELETE,
+		Before: savingsPlanToProto(dbp),
+	})
+
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+func (svc *service) ConfirmPortfolioTransaction(ctx context.Context, req *connect.Request[portfoliov1.ConfirmPortfolioTransactionRequest]) (res *connect.Response[portfoliov1.PortfolioEvent], err error) {
+	var (
+		in   = &portfoliov1.PortfolioEvent{Id: req.Msg.Id}
+		mask = &fieldmaskpb.FieldMask{Paths: []string{"pending"}}
+	)
+
+	tx, err := svc.requireTransactionAccess(ctx, req.Msg.Id, portfoliov1.PortfolioAccess_PORTFOLIO_ACCESS_WRITE)
+	if err != nil {
+		return nil, err
+	} else if !tx.Pending {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, ErrNotPending)
+	}
+
+	// Adjust the expected execution to the actual one
+	if req.Msg.Amount != nil {
+		in.Amount = *req.Msg.Amount
+		mask.Paths = append(mask.Paths, "amount")
+	}
+
+	if req.Msg.Price != nil {
+		in.Price = req.Msg.Price
+		mask.Paths = append(mask.Paths, "price")
+	}
+
+	if req.Msg.Fees != nil {
+		in.Fees = req.Msg.Fees
+		mask.Paths = append(mask.Paths, "fees")
+	}
+
+	if req.Msg.Taxes != nil {
+		in.Taxes = req.Msg.Taxes
+		mask.Paths = append(mask.Paths, "taxes")
+	}
+
+	if req.Msg.Time != nil {
+		in.Time = req.Msg.Time
+		mask.Paths = append(mask.Paths, "time")
+	}
+
+	return svc.UpdatePortfolioTransaction(ctx, connect.NewRequest(&portfoliov1.UpdatePortfolioTransactionRequest{
+		Transaction: in,
+		UpdateMask:  mask,
+	}))
+}
+
+// validateSavingsPlanVolume makes sure that exactly one of amount and value is
+// set.
+func validateSavingsPlanVolume(amount float64, value *portfoliov1.Currency) error {
+	if (amount > 0) == (value.GetValue() > 0) {
+		return connect.NewError(connect.CodeInvalidArgument, ErrSavingsPlanVolume)
+	}
+
+	return nil
+}
+
+// requireSavingsPlanAccess checks, whether the authenticated user has at least
+// the wanted access to the portfolio of the savings plan with the given ID and
+// returns the savings plan.
+func (svc *service) requireSavingsPlanAccess(ctx context.Context, id string, want portfoliov1.PortfolioAccess) (p *persistence.SavingsPlan, err error) {
+	p, err = svc.q.GetSavingsPlan(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeNotFound, ErrSavingsPlanNotFound)
+	} else if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	err = svc.requirePortfolioAccess(ctx, p.PortfolioID, want)
+	if connect.CodeOf(err) == connect.CodeNotFound {
+		// Do not reveal that the savings plan exists
+		return nil, connect.NewError(connect.CodeNotFound, ErrSavingsPlanNotFound)
+	} else if err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// SavingsPlanExecutor creates the due executions of all savings plans as
+// pending transactions.
+type SavingsPlanExecutor struct {
+	svc *service
+}
+
+// NewSavingsPlanExecutor creates a new [SavingsPlanExecutor] that uses the
+// same options as the portfolio service.
+func NewSavingsPlanExecutor(opts Options) *SavingsPlanExecutor {
+	return &SavingsPlanExecutor{svc: newService(opts)}
+}
+
+// Run executes all savings plans once on startup and then every
+// [executeInterval], until ctx is done.
+func (ex *SavingsPlanExecutor) Run(ctx context.Context) {
+	ticker := time.NewTicker(executeInterval)
+	defer ticker.Stop()
+
+	for {
+		n, err := ex.Execute(ctx, time.Now())
+		if err != nil && ctx.Err() == nil {
+			slog.Error("Could not execute savings plans", tint.Err(err))
+		}
+		if n > 0 {
+			slog.Info("Executed savings plans", "transactions", n)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Execute creates a pending transaction for each execution of a savings plan
+// up to now that was not created yet. The transactions are priced at the
+// latest quote of the security, so plans of securities without a quote, or
+// with a quote of zero, are executed once a quote is available. Executions before the day a plan was
+// created are never created, since the latest quote would be the wrong price
+// for them. It returns the number of created transactions.
+func (ex *SavingsPlanExecutor) Execute(ctx context.Context, now time.Time) (n int, err error) {
+	var (
+		svc  = ex.svc
+		errs []error
+	)
+
+	plans, err := svc.q.ListDueSavingsPlans(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+
+	quotes, err := svc.latestQuotes(ctx, plans)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, p := range plans {
+		times := executionTimes(p, executedUntil(p), now)
+		if len(times) == 0 {
+			continue
+		}
+
+		price, ok := quotes[p.SecurityID]
+		if !ok {
+			slog.Warn("Could not execute savings plan without a quote", "savings-plan", p.ID, "security", p.SecurityID)
+			continue
+		}
+
+		err = svc.inTx(ctx, func(q *persistence.Queries) (err error) {
+			for _, t := range times {
+				e := savingsPlanExecution(p, t, price)
+
+				_, err = q.CreatePendingPortfolioEvent(ctx, persistence.CreatePendingPortfolioEventParams{
+					ID:            e.Id,
+					Type:          int64(e.Type),
+					Time:          t,
+					PortfolioID:   e.PortfolioId,
+					SecurityID:    e.SecurityId,
+					Amount:        sql.NullFloat64{Float64: e.Amount, Valid: true},
+					Price:         sql.NullInt64{Int64: int64(e.Price.Value), Valid: true},
+					Fees:          sql.NullInt64{Int64: int64(e.Fees.Value), Valid: true},
+					SavingsPlanID: p.ID,
+				})
+				if err != nil {
+					return err
+				}
+			}
+
+			return q.SetSavingsPlanLastExecutionTime(ctx, persistence.SetSavingsPlanLastExecutionTimeParams{
+				ID:                p.ID,
+				LastExecutionTime: sql.NullTime{Time: times[len(times)-1], Valid: true},
+			})
+		})
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		n += len(times)
+		svc.publish(p.PortfolioID)
+	}
+
+	return n, errors.Join(errs...)
+}
+
+// projectSavingsPlans returns the executions of the savings plans of a
+// portfolio up to until that were not created as transactions yet, priced at
+// the latest quote of their security.
+func (svc *service) projectSavingsPlans(ctx context.Context, portfolioID string, until time.Time) (txs []*portfoliov1.PortfolioEvent, err error) {
+	plans, err := svc.q.ListSavingsPlans(ctx, portfolioID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	quotes, err := svc.latestQuotes(ctx, plans)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range plans {
+		price, ok := quotes[p.SecurityID]
+		if !ok {
+			slog.Warn("Could not project savings plan without a quote", "savings-plan", p.ID, "security", p.SecurityID)
+			continue
+		}
+
+		for _, t := range executionTimes(p, executedUntil(p), until) {
+			txs = append(txs, savingsPlanExecution(p, t, price))
+		}
+	}
+
+	return txs, nil
+}
+
+// latestQuotes returns the latest quotes of the securities of the savings
+// plans, indexed by their ID. Securities without a quote are left out. This
+// includes quotes of zero, since plans with a value divide by the quote.
+func (svc *service) latestQuotes(ctx context.Context, plans []*persistence.SavingsPlan) (quotes map[string]*portfoliov1.Currency, err error) {
+	var ids []string
+
+	quotes = make(map[string]*portfoliov1.Currency)
+	if len(plans) == 0 {
+		return quotes, nil
+	}
+
+	for _, p := range plans {
+		ids = append(ids, p.SecurityID)
+	}
+
+	secs, err := svc.listSecurities(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	for id, sec := range secs {
+		ls := sec.GetListedOn()
+		if len(ls) > 0 && ls[0].LatestQuote.GetValue() > 0 {
+			quotes[id] = ls[0].LatestQuote
+		}
+	}
+
+	return quotes, nil
+}
+
+// savingsPlanExecution returns the transaction of the execution of a savings
+// plan at t. Plans with a value buy fractional shares; the fees are charged on
+// top of the invested value.
+func savingsPlanExecution(p *persistence.SavingsPlan, t time.Time, price *portfoliov1.Currency) *portfoliov1.PortfolioEvent {
+	var (
+		amount = p.Amount
+		value  = portfoliov1.Times(price, p.Amount)
+	)
+
+	if p.Value > 0 {
+		value = portfoliov1.Value(int32(p.Value))
+		amount = float64(p.Value) / float64(price.Value)
+	}
+
+	return &portfoliov1.PortfolioEvent{
+		Id:            p.ID + "-" + t.Format(time.DateOnly),
+		Type:          portfoliov1.PortfolioEventType_PORTFOLIO_EVENT_TYPE_BUY,
+		Time:          timestamppb.New(t),
+		PortfolioId:   p.PortfolioID,
+		SecurityId:    p.SecurityID,
+		Amount:        amount,
+		Price:         price,
+		Fees:          portfoliov1.Value(int32(p.FixedFee)).Plus(portfoliov1.Times(value, p.FeePercentage)),
+		Taxes:         portfoliov1.Zero(),
+		SavingsPlanId: p.ID,
+		Pending:       true,
+	}
+}
+
+// executionTime returns the time of the n-th execution of a savings plan,
+// starting with 0. Executions on days that do not exist in shorter months are
+// on the last day of the month instead.
+func executionTime(p *persistence.SavingsPlan, n int) time.Time {
+	var (
+		start   = p.StartTime
+		y, m, d = start.Date()
+		first   = time.Date(y, m+time.Month(n*int(p.IntervalMonths)), 1, start.Hour(), start.Minute(), start.Second(), start.Nanosecond(), start.Location())
+		last    = first.AddDate(0, 1, -1).Day()
+	)
+
+	return first.AddDate(0, 0, min(d, last)-1)
+}
+
+// executionTimes returns the times of all executions of a savings plan after
+// the time after and up to and including until.
+func executionTimes(p *persistence.SavingsPlan, after time.Time, until time.Time) (times []time.Time) {
+	if p.IntervalMonths <= 0 {
+		return nil
+	}
+
+	for n := 0; ; n++ {
+		t := executionTime(p, n)
+		if t.After(until) || (p.EndTime.Valid && t.After(p.EndTime.Time)) {
+			return times
+		}
+
+		if t.After(after) {
+			times = append(times, t)
+		}
+	}
+}
+
+// executedUntil returns the time up to which the executions of a savings plan
+// are done. This is the time of the last execution that was created as a
+// transaction or, if there was none yet, the end of the day before the plan
+// was created. Earlier executions cannot be priced at their time, so they are
+// skipped.
+func executedUntil(p *persistence.SavingsPlan) time.Time {
+	if p.LastExecutionTime.Valid {
+		return p.LastExecutionTime.Time
+	}
+
+	y, m, d := p.CreateTime.UTC().Date()
+
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC).Add(-time.Nanosecond)
+}
+
+// nextExecutionTime returns the time of the next execution of a savings plan,
+// if it has not ended.
+func nextExecutionTime(p *persistence.SavingsPlan) (t time.Time, ok bool) {
+	if p.IntervalMonths <= 0 {
+		return time.Time{}, false
+	}
+
+	last := executedUntil(p)
+	for n := 0; ; n++ {
+		t = executionTime(p, n)
+		if p.EndTime.Valid && t.After(p.EndTime.Time) {
+			return time.Time{}, false
+		} else if t.After(last) {
+			return t, true
+		}
+	}
+}
+
+func savingsPlanToProto(p *persistence.SavingsPlan) *portfoliov1.SavingsPlan {
+	out := &portfoliov1.SavingsPlan{
+		Id:             p.ID,
+		PortfolioId:    p.PortfolioID,
+		SecurityId:     p.SecurityID,
+		DisplayName:    p.DisplayName,
+		Amount:         p.Amount,
+		IntervalMonths: int32(p.IntervalMonths),
+		StartTime:      timestamppb.New(p.StartTime),
+		FeePercentage:  p.FeePercentage,
+		CreateTime:     timestamppb.New(p.CreateTime),
+	}
+
+	if p.Value > 0 {
+		out.Value = portfoliov1.Value(int32(p.Value))
+	}
+
+	if p.FixedFee > 0 {
+		out.FixedFee = portfoliov1.Value(int32(p.FixedFee))
+	}
+
+	if p.EndTime.Valid {
+		out.EndTime = timestamppb.New(p.EndTime.Time)
+	}
+
+	if p.LastExecutionTime.Valid {
+		out.LastExecutionTime = timestamppb.New(p.LastExecutionTime.Time)
+	}
+
+	if t, ok := nextExecutionTime(p); ok {
+		out.NextExecutionTime = timestamppb.New(t)
+	}
+
+	return out
+}
+
+// nullTime returns t for the database, which is NULL if it is not set.
+func nullTime(t *timestamppb.Timestamp) sql.NullTime {
+	if t == nil {
+		return sql.NullTime{}
+	}
+
+	return sql.NullTime{Time: t.AsTime(), Valid: true}
+}
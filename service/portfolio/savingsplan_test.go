@@ -0,0 +1,243 @@
+// Copyright 2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package portfolio
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	portfoliov1 "github.com/oxisto/money-gopher/gen"
+	"github.com/oxisto/money-gopher/internal"
+	"github.com/oxisto/money-gopher/persistence"
+
+	"connectrpc.com/connect"
+	"github.com/oxisto/assert"
+	"golang.org/x/text/currency"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func Test_executionTime(t *testing.T) {
+	date := func(y int, m time.Month, d int) time.Time {
+		return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name     string
+		start    time.Time
+		interval int64
+		n        int
+		want     time.Time
+	}{
+		{name: "first", start: date(2024, 1, 15), interval: 1, n: 0, want: date(2024, 1, 15)},
+		{name: "monthly", start: date(2024, 1, 15), interval: 1, n: 2, want: date(2024, 3, 15)},
+		{name: "quarterly across years", start: date(2024, 11, 1), interval: 3, n: 1, want: date(2025, 2, 1)},
+		{name: "shorter month", start: date(2024, 1, 31), interval: 1, n: 1, want: date(2024, 2, 29)},
+		{name: "after shorter month", start: date(2024, 1, 31), interval: 1, n: 2, want: date(2024, 3, 31)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &persistence.SavingsPlan{StartTime: tt.start, IntervalMonths: tt.interval}
+			assert.Equals(t, tt.want, executionTime(p, tt.n))
+		})
+	}
+}
+
+func TestSavingsPlans(t *testing.T) {
+	var (
+		db    = internal.NewTestDB(t)
+		opts  = Options{DB: db, SecuritiesClient: mockSecuritiesClientWithData}
+		svc   = NewService(opts)
+		ex    = NewSavingsPlanExecutor(opts)
+		money = internal.WithTestUser(context.Background())
+		err   error
+	)
+
+	_, err = svc.CreatePortfolio(money, connect.NewRequest(&portfoliov1.CreatePortfolioRequest{
+		Portfolio: &portfoliov1.Portfolio{Id: "mybank-myportfolio", DisplayName: "My Portfolio"},
+	}))
+	assert.NoError(t, err)
+
+	plan := &portfoliov1.SavingsPlan{
+		Id:             "apple",
+		PortfolioId:    "mybank-myportfolio",
+		SecurityId:     "US0378331005",
+		Amount:         1,
+		Value:          portfoliov1.Value(5000),
+		IntervalMonths: 1,
+		StartTime:      timestamppb.New(time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)),
+		EndTime:        timestamppb.New(time.Date(2024, 4, 30, 0, 0, 0, 0, time.UTC)),
+		FixedFee:       portfoliov1.Value(100),
+		FeePercentage:  0.01,
+	}
+
+	// A plan either buys a fixed amount of shares or invests a fixed value
+	_, err = svc.CreateSavingsPlan(money, connect.NewRequest(&portfoliov1.CreateSavingsPlanRequest{SavingsPlan: plan}))
+	assert.Equals(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	plan.Amount = 0
+	_, err = svc.CreateSavingsPlan(money, connect.NewRequest(&portfoliov1.CreateSavingsPlanRequest{SavingsPlan: plan}))
+	assert.NoError(t, err)
+
+	// Executions before the plan was created cannot be priced at their time,
+	// so they are not created
+	n, err := ex.Execute(context.Background(), time.Now())
+	assert.NoError(t, err)
+	assert.Equals(t, 0, n)
+
+	_, err = db.Exec("UPDATE savings_plans SET create_time = '2024-01-31 00:00:00' WHERE id = 'apple'")
+	assert.NoError(t, err)
+
+	// The executions up to now are created as pending transactions, but only
+	// once
+	n, err = ex.Execute(context.Background(), time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC))
+	assert.NoError(t, err)
+	assert.Equals(t, 2, n)
+
+	n, err = ex.Execute(context.Background(), time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC))
+	assert.NoError(t, err)
+	assert.Equals(t, 0, n)
+
+	txs, err := svc.ListPortfolioTransactions(money, connect.NewRequest(&portfoliov1.ListPortfolioTransactionsRequest{
+		PortfolioId: "mybank-myportfolio",
+	}))
+	assert.NoError(t, err)
+	assert.Equals(t, 2, len(txs.Msg.Transactions))
+
+	tx := txs.Msg.Transactions[1]
+	assert.Equals(t, "apple-2024-02-29", tx.Id)
+	assert.Equals(t, "apple", tx.SavingsPlanId)
+	assert.Equals(t, true, tx.Pending)
+	assert.Equals(t, 0.5, tx.Amount)
+	assert.Equals(t, portfoliov1.Value(150), tx.Fees, protocmp.Transform())
+
+	res, err := svc.GetSavingsPlan(money, connect.NewRequest(&portfoliov1.GetSavingsPlanRequest{Id: "apple"}))
+	assert.NoError(t, err)
+	assert.Equals(t, time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC), res.Msg.LastExecutionTime.AsTime())
+	assert.Equals(t, time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC), res.Msg.NextExecutionTime.AsTime())
+
+	// Pending transactions are confirmed against the actual execution
+	confirmed, err := svc.ConfirmPortfolioTransaction(money, connect.NewRequest(&portfoliov1.ConfirmPortfolioTransactionRequest{
+		Id:     tx.Id,
+		Amount: proto.Float64(0.51),
+		Price:  portfoliov1.Value(9800),
+	}))
+	assert.NoError(t, err)
+	assert.Equals(t, false, confirmed.Msg.Pending)
+	assert.Equals(t, 0.51, confirmed.Msg.Amount)
+	assert.Equals(t, portfoliov1.Value(150), confirmed.Msg.Fees, protocmp.Transform())
+
+	_, err = svc.ConfirmPortfolioTransaction(money, connect.NewRequest(&portfoliov1.ConfirmPortfolioTransactionRequest{Id: tx.Id}))
+	assert.Equals(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+
+	// Pending transactions neither hold shares nor settle against the bank
+	// account until they are confirmed
+	snap, err := svc.GetPortfolioSnapshot(money, connect.NewRequest(&portfoliov1.GetPortfolioSnapshotRequest{
+		PortfolioId: "mybank-myportfolio",
+		Time:        timestamppb.New(time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)),
+	}))
+	assert.NoError(t, err)
+	assert.Equals(t, portfoliov1.Value(-5148), snap.Msg.Cash, protocmp.Transform())
+	assert.Equals(t, 0.51, snap.Msg.Positions["US0378331005"].Amount)
+
+	// The pending and the remaining executions until the end of the plan are
+	// projected
+	snap, err = svc.GetPortfolioSnapshot(money, connect.NewRequest(&portfoliov1.GetPortfolioSnapshotRequest{
+		PortfolioId:         "mybank-myportfolio",
+		Time:                timestamppb.New(time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)),
+		ProjectSavingsPlans: true,
+	}))
+	assert.NoError(t, err)
+	assert.Equals(t, portfoliov1.Value(15450), snap.Msg.ProjectedContributions, protocmp.Transform())
+	assert.Equals(t, portfoliov1.Value(-5148), snap.Msg.Cash, protocmp.Transform())
+	assert.Equals(t, 2.01, snap.Msg.Positions["US0378331005"].Amount)
+
+	// Deleting the plan keeps its transactions
+	_, err = svc.DeleteSavingsPlan(money, connect.NewRequest(&portfoliov1.DeleteSavingsPlanRequest{Id: "apple"}))
+	assert.NoError(t, err)
+
+	_, err = svc.GetSavingsPlan(money, connect.NewRequest(&portfoliov1.GetSavingsPlanRequest{Id: "apple"}))
+	assert.Equals(t, connect.CodeNotFound, connect.CodeOf(err))
+
+	txs, err = svc.ListPortfolioTransactions(money, connect.NewRequest(&portfoliov1.ListPortfolioTransactionsRequest{
+		PortfolioId: "mybank-myportfolio",
+	}))
+	assert.NoError(t, err)
+	assert.Equals(t, 2, len(txs.Msg.Transactions))
+}
+
+func TestSavingsPlans_zeroQuote(t *testing.T) {
+	var (
+		db   = internal.NewTestDB(t)
+		opts = Options{DB: db, SecuritiesClient: &mockSecuritiesClient{
+			securities: []*portfoliov1.Security{
+				{
+					Id:          "US0378331005",
+					DisplayName: "Apple, Inc.",
+					ListedOn: []*portfoliov1.ListedSecurity{
+						{
+							SecurityId:  "US0378331005",
+							Ticker:      "APC.F",
+							Currency:    currency.EUR.String(),
+							LatestQuote: portfoliov1.Value(0),
+						},
+					},
+				},
+			},
+		}}
+		svc   = NewService(opts)
+		ex    = NewSavingsPlanExecutor(opts)
+		money = internal.WithTestUser(context.Background())
+		err   error
+	)
+
+	_, err = svc.CreatePortfolio(money, connect.NewRequest(&portfoliov1.CreatePortfolioRequest{
+		Portfolio: &portfoliov1.Portfolio{Id: "mybank-myportfolio", DisplayName: "My Portfolio"},
+	}))
+	assert.NoError(t, err)
+
+	_, err = svc.CreateSavingsPlan(money, connect.NewRequest(&portfoliov1.CreateSavingsPlanRequest{
+		SavingsPlan: &portfoliov1.SavingsPlan{
+			Id:             "apple",
+			PortfolioId:    "mybank-myportfolio",
+			SecurityId:     "US0378331005",
+			Value:          portfoliov1.Value(5000),
+			IntervalMonths: 1,
+			StartTime:      timestamppb.New(time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)),
+		},
+	}))
+	assert.NoError(t, err)
+
+	_, err = db.Exec("UPDATE savings_plans SET create_time = '2024-01-31 00:00:00' WHERE id = 'apple'")
+	assert.NoError(t, err)
+
+	// A quote of zero cannot price the shares, so the plan is skipped until
+	// there is a proper quote
+	n, err := ex.Execute(context.Background(), time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC))
+	assert.NoError(t, err)
+	assert.Equals(t, 0, n)
+
+	snap, err := svc.GetPortfolioSnapshot(money, connect.NewRequest(&portfoliov1.GetPortfolioSnapshotRequest{
+		PortfolioId:         "mybank-myportfolio",
+		Time:                timestamppb.New(time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)),
+		ProjectSavingsPlans: true,
+	}))
+	assert.NoError(t, err)
+	assert.Equals(t, 0, len(snap.Msg.Positions))
+}
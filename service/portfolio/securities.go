@@ -0,0 +1,85 @@
+// Copyright 2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package portfolio
+
+import (
+	"context"
+	"strings"
+
+	portfoliov1 "github.com/oxisto/money-gopher/gen"
+	"github.com/oxisto/money-gopher/gen/portfoliov1connect"
+
+	"connectrpc.com/connect"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// tracer creates the spans of in-process calls to the securities service.
+var tracer = otel.Tracer("github.com/oxisto/money-gopher/service/portfolio")
+
+// inProcessSecuritiesClient calls the securities service in-process. Just like
+// a remote client, it creates a span for every call, so that the call shows up
+// in traces regardless of the deployment.
+type inProcessSecuritiesClient struct {
+	handler portfoliov1connect.SecuritiesServiceHandler
+}
+
+func (c *inProcessSecuritiesClient) ListSecurities(ctx context.Context, req *connect.Request[portfoliov1.ListSecuritiesRequest]) (*connect.Response[portfoliov1.ListSecuritiesResponse], error) {
+	return call(ctx, portfoliov1connect.SecuritiesServiceListSecuritiesProcedure, c.handler.ListSecurities, req)
+}
+
+func (c *inProcessSecuritiesClient) GetSecurity(ctx context.Context, req *connect.Request[portfoliov1.GetSecurityRequest]) (*connect.Response[portfoliov1.Security], error) {
+	return call(ctx, portfoliov1connect.SecuritiesServiceGetSecurityProcedure, c.handler.GetSecurity, req)
+}
+
+func (c *inProcessSecuritiesClient) CreateSecurity(ctx context.Context, req *connect.Request[portfoliov1.CreateSecurityRequest]) (*connect.Response[portfoliov1.Security], error) {
+	return call(ctx, portfoliov1connect.SecuritiesServiceCreateSecurityProcedure, c.handler.CreateSecurity, req)
+}
+
+func (c *inProcessSecuritiesClient) UpdateSecurity(ctx context.Context, req *connect.Request[portfoliov1.UpdateSecurityRequest]) (*connect.Response[portfoliov1.Security], error) {
+	return call(ctx, portfoliov1connect.SecuritiesServiceUpdateSecurityProcedure, c.handler.UpdateSecurity, req)
+}
+
+func (c *inProcessSecuritiesClient) DeleteSecurity(ctx context.Context, req *connect.Request[portfoliov1.DeleteSecurityRequest]) (*connect.Response[emptypb.Empty], error) {
+	return call(ctx, portfoliov1connect.SecuritiesServiceDeleteSecurityProcedure, c.handler.DeleteSecurity, req)
+}
+
+func (c *inProcessSecuritiesClient) TriggerSecurityQuoteUpdate(ctx context.Context, req *connect.Request[portfoliov1.TriggerQuoteUpdateRequest]) (*connect.Response[portfoliov1.TriggerQuoteUpdateResponse], error) {
+	return call(ctx, portfoliov1connect.SecuritiesServiceTriggerSecurityQuoteUpdateProcedure, c.handler.TriggerSecurityQuoteUpdate, req)
+}
+
+// call calls the procedure f within a span that is named like the spans of
+// Connect clients, e.g., "mgo.portfolio.v1.SecuritiesService/ListSecurities".
+func call[Req any, Res any](
+	ctx context.Context,
+	procedure string,
+	f func(context.Context, *connect.Request[Req]) (*connect.Response[Res], error),
+	req *connect.Request[Req],
+) (res *connect.Response[Res], err error) {
+	ctx, span := tracer.Start(ctx, strings.TrimPrefix(procedure, "/"), trace.WithSpanKind(trace.SpanKindInternal))
+	defer span.End()
+
+	res, err = f(ctx, req)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return
+}
@@ -18,54 +18,126 @@
 package portfolio
 
 import (
-	"net/http"
+	"context"
 
 	portfoliov1 "github.com/oxisto/money-gopher/gen"
 	"github.com/oxisto/money-gopher/gen/portfoliov1connect"
 	"github.com/oxisto/money-gopher/persistence"
-)
+	"github.com/oxisto/money-gopher/service/events"
+	"github.com/oxisto/money-gopher/service/securities"
 
-const DefaultSecuritiesServiceURL = "http://localhost:8080"
+	"connectrpc.com/connect"
+)
 
 // service is the main struct fo the [PortfolioService] implementation.
 type service struct {
 	portfolios   persistence.StorageOperations[*portfoliov1.Portfolio]
 	events       persistence.StorageOperations[*portfoliov1.PortfolioEvent]
+	shares       persistence.StorageOperations[*portfoliov1.PortfolioShare]
 	bankAccounts persistence.StorageOperations[*portfoliov1.BankAccount]
 	securities   portfoliov1connect.SecuritiesServiceClient
+	broker       *events.Broker
+	db           *persistence.DB
+	q            *persistence.Queries
+	blobs        persistence.BlobStore
+
+	// retentionDays is the number of days after which the trash is purged.
+	retentionDays int
 
 	portfoliov1connect.UnimplementedPortfolioServiceHandler
 }
 
 type Options struct {
+	// SecuritiesClient is used to call the securities service. If it is nil,
+	// the securities service is called in-process.
 	SecuritiesClient portfoliov1connect.SecuritiesServiceClient
-	DB               *persistence.DB
+
+	// SecuritiesHandler is the securities service that is called in-process.
+	// If it is nil, a new one is created using DB.
+	SecuritiesHandler portfoliov1connect.SecuritiesServiceHandler
+
+	DB *persistence.DB
+
+	// Queries is used for queries that do not fit the storage operations, e.g.,
+	// for the trash. If it is nil, a new one is created using DB.
+	Queries *persistence.Queries
+
+	// TrashRetentionDays is the number of days after which deleted items are
+	// purged from the trash. It is only used to display the purge time; 0
+	// means that the trash is never purged.
+	TrashRetentionDays int
+
+	// Broker is used to publish changes of portfolios and to watch them.
+	// If it is nil, changes are not published and watchers only receive the
+	// initial snapshot.
+	Broker *events.Broker
+
+	// Attachments stores the content of attachments. If it is nil, the
+	// content is stored in the database.
+	Attachments persistence.BlobStore
 }
 
 func NewService(opts Options) portfoliov1connect.PortfolioServiceHandler {
+	return newService(opts)
+}
+
+// newService creates the [service] behind [NewService], so that it can also
+// be used internally, e.g., by the [SavingsPlanExecutor].
+func newService(opts Options) *service {
 	var s service
 
 	s.portfolios = persistence.Ops[*portfoliov1.Portfolio](opts.DB)
 	s.events = persistence.Relationship[*portfoliov1.PortfolioEvent](s.portfolios)
+	s.shares = persistence.Relationship[*portfoliov1.PortfolioShare](s.portfolios)
 	s.bankAccounts = persistence.Ops[*portfoliov1.BankAccount](opts.DB)
 
 	s.securities = opts.SecuritiesClient
 	if s.securities == nil {
-		s.securities = portfoliov1connect.NewSecuritiesServiceClient(http.DefaultClient, DefaultSecuritiesServiceURL)
+		// The handler shares its method set with the client, so we can call
+		// it directly without a round-trip over HTTP
+		if opts.SecuritiesHandler == nil {
+			opts.SecuritiesHandler = securities.NewService(opts.DB, opts.Broker)
+		}
+
+		s.securities = &inProcessSecuritiesClient{handler: opts.SecuritiesHandler}
 	}
 
-	// Add a simple starter portfolio
-	s.portfolios.Replace(&portfoliov1.Portfolio{
-		Id:            "mybank-myportfolio",
-		DisplayName:   "My Portfolio",
-		BankAccountId: "mybank-mycash",
-	})
+	s.broker = opts.Broker
+	s.db = opts.DB
 
-	// Add its cash account
-	s.bankAccounts.Replace(&portfoliov1.BankAccount{
-		Id:          "mybank-mycash",
-		DisplayName: "My Cash Account",
-	})
+	s.q = opts.Queries
+	if s.q == nil {
+		s.q = persistence.New(opts.DB)
+	}
+
+	s.blobs = opts.Attachments
+	if s.blobs == nil {
+		s.blobs = persistence.NewDBBlobStore(s.q)
+	}
+
+	s.retentionDays = opts.TrashRetentionDays
 
 	return &s
 }
+
+// inTx runs f within a database transaction, which is committed if f
+// does not return an error.
+func (svc *service) inTx(ctx context.Context, f func(q *persistence.Queries) error) error {
+	tx, err := svc.db.BeginTx(ctx, nil)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	defer tx.Rollback()
+
+	err = f(svc.q.WithTx(tx))
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+// Copyright 2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package portfolio
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/oxisto/money-gopher/auth"
+	portfoliov1 "github.com/oxisto/money-gopher/gen"
+	"github.com/oxisto/money-gopher/service/audit"
+	"github.com/oxisto/money-gopher/service/internal/crud"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+var (
+	ErrMissingShare   = errors.New("the request requires a share")
+	ErrMissingSubject = errors.New("a share requires a subject")
+	ErrInvalidAccess  = errors.New("a share requires either read or write access")
+	ErrShareWithOwner = errors.New("a portfolio cannot be shared with its owner")
+)
+
+func (svc *service) SharePortfolio(ctx context.Context, req *connect.Request[portfoliov1.SharePortfolioRequest]) (res *connect.Response[portfoliov1.PortfolioShare], err error) {
+	var (
+		share = req.Msg.Share
+		u     *auth.User
+	)
+
+	u, err = currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if share == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, ErrMissingShare)
+	}
+
+	err = svc.requirePortfolioAccess(ctx, share.PortfolioId, accessOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case share.Subject == "":
+		return nil, connect.NewError(connect.CodeInvalidArgument, ErrMissingSubject)
+	case share.Subject == u.Subject:
+		return nil, connect.NewError(connect.CodeInvalidArgument, ErrShareWithOwner)
+	case share.Access != portfoliov1.PortfolioAccess_PORTFOLIO_ACCESS_READ &&
+		share.Access != portfoliov1.PortfolioAccess_PORTFOLIO_ACCESS_WRITE:
+		return nil, connect.NewError(connect.CodeInvalidArgument, ErrInvalidAccess)
+	}
+
+	slog.Info("Sharing portfolio", "share", share)
+
+	err = svc.shares.Replace(ctx, share)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	audit.Record(ctx, audit.Event{
+		Action:     portfoliov1.AuditAction_AUDIT_ACTION_CREATE,
+		ResourceID: audit.Key([]any{share.PortfolioId, share.Subject}),
+		After:      share,
+	})
+
+	return connect.NewResponse(share), nil
+}
+
+func (svc *service) UnsharePortfolio(ctx context.Context, req *connect.Request[portfoliov1.UnsharePortfolioRequest]) (res *connect.Response[emptypb.Empty], err error) {
+	err = svc.requirePortfolioAccess(ctx, req.Msg.PortfolioId, accessOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	return crud.Delete(ctx, []any{req.Msg.PortfolioId, req.Msg.Subject}, svc.shares)
+}
+
+func (svc *service) ListPortfolioShares(ctx context.Context, req *connect.Request[portfoliov1.ListPortfolioSharesRequest]) (res *connect.Response[portfoliov1.ListPortfolioSharesResponse], err error) {
+	err = svc.requirePortfolioAccess(ctx, req.Msg.PortfolioId, accessOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	return crud.List(
+		ctx,
+		svc.shares,
+		func(
+			res *connect.Response[portfoliov1.ListPortfolioSharesResponse],
+			list []*portfoliov1.PortfolioShare,
+		) error {
+			res.Msg.Shares = list
+			return nil
+		},
+		req.Msg.PortfolioId,
+	)
+}
@@ -18,7 +18,9 @@ package portfolio
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
 
 	moneygopher "github.com/oxisto/money-gopher"
 	"github.com/oxisto/money-gopher/finance"
@@ -30,20 +32,35 @@ import (
 
 func (svc *service) GetPortfolioSnapshot(ctx context.Context, req *connect.Request[portfoliov1.GetPortfolioSnapshotRequest]) (res *connect.Response[portfoliov1.PortfolioSnapshot], err error) {
 	var (
-		snap   *portfoliov1.PortfolioSnapshot
-		p      portfoliov1.Portfolio
-		m      map[string][]*portfoliov1.PortfolioEvent
-		names  []string
-		secres *connect.Response[portfoliov1.ListSecuritiesResponse]
-		secmap map[string]*portfoliov1.Security
+		snap    *portfoliov1.PortfolioSnapshot
+		p       portfoliov1.Portfolio
+		m       map[string][]*portfoliov1.PortfolioEvent
+		names   []string
+		secres  *connect.Response[portfoliov1.ListSecuritiesResponse]
+		secmap  map[string]*portfoliov1.Security
+		proj    []*portfoliov1.PortfolioEvent
+		pending []*portfoliov1.PortfolioEvent
 	)
 
+	err = svc.requirePortfolioAccess(ctx, req.Msg.PortfolioId, portfoliov1.PortfolioAccess_PORTFOLIO_ACCESS_READ)
+	if err != nil {
+		return nil, err
+	}
+
 	// Retrieve transactions
-	p.Events, err = svc.events.List(req.Msg.PortfolioId)
+	p.Events, err = svc.events.List(ctx, req.Msg.PortfolioId)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
+	// Restrict the snapshot to the transactions of a tag, e.g., a strategy
+	if req.Msg.Tag != "" {
+		p.Events, err = svc.eventsWithTag(ctx, p.Events, req.Msg.Tag)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// If no time is specified, we assume it to be now
 	if req.Msg.Time == nil {
 		req.Msg.Time = timestamppb.Now()
@@ -59,23 +76,67 @@ func (svc *service) GetPortfolioSnapshot(ctx context.Context, req *connect.Reque
 		Cash:               portfoliov1.Zero(),
 	}
 
+	// Pending executions of savings plans did not happen yet, so they are
+	// only part of the snapshot as projected executions
+	for _, tx := range p.Events {
+		if tx.Pending {
+			pending = append(pending, tx)
+		}
+	}
+	p.Events = portfoliov1.ExecutedEvents(p.Events)
+
 	// Record the first transaction time
 	if len(p.Events) > 0 {
 		snap.FirstTransactionTime = p.Events[0].Time
 	}
 
+	// All events settle against the bank account of the portfolio
+	for _, tx := range portfoliov1.EventsBefore(p.Events, snap.Time.AsTime()) {
+		snap.Cash.PlusAssign(finance.CashFlow(tx))
+	}
+
+	// Project the future executions of savings plans. They are paid with new
+	// money, so they do not settle against the bank account.
+	if req.Msg.ProjectSavingsPlans {
+		proj, err = svc.projectSavingsPlans(ctx, req.Msg.PortfolioId, snap.Time.AsTime())
+		if err != nil {
+			return nil, err
+		}
+
+		if req.Msg.Tag != "" {
+			proj, err = svc.eventsWithTag(ctx, proj, req.Msg.Tag)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		// Pending executions are projected as well, since the savings plan
+		// is only projected after its last execution
+		proj = append(portfoliov1.EventsBefore(pending, snap.Time.AsTime()), proj...)
+
+		snap.ProjectedContributions = portfoliov1.Zero()
+		for _, tx := range proj {
+			snap.ProjectedContributions.MinusAssign(finance.CashFlow(tx))
+		}
+
+		p.Events = append(p.Events, proj...)
+		slices.SortStableFunc(p.Events, func(a, b *portfoliov1.PortfolioEvent) int {
+			return a.Time.AsTime().Compare(b.Time.AsTime())
+		})
+	}
+
 	// Retrieve the event map; a map of events indexed by their security ID
 	m = p.EventMap()
 	names = keys(m)
 
 	// Retrieve market value of filtered securities
 	secres, err = svc.securities.ListSecurities(
-		context.Background(),
-		forwardAuth(connect.NewRequest(&portfoliov1.ListSecuritiesRequest{
+		ctx,
+		connect.NewRequest(&portfoliov1.ListSecuritiesRequest{
 			Filter: &portfoliov1.ListSecuritiesRequest_Filter{
 				SecurityIds: names,
 			},
-		}), req),
+		}),
 	)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal,
@@ -95,19 +156,10 @@ func (svc *service) GetPortfolioSnapshot(ctx context.Context, req *connect.Reque
 
 		c := finance.NewCalculation(txs)
 
-		if name == "cash" {
-			// Add deposited/withdrawn cash directly
-			snap.Cash.PlusAssign(c.Cash)
-			continue
-		}
-
 		if c.Amount == 0 {
 			continue
 		}
 
-		// Also add cash that is part of a securities' transaction (e.g., sell/buy)
-		snap.Cash.PlusAssign(c.Cash)
-
 		pos := &portfoliov1.PortfolioPosition{
 			Security:      secmap[name],
 			Amount:        c.Amount,
@@ -139,8 +191,131 @@ func (svc *service) GetPortfolioSnapshot(ctx context.Context, req *connect.Reque
 	return connect.NewResponse(snap), nil
 }
 
+// eventsWithTag returns the events that have the tag or whose security has
+// the tag.
+func (svc *service) eventsWithTag(ctx context.Context, events []*portfoliov1.PortfolioEvent, tag string) ([]*portfoliov1.PortfolioEvent, error) {
+	secres, err := svc.securities.ListSecurities(
+		ctx,
+		connect.NewRequest(&portfoliov1.ListSecuritiesRequest{
+			Filter: &portfoliov1.ListSecuritiesRequest_Filter{
+				Tag: tag,
+			},
+		}),
+	)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal,
+			fmt.Errorf("internal error while calling ListSecurities on securities service: %w", err),
+		)
+	}
+
+	tagged := moneygopher.Map(secres.Msg.Securities, func(s *portfoliov1.Security) string {
+		return s.Id
+	})
+
+	return slices.DeleteFunc(events, func(e *portfoliov1.PortfolioEvent) bool {
+		_, ok := tagged[e.SecurityId]
+		return !ok && !slices.Contains(e.Tags, tag)
+	}), nil
+}
+
+// ErrMissingPortfolios is returned if a consolidated snapshot is requested
+// without any portfolio.
+var ErrMissingPortfolios = errors.New("at least one portfolio is required")
+
+func (svc *service) GetConsolidatedSnapshot(ctx context.Context, req *connect.Request[portfoliov1.GetConsolidatedSnapshotRequest]) (res *connect.Response[portfoliov1.ConsolidatedSnapshot], err error) {
+	var (
+		snap *portfoliov1.ConsolidatedSnapshot
+	)
+
+	if len(req.Msg.PortfolioIds) == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, ErrMissingPortfolios)
+	}
+
+	// All snapshots need to be taken at the same time, so we cannot let
+	// GetPortfolioSnapshot decide
+	if req.Msg.Time == nil {
+		req.Msg.Time = timestamppb.Now()
+	}
+
+	snap = &portfoliov1.ConsolidatedSnapshot{
+		Total: &portfoliov1.PortfolioSnapshot{
+			Time:               req.Msg.Time,
+			Positions:          make(map[string]*portfoliov1.PortfolioPosition),
+			TotalPurchaseValue: portfoliov1.Zero(),
+			TotalMarketValue:   portfoliov1.Zero(),
+			TotalProfitOrLoss:  portfoliov1.Zero(),
+			Cash:               portfoliov1.Zero(),
+		},
+		Portfolios: make(map[string]*portfoliov1.PortfolioSnapshot),
+	}
+
+	for _, id := range req.Msg.PortfolioIds {
+		var part *connect.Response[portfoliov1.PortfolioSnapshot]
+
+		// A portfolio that is requested more than once must only be counted
+		// once
+		if _, ok := snap.Portfolios[id]; ok {
+			continue
+		}
+
+		// This also makes sure that the user is allowed to read the portfolio
+		part, err = svc.GetPortfolioSnapshot(ctx, connect.NewRequest(&portfoliov1.GetPortfolioSnapshotRequest{
+			PortfolioId: id,
+			Time:        req.Msg.Time,
+		}))
+		if err != nil {
+			return nil, err
+		}
+
+		snap.Portfolios[id] = part.Msg
+		mergeSnapshot(snap.Total, part.Msg)
+	}
+
+	// Calculate total gains and value
+	snap.Total.TotalGains = float64(portfoliov1.Minus(snap.Total.TotalMarketValue, snap.Total.TotalPurchaseValue).Value) / float64(snap.Total.TotalPurchaseValue.Value)
+	snap.Total.TotalPortfolioValue = snap.Total.TotalMarketValue.Plus(snap.Total.Cash)
+
+	return connect.NewResponse(snap), nil
+}
+
+// mergeSnapshot adds the positions and values of part to total. Positions of
+// the same security are merged, so that their purchase price is the average
+// of both cost bases, weighted by the amount.
+func mergeSnapshot(total *portfoliov1.PortfolioSnapshot, part *portfoliov1.PortfolioSnapshot) {
+	if part.FirstTransactionTime != nil && (total.FirstTransactionTime == nil ||
+		part.FirstTransactionTime.AsTime().Before(total.FirstTransactionTime.AsTime())) {
+		total.FirstTransactionTime = part.FirstTransactionTime
+	}
+
+	for name, pos := range part.Positions {
+		merged, ok := total.Positions[name]
+		if !ok {
+			merged = &portfoliov1.PortfolioPosition{
+				Security:      pos.Security,
+				PurchaseValue: portfoliov1.Zero(),
+				MarketValue:   portfoliov1.Zero(),
+				MarketPrice:   pos.MarketPrice,
+			}
+			total.Positions[name] = merged
+		}
+
+		merged.Amount += pos.Amount
+		merged.PurchaseValue.PlusAssign(pos.PurchaseValue)
+		merged.MarketValue.PlusAssign(pos.MarketValue)
+
+		merged.PurchasePrice = portfoliov1.Divide(merged.PurchaseValue, merged.Amount)
+		merged.ProfitOrLoss = portfoliov1.Minus(merged.MarketValue, merged.PurchaseValue)
+		merged.Gains = float64(merged.ProfitOrLoss.Value) / float64(merged.PurchaseValue.Value)
+	}
+
+	total.TotalPurchaseValue.PlusAssign(part.TotalPurchaseValue)
+	total.TotalMarketValue.PlusAssign(part.TotalMarketValue)
+	total.TotalProfitOrLoss.PlusAssign(part.TotalProfitOrLoss)
+	total.Cash.PlusAssign(part.Cash)
+}
+
 func marketPrice(secmap map[string]*portfoliov1.Security, name string, netPrice *portfoliov1.Currency) *portfoliov1.Currency {
-	ls := secmap[name].ListedOn
+	ls := secmap[name].GetListedOn()
 
 	if ls == nil || ls[0].LatestQuote == nil {
 		return netPrice
@@ -159,11 +334,3 @@ func keys[M ~map[K]V, K comparable, V any](m M) (keys []K) {
 
 	return keys
 }
-
-// forwardAuth uses the authorization header of [authenticatedReq] to
-// authenticate [req]. This is a little workaround, until we have proper
-// service-to-service authentication.
-func forwardAuth[T any, S any](req *connect.Request[T], authenticatedReq *connect.Request[S]) *connect.Request[T] {
-	req.Header().Set("Authorization", authenticatedReq.Header().Get("Authorization"))
-	return req
-}
@@ -18,10 +18,13 @@ package portfolio
 
 import (
 	"context"
+	"errors"
 	"io"
+	"slices"
 	"testing"
 	"time"
 
+	"github.com/oxisto/money-gopher/auth"
 	"github.com/oxisto/money-gopher/gen/portfoliov1connect"
 	"github.com/oxisto/money-gopher/internal"
 	"github.com/oxisto/money-gopher/persistence"
@@ -110,6 +113,56 @@ func Test_service_GetPortfolioSnapshot(t *testing.T) {
 					assert.Equals(t, portfoliov1.Value(200000), pos.MarketValue, protocmp.Transform())
 			},
 		},
+		{
+			name: "filtered by tag of transaction",
+			fields: fields{
+				portfolios: myPortfolio(t),
+				securities: mockSecuritiesClientWithData,
+			},
+			args: args{req: connect.NewRequest(&portfoliov1.GetPortfolioSnapshotRequest{
+				PortfolioId: "mybank-myportfolio",
+				Tag:         "long-term",
+			})},
+			wantRes: func(t *testing.T, r *connect.Response[portfoliov1.PortfolioSnapshot]) bool {
+				return true &&
+					assert.Equals(t, 20, r.Msg.Positions["US0378331005"].Amount) &&
+					assert.Equals(t, portfoliov1.Value(214160), r.Msg.Positions["US0378331005"].PurchaseValue, protocmp.Transform())
+			},
+		},
+		{
+			name: "filtered by tag of security",
+			fields: fields{
+				portfolios: myPortfolio(t),
+				securities: &mockSecuritiesClient{
+					securities: []*portfoliov1.Security{
+						{Id: "US0378331005", DisplayName: "Apple, Inc.", Tags: []string{"tech"}},
+					},
+				},
+			},
+			args: args{req: connect.NewRequest(&portfoliov1.GetPortfolioSnapshotRequest{
+				PortfolioId: "mybank-myportfolio",
+				Tag:         "tech",
+			})},
+			wantRes: func(t *testing.T, r *connect.Response[portfoliov1.PortfolioSnapshot]) bool {
+				return assert.Equals(t, 10, r.Msg.Positions["US0378331005"].Amount)
+			},
+		},
+		{
+			name: "filtered by unknown tag",
+			fields: fields{
+				portfolios: myPortfolio(t),
+				securities: mockSecuritiesClientWithData,
+			},
+			args: args{req: connect.NewRequest(&portfoliov1.GetPortfolioSnapshotRequest{
+				PortfolioId: "mybank-myportfolio",
+				Tag:         "speculation",
+			})},
+			wantRes: func(t *testing.T, r *connect.Response[portfoliov1.PortfolioSnapshot]) bool {
+				return true &&
+					assert.Equals(t, 0, len(r.Msg.Positions)) &&
+					assert.Equals(t, portfoliov1.Zero(), r.Msg.Cash, protocmp.Transform())
+			},
+		},
 		{
 			name: "happy path, position zero'd out",
 			fields: fields{
@@ -169,7 +222,7 @@ func Test_service_GetPortfolioSnapshot(t *testing.T) {
 				svc.events = tt.fields.events
 			}
 
-			gotRes, err := svc.GetPortfolioSnapshot(tt.args.ctx, tt.args.req)
+			gotRes, err := svc.GetPortfolioSnapshot(internal.WithTestUser(tt.args.ctx), tt.args.req)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("service.GetPortfolioSnapshot() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -179,19 +232,102 @@ func Test_service_GetPortfolioSnapshot(t *testing.T) {
 	}
 }
 
+func Test_service_GetConsolidatedSnapshot(t *testing.T) {
+	var (
+		db     = internal.NewTestDB(t)
+		svc    = NewService(Options{DB: db, SecuritiesClient: mockSecuritiesClientWithData})
+		events = persistence.Ops[*portfoliov1.PortfolioEvent](db)
+		money  = internal.WithTestUser(context.Background())
+		gopher = auth.NewContext(context.Background(), &auth.User{Subject: "gopher", Roles: []auth.Role{auth.RoleEditor}})
+		err    error
+	)
+
+	for i, id := range []string{"mybank-myportfolio", "otherbank-myportfolio"} {
+		_, err = svc.CreatePortfolio(money, connect.NewRequest(&portfoliov1.CreatePortfolioRequest{
+			Portfolio: &portfoliov1.Portfolio{Id: id, DisplayName: id},
+		}))
+		assert.NoError(t, err)
+
+		err = events.Replace(money, &portfoliov1.PortfolioEvent{
+			Id:          id + "-buy",
+			Type:        portfoliov1.PortfolioEventType_PORTFOLIO_EVENT_TYPE_BUY,
+			PortfolioId: id,
+			SecurityId:  "US0378331005",
+			Amount:      5,
+			Price:       portfoliov1.Value(int32(10000 * (i + 1))),
+			Time:        timestamppb.New(time.Date(2020+i, 1, 1, 0, 0, 0, 0, time.UTC)),
+		})
+		assert.NoError(t, err)
+	}
+
+	consolidate := func(ctx context.Context, ids ...string) (*portfoliov1.ConsolidatedSnapshot, error) {
+		res, err := svc.GetConsolidatedSnapshot(ctx, connect.NewRequest(&portfoliov1.GetConsolidatedSnapshotRequest{
+			PortfolioIds: ids,
+		}))
+		if err != nil {
+			return nil, err
+		}
+
+		return res.Msg, nil
+	}
+
+	_, err = consolidate(money)
+	assert.Equals(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	_, err = consolidate(gopher, "mybank-myportfolio")
+	assert.Equals(t, connect.CodeNotFound, connect.CodeOf(err))
+
+	snap, err := consolidate(money, "mybank-myportfolio", "otherbank-myportfolio")
+	assert.NoError(t, err)
+	assert.Equals(t, 2, len(snap.Portfolios))
+	assert.Equals(t, int32(50000), snap.Portfolios["mybank-myportfolio"].TotalPurchaseValue.Value)
+	assert.Equals(t, time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), snap.Total.FirstTransactionTime.AsTime())
+
+	// Both positions are merged and their cost basis is combined
+	pos := snap.Total.Positions["US0378331005"]
+	assert.Equals(t, 1, len(snap.Total.Positions))
+	assert.Equals(t, 10, pos.Amount)
+	assert.Equals(t, int32(150000), pos.PurchaseValue.Value)
+	assert.Equals(t, int32(15000), pos.PurchasePrice.Value)
+	assert.Equals(t, int32(100000), pos.MarketValue.Value)
+	assert.Equals(t, int32(-50000), pos.ProfitOrLoss.Value)
+	assert.Equals(t, int32(-150000), snap.Total.Cash.Value)
+	assert.Equals(t, int32(-50000), snap.Total.TotalPortfolioValue.Value)
+
+	// A portfolio that is requested twice is only counted once
+	snap, err = consolidate(money, "mybank-myportfolio", "mybank-myportfolio")
+	assert.NoError(t, err)
+	assert.Equals(t, 1, len(snap.Portfolios))
+	assert.Equals(t, 5, snap.Total.Positions["US0378331005"].Amount)
+	assert.Equals(t, int32(50000), snap.Total.TotalPurchaseValue.Value)
+}
+
 type mockSecuritiesClient struct {
 	securities          []*portfoliov1.Security
 	listSecuritiesError error
 }
 
-func (m *mockSecuritiesClient) ListSecurities(context.Context, *connect.Request[portfoliov1.ListSecuritiesRequest]) (*connect.Response[portfoliov1.ListSecuritiesResponse], error) {
+func (m *mockSecuritiesClient) ListSecurities(_ context.Context, req *connect.Request[portfoliov1.ListSecuritiesRequest]) (*connect.Response[portfoliov1.ListSecuritiesResponse], error) {
+	securities := m.securities
+	if tag := req.Msg.Filter.GetTag(); tag != "" {
+		securities = slices.DeleteFunc(slices.Clone(securities), func(s *portfoliov1.Security) bool {
+			return !slices.Contains(s.Tags, tag)
+		})
+	}
+
 	return connect.NewResponse(&portfoliov1.ListSecuritiesResponse{
-		Securities: m.securities,
+		Securities: securities,
 	}), m.listSecuritiesError
 }
 
-func (*mockSecuritiesClient) GetSecurity(context.Context, *connect.Request[portfoliov1.GetSecurityRequest]) (*connect.Response[portfoliov1.Security], error) {
-	return nil, nil
+func (m *mockSecuritiesClient) GetSecurity(_ context.Context, req *connect.Request[portfoliov1.GetSecurityRequest]) (*connect.Response[portfoliov1.Security], error) {
+	for _, sec := range m.securities {
+		if sec.Id == req.Msg.Id {
+			return connect.NewResponse(sec), nil
+		}
+	}
+
+	return nil, connect.NewError(connect.CodeNotFound, errors.New("security not found"))
 }
 
 func (*mockSecuritiesClient) CreateSecurity(context.Context, *connect.Request[portfoliov1.CreateSecurityRequest]) (*connect.Response[portfoliov1.Security], error) {
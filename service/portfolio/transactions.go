@@ -20,13 +20,21 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"fmt"
 	"log/slog"
+	"slices"
 
+	"github.com/oxisto/money-gopher/auth"
 	portfoliov1 "github.com/oxisto/money-gopher/gen"
 	"github.com/oxisto/money-gopher/import/csv"
+	"github.com/oxisto/money-gopher/persistence"
+	"github.com/oxisto/money-gopher/service/audit"
 	"github.com/oxisto/money-gopher/service/internal/crud"
+	"github.com/oxisto/money-gopher/service/internal/pagination"
 
 	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -35,31 +43,30 @@ var portfolioEventSetter = func(obj *portfoliov1.PortfolioEvent) *portfoliov1.Po
 }
 
 var (
-	ErrMissingSecurityId = errors.New("the specified transaction type requires a security ID")
-	ErrMissingPrice      = errors.New("a transaction requires a price")
-	ErrMissingAmount     = errors.New("the specified transaction type requires an amount")
+	ErrMissingSecurityId  = errors.New("the specified transaction type requires a security ID")
+	ErrMissingPrice       = errors.New("a transaction requires a price")
+	ErrMissingAmount      = errors.New("the specified transaction type requires an amount")
+	ErrMissingTransaction = errors.New("a transaction is required")
+	ErrTransferUpdate     = errors.New("only the note and tags of a transaction of a transfer can be updated; delete and recreate the transfer instead")
 )
 
+// metadataPaths are the paths of a transaction that can be updated without
+// changing its effect on the portfolio.
+var metadataPaths = []string{"note", "tags"}
+
 func (svc *service) CreatePortfolioTransaction(ctx context.Context, req *connect.Request[portfoliov1.CreatePortfolioTransactionRequest]) (res *connect.Response[portfoliov1.PortfolioEvent], err error) {
 	var (
 		tx *portfoliov1.PortfolioEvent = req.Msg.Transaction
 	)
 
-	// Do some basic validation depending on the type
-	switch tx.Type {
-	case portfoliov1.PortfolioEventType_PORTFOLIO_EVENT_TYPE_SELL:
-		fallthrough
-	case portfoliov1.PortfolioEventType_PORTFOLIO_EVENT_TYPE_BUY:
-		if tx.SecurityId == "" {
-			return nil, connect.NewError(connect.CodeInvalidArgument, ErrMissingSecurityId)
-		} else if tx.Amount == 0 {
-			return nil, connect.NewError(connect.CodeInvalidArgument, ErrMissingAmount)
-		}
+	err = validateTransaction(tx)
+	if err != nil {
+		return nil, err
 	}
 
-	// We always need a price
-	if tx.Price.IsZero() {
-		return nil, connect.NewError(connect.CodeInvalidArgument, ErrMissingPrice)
+	err = svc.requirePortfolioAccess(ctx, tx.PortfolioId, portfoliov1.PortfolioAccess_PORTFOLIO_ACCESS_WRITE)
+	if err != nil {
+		return nil, err
 	}
 
 	// Create a unique name for the transaction
@@ -70,37 +77,164 @@ func (svc *service) CreatePortfolioTransaction(ctx context.Context, req *connect
 		"transaction", req.Msg.Transaction,
 	)
 
-	return crud.Create(
+	res, err = crud.Create(
+		ctx,
 		req.Msg.Transaction,
 		svc.events,
 		portfolioEventSetter,
 	)
+	if err == nil {
+		svc.publish(tx.PortfolioId)
+	}
+
+	return
 }
 
-func (svc *service) GetPortfolioTransaction(ctx context.Context, req *connect.Request[portfoliov1.GetPortfolioTransactionRequest]) (res *connect.Response[portfoliov1.PortfolioEvent], err error) {
-	return crud.Get(
-		req.Msg.Id,
-		svc.events,
-		func(obj *portfoliov1.PortfolioEvent) *portfoliov1.PortfolioEvent {
-			return obj
-		},
+// validateTransaction does some basic validation of a new transaction
+// depending on its type.
+func validateTransaction(tx *portfoliov1.PortfolioEvent) error {
+	if tx == nil {
+		return connect.NewError(connect.CodeInvalidArgument, ErrMissingTransaction)
+	}
+
+	switch tx.Type {
+	case portfoliov1.PortfolioEventType_PORTFOLIO_EVENT_TYPE_SELL:
+		fallthrough
+	case portfoliov1.PortfolioEventType_PORTFOLIO_EVENT_TYPE_BUY:
+		if tx.SecurityId == "" {
+			return connect.NewError(connect.CodeInvalidArgument, ErrMissingSecurityId)
+		} else if tx.Amount == 0 {
+			return connect.NewError(connect.CodeInvalidArgument, ErrMissingAmount)
+		}
+	}
+
+	// We always need a price
+	if tx.Price.IsZero() {
+		return connect.NewError(connect.CodeInvalidArgument, ErrMissingPrice)
+	}
+
+	return nil
+}
+
+// validateUpdate checks, whether the update of old with the paths of in
+// results in a valid transaction. The sides of a transfer need to match, so
+// only their metadata can be updated on its own.
+func validateUpdate(old *portfoliov1.PortfolioEvent, in *portfoliov1.PortfolioEvent, paths []string) error {
+	if in == nil {
+		return connect.NewError(connect.CodeInvalidArgument, ErrMissingTransaction)
+	}
+
+	changes := slices.DeleteFunc(slices.Clone(paths), func(path string) bool {
+		return slices.Contains(metadataPaths, path)
+	})
+	if len(changes) == 0 {
+		return nil
+	}
+
+	if old.TransferId != "" || slices.Contains(changes, "transfer_id") {
+		return connect.NewError(connect.CodeFailedPrecondition, ErrTransferUpdate)
+	}
+
+	// Apply the update to a copy of the transaction, so that we can validate
+	// the result
+	var (
+		merged = proto.Clone(old).(*portfoliov1.PortfolioEvent)
+		src    = in.ProtoReflect()
+		dst    = merged.ProtoReflect()
 	)
+
+	for _, path := range changes {
+		fd := dst.Descriptor().Fields().ByName(protoreflect.Name(path))
+		if fd == nil {
+			continue
+		}
+
+		if src.Has(fd) {
+			dst.Set(fd, src.Get(fd))
+		} else {
+			dst.Clear(fd)
+		}
+	}
+
+	return validateTransaction(merged)
+}
+
+func (svc *service) GetPortfolioTransaction(ctx context.Context, req *connect.Request[portfoliov1.GetPortfolioTransactionRequest]) (res *connect.Response[portfoliov1.PortfolioEvent], err error) {
+	tx, err := svc.requireTransactionAccess(ctx, req.Msg.Id, portfoliov1.PortfolioAccess_PORTFOLIO_ACCESS_READ)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(tx), nil
 }
 
 func (svc *service) ListPortfolioTransactions(ctx context.Context, req *connect.Request[portfoliov1.ListPortfolioTransactionsRequest]) (res *connect.Response[portfoliov1.ListPortfolioTransactionsResponse], err error) {
-	return crud.List(
+	var opts persistence.ListOptions
+
+	err = svc.requirePortfolioAccess(ctx, req.Msg.PortfolioId, portfoliov1.PortfolioAccess_PORTFOLIO_ACCESS_READ)
+	if err != nil {
+		return nil, err
+	}
+
+	opts, err = pagination.Options(req.Msg.PageSize, req.Msg.PageToken, req.Msg.OrderBy, "time", "type", "security_id")
+	if err != nil {
+		return nil, err
+	}
+
+	opts.Filters = transactionFilters(req.Msg.Filter)
+
+	return crud.ListWithOptions(
+		ctx,
 		svc.events,
+		opts,
 		func(
 			res *connect.Response[portfoliov1.ListPortfolioTransactionsResponse],
 			list []*portfoliov1.PortfolioEvent,
+			nextPageToken string,
 		) error {
 			res.Msg.Transactions = list
+			res.Msg.NextPageToken = nextPageToken
 			return nil
 		},
 		req.Msg.PortfolioId,
 	)
 }
 
+// transactionFilters converts the filter of a transaction list into filters of
+// the persistence layer.
+func transactionFilters(filter *portfoliov1.ListPortfolioTransactionsRequest_Filter) (filters []persistence.Filter) {
+	if filter == nil {
+		return nil
+	}
+
+	if filter.StartTime != nil {
+		filters = append(filters, persistence.Filter{Column: "time", Operator: persistence.OperatorGreaterOrEqual, Value: filter.StartTime.AsTime()})
+	}
+
+	if filter.EndTime != nil {
+		filters = append(filters, persistence.Filter{Column: "time", Operator: persistence.OperatorLess, Value: filter.EndTime.AsTime()})
+	}
+
+	if len(filter.Types) > 0 {
+		types := make([]any, 0, len(filter.Types))
+		for _, typ := range filter.Types {
+			types = append(types, typ)
+		}
+
+		filters = append(filters, persistence.Filter{Column: "type", Operator: persistence.OperatorIn, Value: types})
+	}
+
+	if filter.SecurityId != "" {
+		filters = append(filters, persistence.Filter{Column: "security_id", Operator: persistence.OperatorEquals, Value: filter.SecurityId})
+	}
+
+	if filter.Tag != "" {
+		filters = append(filters, persistence.Filter{Column: "tags", Operator: persistence.OperatorContains, Value: filter.Tag})
+	}
+
+	return filters
+}
+
 func (svc *service) UpdatePortfolioTransaction(ctx context.Context, req *connect.Request[portfoliov1.UpdatePortfolioTransactionRequest]) (res *connect.Response[portfoliov1.PortfolioEvent], err error) {
 	slog.Info(
 		"Updating transaction",
@@ -108,20 +242,63 @@ func (svc *service) UpdatePortfolioTransaction(ctx context.Context, req *connect
 		"update-mask", req.Msg.UpdateMask.Paths,
 	)
 
-	return crud.Update(
+	old, err := svc.requireTransactionAccess(ctx, req.Msg.Transaction.GetId(), portfoliov1.PortfolioAccess_PORTFOLIO_ACCESS_WRITE)
+	if err != nil {
+		return nil, err
+	}
+
+	err = validateUpdate(old, req.Msg.Transaction, req.Msg.GetUpdateMask().GetPaths())
+	if err != nil {
+		return nil, err
+	}
+
+	// If the transaction is moved to another portfolio, we also need to be
+	// allowed to write to it
+	if slices.Contains(req.Msg.GetUpdateMask().GetPaths(), "portfolio_id") {
+		err = svc.requirePortfolioAccess(ctx, req.Msg.Transaction.PortfolioId, portfoliov1.PortfolioAccess_PORTFOLIO_ACCESS_WRITE)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	res, err = crud.Update(
+		ctx,
 		req.Msg.Transaction.Id,
 		req.Msg.Transaction,
 		req.Msg.UpdateMask.Paths,
 		svc.events,
 		portfolioEventSetter,
 	)
+	if err == nil {
+		svc.publish(old.PortfolioId, res.Msg.PortfolioId)
+	}
+
+	return
 }
 
-func (svc *service) DeletePortfolioTransactions(ctx context.Context, req *connect.Request[portfoliov1.DeletePortfolioTransactionRequest]) (res *connect.Response[emptypb.Empty], err error) {
-	return crud.Delete(
-		req.Msg.TransactionId,
+func (svc *service) DeletePortfolioTransaction(ctx context.Context, req *connect.Request[portfoliov1.DeletePortfolioTransactionRequest]) (res *connect.Response[emptypb.Empty], err error) {
+	tx, err := svc.requireTransactionAccess(ctx, req.Msg.Id, portfoliov1.PortfolioAccess_PORTFOLIO_ACCESS_WRITE)
+	if err != nil {
+		return nil, err
+	}
+
+	// All sides of a transfer need to be deleted at once
+	if tx.TransferId != "" {
+		return svc.BatchDeletePortfolioTransactions(ctx, connect.NewRequest(&portfoliov1.BatchDeletePortfolioTransactionsRequest{
+			Ids: []string{req.Msg.Id},
+		}))
+	}
+
+	res, err = crud.Delete(
+		ctx,
+		req.Msg.Id,
 		svc.events,
 	)
+	if err == nil {
+		svc.publish(tx.PortfolioId)
+	}
+
+	return
 }
 
 func (svc *service) ImportTransactions(ctx context.Context, req *connect.Request[portfoliov1.ImportTransactionsRequest]) (res *connect.Response[emptypb.Empty], err error) {
@@ -130,13 +307,38 @@ func (svc *service) ImportTransactions(ctx context.Context, req *connect.Request
 		secs []*portfoliov1.Security
 	)
 
+	err = svc.requirePortfolioAccess(ctx, req.Msg.PortfolioId, portfoliov1.PortfolioAccess_PORTFOLIO_ACCESS_WRITE)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	txs, secs = csv.Import(bytes.NewReader([]byte(req.Msg.FromCsv)), req.Msg.PortfolioId)
 
 	for _, sec := range secs {
+		// The securities service is called in-process, so its permissions are
+		// not checked by the interceptor. Users that are not allowed to change
+		// securities can only import transactions of known securities, which
+		// are left as they are.
+		if !u.HasPermission(auth.PermissionWriteSecurities) {
+			_, err = svc.securities.GetSecurity(ctx, connect.NewRequest(&portfoliov1.GetSecurityRequest{Id: sec.Id}))
+			if connect.CodeOf(err) == connect.CodeNotFound {
+				return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("%w: unknown security %s", ErrInsufficientAccess, sec.Id))
+			} else if err != nil {
+				return nil, err
+			}
+
+			continue
+		}
+
 		// TODO(oxisto): Once "Create" is really create and not replace, we need
 		//  to change this to something else.
 		svc.securities.CreateSecurity(
-			context.Background(),
+			ctx,
 			connect.NewRequest(&portfoliov1.CreateSecurityRequest{
 				Security: sec,
 			}),
@@ -144,12 +346,19 @@ func (svc *service) ImportTransactions(ctx context.Context, req *connect.Request
 	}
 
 	for _, tx := range txs {
-		err = svc.events.Replace(tx)
+		err = svc.events.Replace(ctx, tx)
 		if err != nil {
 			return nil, connect.NewError(connect.CodeInternal, err)
 		}
+
+		audit.Record(ctx, audit.Event{
+			Action: portfoliov1.AuditAction_AUDIT_ACTION_IMPORT,
+			After:  tx,
+		})
 	}
 
+	svc.publish(req.Msg.PortfolioId)
+
 	res = connect.NewResponse(&emptypb.Empty{})
 
 	return
@@ -19,14 +19,18 @@ package portfolio
 import (
 	"context"
 	"testing"
+	"time"
 
+	"github.com/oxisto/money-gopher/auth"
 	portfoliov1 "github.com/oxisto/money-gopher/gen"
 	"github.com/oxisto/money-gopher/gen/portfoliov1connect"
+	"github.com/oxisto/money-gopher/internal"
 	"github.com/oxisto/money-gopher/persistence"
 
 	"connectrpc.com/connect"
 	"github.com/oxisto/assert"
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func Test_service_CreatePortfolioTransaction(t *testing.T) {
@@ -66,7 +70,7 @@ func Test_service_CreatePortfolioTransaction(t *testing.T) {
 				return assert.Equals(t, "My Security", r.Msg.GetSecurityId())
 			},
 			wantSvc: func(t *testing.T, s *service) bool {
-				list, _ := s.events.List("mybank-myportfolio")
+				list, _ := s.events.List(context.Background(), "mybank-myportfolio")
 				return assert.Equals(t, 3, len(list))
 			},
 		},
@@ -90,7 +94,7 @@ func Test_service_CreatePortfolioTransaction(t *testing.T) {
 				return assert.Equals(t, "My Security", r.Msg.GetSecurityId())
 			},
 			wantSvc: func(t *testing.T, s *service) bool {
-				list, _ := s.events.List("mybank-myportfolio")
+				list, _ := s.events.List(context.Background(), "mybank-myportfolio")
 				return assert.Equals(t, 3, len(list))
 			},
 		},
@@ -127,7 +131,7 @@ func Test_service_CreatePortfolioTransaction(t *testing.T) {
 				events:     persistence.Relationship[*portfoliov1.PortfolioEvent](tt.fields.portfolios),
 				securities: tt.fields.securities,
 			}
-			gotRes, err := svc.CreatePortfolioTransaction(tt.args.ctx, tt.args.req)
+			gotRes, err := svc.CreatePortfolioTransaction(internal.WithTestUser(tt.args.ctx), tt.args.req)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("service.CreatePortfolioTransaction() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -179,7 +183,7 @@ func Test_service_GetPortfolioTransaction(t *testing.T) {
 				events:     persistence.Relationship[*portfoliov1.PortfolioEvent](tt.fields.portfolios),
 				securities: tt.fields.securities,
 			}
-			gotRes, err := svc.GetPortfolioTransaction(tt.args.ctx, tt.args.req)
+			gotRes, err := svc.GetPortfolioTransaction(internal.WithTestUser(tt.args.ctx), tt.args.req)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("service.GetPortfolioTransaction() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -216,9 +220,105 @@ func Test_service_ListPortfolioTransactions(t *testing.T) {
 				}),
 			},
 			wantRes: func(t *testing.T, r *connect.Response[portfoliov1.ListPortfolioTransactionsResponse]) bool {
-				return assert.Equals(t, 2, len(r.Msg.Transactions))
+				return assert.Equals(t, 2, len(r.Msg.Transactions)) &&
+					assert.Equals(t, "buy", r.Msg.Transactions[0].Id) &&
+					assert.Equals(t, "", r.Msg.NextPageToken)
 			},
 		},
+		{
+			name: "filtered",
+			fields: fields{
+				portfolios: myPortfolio(t),
+			},
+			args: args{
+				req: connect.NewRequest(&portfoliov1.ListPortfolioTransactionsRequest{
+					PortfolioId: "mybank-myportfolio",
+					Filter: &portfoliov1.ListPortfolioTransactionsRequest_Filter{
+						StartTime:  timestamppb.New(time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC)),
+						EndTime:    timestamppb.New(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)),
+						Types:      []portfoliov1.PortfolioEventType{portfoliov1.PortfolioEventType_PORTFOLIO_EVENT_TYPE_SELL},
+						SecurityId: "US0378331005",
+					},
+				}),
+			},
+			wantRes: func(t *testing.T, r *connect.Response[portfoliov1.ListPortfolioTransactionsResponse]) bool {
+				return assert.Equals(t, 1, len(r.Msg.Transactions)) &&
+					assert.Equals(t, "sell", r.Msg.Transactions[0].Id)
+			},
+		},
+		{
+			name: "filtered by tag",
+			fields: fields{
+				portfolios: myPortfolio(t),
+			},
+			args: args{
+				req: connect.NewRequest(&portfoliov1.ListPortfolioTransactionsRequest{
+					PortfolioId: "mybank-myportfolio",
+					Filter: &portfoliov1.ListPortfolioTransactionsRequest_Filter{
+						Tag: "long-term",
+					},
+				}),
+			},
+			wantRes: func(t *testing.T, r *connect.Response[portfoliov1.ListPortfolioTransactionsResponse]) bool {
+				return assert.Equals(t, 1, len(r.Msg.Transactions)) &&
+					assert.Equals(t, "buy", r.Msg.Transactions[0].Id) &&
+					assert.Equals(t, "Bought for the long term", r.Msg.Transactions[0].Note) &&
+					assert.Equals(t, []string{"long-term"}, r.Msg.Transactions[0].Tags)
+			},
+		},
+		{
+			name: "first page in descending order",
+			fields: fields{
+				portfolios: myPortfolio(t),
+			},
+			args: args{
+				req: connect.NewRequest(&portfoliov1.ListPortfolioTransactionsRequest{
+					PortfolioId: "mybank-myportfolio",
+					PageSize:    1,
+					OrderBy:     "time desc",
+				}),
+			},
+			wantRes: func(t *testing.T, r *connect.Response[portfoliov1.ListPortfolioTransactionsResponse]) bool {
+				return assert.Equals(t, 1, len(r.Msg.Transactions)) &&
+					assert.Equals(t, "sell", r.Msg.Transactions[0].Id) &&
+					assert.Equals(t, "MQ", r.Msg.NextPageToken)
+			},
+		},
+		{
+			name: "last page in descending order",
+			fields: fields{
+				portfolios: myPortfolio(t),
+			},
+			args: args{
+				req: connect.NewRequest(&portfoliov1.ListPortfolioTransactionsRequest{
+					PortfolioId: "mybank-myportfolio",
+					PageSize:    1,
+					PageToken:   "MQ",
+					OrderBy:     "time desc",
+				}),
+			},
+			wantRes: func(t *testing.T, r *connect.Response[portfoliov1.ListPortfolioTransactionsResponse]) bool {
+				return assert.Equals(t, 1, len(r.Msg.Transactions)) &&
+					assert.Equals(t, "buy", r.Msg.Transactions[0].Id) &&
+					assert.Equals(t, "", r.Msg.NextPageToken)
+			},
+		},
+		{
+			name: "invalid page token",
+			fields: fields{
+				portfolios: myPortfolio(t),
+			},
+			args: args{
+				req: connect.NewRequest(&portfoliov1.ListPortfolioTransactionsRequest{
+					PortfolioId: "mybank-myportfolio",
+					PageToken:   "not-a-token",
+				}),
+			},
+			wantRes: func(t *testing.T, r *connect.Response[portfoliov1.ListPortfolioTransactionsResponse]) bool {
+				return assert.Equals(t, nil, r)
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -227,7 +327,7 @@ func Test_service_ListPortfolioTransactions(t *testing.T) {
 				events:     persistence.Relationship[*portfoliov1.PortfolioEvent](tt.fields.portfolios),
 				securities: tt.fields.securities,
 			}
-			gotRes, err := svc.ListPortfolioTransactions(tt.args.ctx, tt.args.req)
+			gotRes, err := svc.ListPortfolioTransactions(internal.WithTestUser(tt.args.ctx), tt.args.req)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("service.ListPortfolioTransactions() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -272,6 +372,22 @@ func Test_service_UpdatePortfolioTransaction(t *testing.T) {
 				return assert.Equals(t, "My Second Security", r.Msg.SecurityId)
 			},
 		},
+		{
+			name: "invalid result",
+			fields: fields{
+				portfolios: myPortfolio(t),
+			},
+			args: args{
+				req: connect.NewRequest(&portfoliov1.UpdatePortfolioTransactionRequest{
+					Transaction: &portfoliov1.PortfolioEvent{Id: "buy"},
+					UpdateMask:  &fieldmaskpb.FieldMask{Paths: []string{"price"}},
+				}),
+			},
+			wantRes: func(t *testing.T, r *connect.Response[portfoliov1.PortfolioEvent]) bool {
+				return assert.Equals(t, nil, r)
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -280,7 +396,7 @@ func Test_service_UpdatePortfolioTransaction(t *testing.T) {
 				events:     persistence.Relationship[*portfoliov1.PortfolioEvent](tt.fields.portfolios),
 				securities: tt.fields.securities,
 			}
-			gotRes, err := svc.UpdatePortfolioTransaction(tt.args.ctx, tt.args.req)
+			gotRes, err := svc.UpdatePortfolioTransaction(internal.WithTestUser(tt.args.ctx), tt.args.req)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("service.UpdatePortfolioTransactions() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -290,7 +406,7 @@ func Test_service_UpdatePortfolioTransaction(t *testing.T) {
 	}
 }
 
-func Test_service_DeletePortfolioTransactions(t *testing.T) {
+func Test_service_DeletePortfolioTransaction(t *testing.T) {
 	type fields struct {
 		portfolios persistence.StorageOperations[*portfoliov1.Portfolio]
 		securities portfoliov1connect.SecuritiesServiceClient
@@ -313,14 +429,31 @@ func Test_service_DeletePortfolioTransactions(t *testing.T) {
 			},
 			args: args{
 				req: connect.NewRequest(&portfoliov1.DeletePortfolioTransactionRequest{
-					TransactionId: 1,
+					Id: "buy",
 				}),
 			},
 			wantSvc: func(t *testing.T, s *service) bool {
-				list, _ := s.portfolios.List("mybank-myportfolio")
-				return assert.Equals(t, 0, len(list))
+				list, _ := s.events.List(context.Background(), "mybank-myportfolio")
+				return assert.Equals(t, 1, len(list)) &&
+					assert.Equals(t, "sell", list[0].Id)
 			},
 		},
+		{
+			name: "not found",
+			fields: fields{
+				portfolios: myPortfolio(t),
+			},
+			args: args{
+				req: connect.NewRequest(&portfoliov1.DeletePortfolioTransactionRequest{
+					Id: "does-not-exist",
+				}),
+			},
+			wantSvc: func(t *testing.T, s *service) bool {
+				list, _ := s.events.List(context.Background(), "mybank-myportfolio")
+				return assert.Equals(t, 2, len(list))
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -329,9 +462,9 @@ func Test_service_DeletePortfolioTransactions(t *testing.T) {
 				events:     persistence.Relationship[*portfoliov1.PortfolioEvent](tt.fields.portfolios),
 				securities: tt.fields.securities,
 			}
-			_, err := svc.DeletePortfolioTransactions(tt.args.ctx, tt.args.req)
+			_, err := svc.DeletePortfolioTransaction(internal.WithTestUser(tt.args.ctx), tt.args.req)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("service.DeletePortfolioTransactions() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("service.DeletePortfolioTransaction() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
 			tt.wantSvc(t, svc)
@@ -371,12 +504,65 @@ func Test_service_ImportTransactions(t *testing.T) {
 				}),
 			},
 			wantSvc: func(t *testing.T, s *service) bool {
-				txs, err := s.events.List("mybank-myportfolio")
+				txs, err := s.events.List(context.Background(), "mybank-myportfolio")
 				return true &&
 					assert.NoError(t, err) &&
 					assert.Equals(t, 3, len(txs))
 			},
 		},
+		{
+			name: "unknown security without permission",
+			fields: fields{
+				portfolios: emptyPortfolio(t),
+				securities: &mockSecuritiesClient{},
+			},
+			args: args{
+				ctx: auth.NewContext(context.Background(), &auth.User{
+					Subject: internal.TestUser,
+					Roles:   []auth.Role{auth.RoleEditor},
+					Scopes:  []auth.Permission{auth.PermissionReadPortfolios, auth.PermissionWritePortfolios},
+				}),
+				req: connect.NewRequest(&portfoliov1.ImportTransactionsRequest{
+					PortfolioId: "mybank-myportfolio",
+					FromCsv: `Date;Type;Value;Transaction Currency;Gross Amount;Currency Gross Amount;Exchange Rate;Fees;Taxes;Shares;ISIN;WKN;Ticker Symbol;Security Name;Note
+2021-06-05T00:00;Buy;2.151,85;EUR;;;;10,25;0,00;20;US0378331005;865985;APC.F;Apple Inc.;`,
+				}),
+			},
+			wantSvc: func(t *testing.T, s *service) bool {
+				txs, err := s.events.List(context.Background(), "mybank-myportfolio")
+				return true &&
+					assert.NoError(t, err) &&
+					assert.Equals(t, 0, len(txs))
+			},
+			wantErr: true,
+		},
+		{
+			name: "known security without permission",
+			fields: fields{
+				portfolios: emptyPortfolio(t),
+				securities: &mockSecuritiesClient{
+					securities: []*portfoliov1.Security{{Id: "US0378331005", DisplayName: "Apple Inc."}},
+				},
+			},
+			args: args{
+				ctx: auth.NewContext(context.Background(), &auth.User{
+					Subject: internal.TestUser,
+					Roles:   []auth.Role{auth.RoleEditor},
+					Scopes:  []auth.Permission{auth.PermissionReadPortfolios, auth.PermissionWritePortfolios},
+				}),
+				req: connect.NewRequest(&portfoliov1.ImportTransactionsRequest{
+					PortfolioId: "mybank-myportfolio",
+					FromCsv: `Date;Type;Value;Transaction Currency;Gross Amount;Currency Gross Amount;Exchange Rate;Fees;Taxes;Shares;ISIN;WKN;Ticker Symbol;Security Name;Note
+2021-06-05T00:00;Buy;2.151,85;EUR;;;;10,25;0,00;20;US0378331005;865985;APC.F;Apple Inc.;`,
+				}),
+			},
+			wantSvc: func(t *testing.T, s *service) bool {
+				txs, err := s.events.List(context.Background(), "mybank-myportfolio")
+				return true &&
+					assert.NoError(t, err) &&
+					assert.Equals(t, 1, len(txs))
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -385,10 +571,9 @@ func Test_service_ImportTransactions(t *testing.T) {
 				events:     persistence.Relationship[*portfoliov1.PortfolioEvent](tt.fields.portfolios),
 				securities: tt.fields.securities,
 			}
-			_, err := svc.ImportTransactions(tt.args.ctx, tt.args.req)
+			_, err := svc.ImportTransactions(internal.WithTestUser(tt.args.ctx), tt.args.req)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("service.ImportTransactions() error = %v, wantErr %v", err, tt.wantErr)
-				return
 			}
 			tt.wantSvc(t, svc)
 		})
@@ -0,0 +1,269 @@
+// Copyright 2023 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package portfolio
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/oxisto/money-gopher/finance"
+	portfoliov1 "github.com/oxisto/money-gopher/gen"
+	"github.com/oxisto/money-gopher/persistence"
+	"github.com/oxisto/money-gopher/service/audit"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	ErrMissingTransfer     = errors.New("a transfer is required")
+	ErrSamePortfolio       = errors.New("source and target portfolio of a transfer must be different")
+	ErrMissingTransferSize = errors.New("a transfer requires either a security and an amount or cash")
+	ErrInsufficientShares  = errors.New("the source portfolio does not hold enough shares")
+)
+
+// CreatePortfolioTransfer moves shares or cash from one portfolio to another.
+// Both sides are stored as individual transactions that share the ID of the
+// transfer. Shares are taken from the FIFO lots of the source portfolio and
+// each lot becomes its own inbound transaction in the target portfolio, so
+// that it keeps its purchase price and time.
+func (svc *service) CreatePortfolioTransfer(ctx context.Context, req *connect.Request[portfoliov1.CreatePortfolioTransferRequest]) (res *connect.Response[portfoliov1.PortfolioTransfer], err error) {
+	var (
+		t   = req.Msg.Transfer
+		out *portfoliov1.PortfolioEvent
+		in  []*portfoliov1.PortfolioEvent
+	)
+
+	err = validateTransfer(t)
+	if err != nil {
+		return nil, err
+	}
+
+	// If no time is specified, we assume it to be now
+	if t.Time == nil {
+		t.Time = timestamppb.Now()
+	}
+
+	for _, id := range []string{t.SourcePortfolioId, t.TargetPortfolioId} {
+		err = svc.requirePortfolioAccess(ctx, id, portfoliov1.PortfolioAccess_PORTFOLIO_ACCESS_WRITE)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	out = &portfoliov1.PortfolioEvent{
+		Time:        t.Time,
+		PortfolioId: t.SourcePortfolioId,
+		SecurityId:  t.SecurityId,
+		Amount:      t.Amount,
+		Fees:        t.Fees,
+	}
+
+	if t.SecurityId != "" {
+		out.Type = portfoliov1.PortfolioEventType_PORTFOLIO_EVENT_TYPE_TRANSFER_OUTBOUND
+		out.Price = portfoliov1.Zero()
+	} else {
+		out.Type = portfoliov1.PortfolioEventType_PORTFOLIO_EVENT_TYPE_TRANSFER_CASH_OUT
+		out.Price = t.Cash
+
+		in = append(in, &portfoliov1.PortfolioEvent{
+			Type:        portfoliov1.PortfolioEventType_PORTFOLIO_EVENT_TYPE_TRANSFER_CASH_IN,
+			Time:        t.Time,
+			PortfolioId: t.TargetPortfolioId,
+			Price:       t.Cash,
+		})
+	}
+
+	// The ID of the outbound transaction is also the ID of the transfer. It is
+	// random, since several transfers can share all of their other fields.
+	t.Id, err = newTransferID()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	out.Id = t.Id
+	out.TransferId = t.Id
+
+	slog.Info("Creating transfer", "id", t.Id, "source", t.SourcePortfolioId, "target", t.TargetPortfolioId)
+
+	// The lots are determined within the transaction, so that concurrent
+	// transfers cannot take the same shares
+	err = persistence.InTx(ctx, svc.events, func(op persistence.StorageOperations[*portfoliov1.PortfolioEvent]) error {
+		if t.SecurityId != "" {
+			lots, err := lotsAt(ctx, op, t, out)
+			if err != nil {
+				return err
+			}
+
+			for _, lot := range lots {
+				in = append(in, &portfoliov1.PortfolioEvent{
+					Type:         portfoliov1.PortfolioEventType_PORTFOLIO_EVENT_TYPE_TRANSFER_INBOUND,
+					Time:         t.Time,
+					PortfolioId:  t.TargetPortfolioId,
+					SecurityId:   t.SecurityId,
+					Amount:       lot.Amount,
+					Price:        lot.Price,
+					Fees:         lot.Fees,
+					PurchaseTime: timestamppb.New(lot.Time),
+				})
+			}
+		}
+
+		// All inbound transactions are numbered after the transfer
+		for i, tx := range in {
+			tx.Id = fmt.Sprintf("%s-%d", t.Id, i+1)
+			tx.TransferId = t.Id
+		}
+
+		t.Transactions = append([]*portfoliov1.PortfolioEvent{out}, in...)
+
+		for _, tx := range t.Transactions {
+			existing, err := op.Get(ctx, tx.Id)
+			if err != nil {
+				return connect.NewError(connect.CodeInternal, err)
+			} else if existing != nil {
+				return connect.NewError(connect.CodeAlreadyExists, ErrAlreadyExists)
+			}
+
+			err = op.Replace(ctx, tx)
+			if err != nil {
+				return connect.NewError(connect.CodeInternal, err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tx := range t.Transactions {
+		audit.Record(ctx, audit.Event{
+			Action: portfoliov1.AuditAction_AUDIT_ACTION_CREATE,
+			After:  tx,
+		})
+	}
+
+	svc.publish(sorted([]string{t.SourcePortfolioId, t.TargetPortfolioId})...)
+
+	return connect.NewResponse(t), nil
+}
+
+// newTransferID generates a new random identifier of a transfer.
+func newTransferID() (string, error) {
+	var b = make([]byte, 8)
+
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+
+	return "transfer-" + hex.EncodeToString(b), nil
+}
+
+// validateTransfer does some basic validation of a new transfer.
+func validateTransfer(t *portfoliov1.PortfolioTransfer) error {
+	if t == nil {
+		return connect.NewError(connect.CodeInvalidArgument, ErrMissingTransfer)
+	}
+
+	if t.SourcePortfolioId == t.TargetPortfolioId {
+		return connect.NewError(connect.CodeInvalidArgument, ErrSamePortfolio)
+	}
+
+	if (t.SecurityId != "" && t.Amount <= 0) || (t.SecurityId == "" && t.Cash.GetValue() <= 0) {
+		return connect.NewError(connect.CodeInvalidArgument, ErrMissingTransferSize)
+	}
+
+	return nil
+}
+
+// lotsAt returns the lots of the source portfolio of t that are transferred
+// by the outbound transaction out. Since a transfer can be backdated, it also
+// makes sure that the source portfolio still holds enough shares for all
+// later transactions.
+func lotsAt(ctx context.Context, op persistence.StorageOperations[*portfoliov1.PortfolioEvent], t *portfoliov1.PortfolioTransfer, out *portfoliov1.PortfolioEvent) (lots []*finance.Lot, err error) {
+	var p portfoliov1.Portfolio
+
+	p.Events, err = op.List(ctx, t.SourcePortfolioId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	// Shares of pending executions cannot be transferred yet
+	p.Events = portfoliov1.ExecutedEvents(p.Events)
+
+	txs := p.EventMap()[t.SecurityId]
+
+	c := finance.NewCalculation(portfoliov1.EventsBefore(txs, t.Time.AsTime()))
+	if c.Amount < t.Amount {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, ErrInsufficientShares)
+	}
+
+	lots = finance.TakeLots(c.Lots(), t.Amount)
+
+	// Replay all later transactions after the transfer
+	c.Apply(out)
+	for _, tx := range txs {
+		if !tx.Time.AsTime().After(t.Time.AsTime()) {
+			continue
+		}
+
+		if removesShares(tx) && c.Amount < tx.Amount {
+			return nil, connect.NewError(connect.CodeFailedPrecondition,
+				fmt.Errorf("%w for transaction %s after the transfer", ErrInsufficientShares, tx.Id))
+		}
+
+		c.Apply(tx)
+	}
+
+	return lots, nil
+}
+
+// removesShares returns true, if tx removes shares from a portfolio.
+func removesShares(tx *portfoliov1.PortfolioEvent) bool {
+	switch tx.Type {
+	case portfoliov1.PortfolioEventType_PORTFOLIO_EVENT_TYPE_SELL,
+		portfoliov1.PortfolioEventType_PORTFOLIO_EVENT_TYPE_DELIVERY_OUTBOUND,
+		portfoliov1.PortfolioEventType_PORTFOLIO_EVENT_TYPE_TRANSFER_OUTBOUND:
+		return true
+	default:
+		return false
+	}
+}
+
+// transferSides returns the IDs of all transactions that belong to the same
+// transfer as tx, including tx itself.
+func (svc *service) transferSides(ctx context.Context, tx *portfoliov1.PortfolioEvent) (ids []string, err error) {
+	if tx.TransferId == "" {
+		return []string{tx.Id}, nil
+	}
+
+	rows, err := svc.q.ListTransferEvents(ctx, tx.TransferId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	for _, row := range rows {
+		ids = append(ids, row.ID)
+	}
+
+	return
+}
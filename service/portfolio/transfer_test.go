@@ -0,0 +1,210 @@
+// Copyright 2023 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package portfolio
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/oxisto/money-gopher/auth"
+	portfoliov1 "github.com/oxisto/money-gopher/gen"
+	"github.com/oxisto/money-gopher/internal"
+	"github.com/oxisto/money-gopher/persistence"
+
+	"connectrpc.com/connect"
+	"github.com/oxisto/assert"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestCreatePortfolioTransfer(t *testing.T) {
+	var (
+		db     = internal.NewTestDB(t)
+		svc    = NewService(Options{DB: db})
+		events = persistence.Ops[*portfoliov1.PortfolioEvent](db)
+		money  = internal.WithTestUser(context.Background())
+		gopher = auth.NewContext(context.Background(), &auth.User{Subject: "gopher", Roles: []auth.Role{auth.RoleEditor}})
+		when   = timestamppb.New(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC))
+		err    error
+	)
+
+	for _, id := range []string{"mybank-source", "mybank-target"} {
+		_, err = svc.CreatePortfolio(money, connect.NewRequest(&portfoliov1.CreatePortfolioRequest{
+			Portfolio: &portfoliov1.Portfolio{Id: id, DisplayName: id},
+		}))
+		assert.NoError(t, err)
+	}
+
+	for _, tx := range []*portfoliov1.PortfolioEvent{
+		{
+			Id:    "deposit",
+			Type:  portfoliov1.PortfolioEventType_PORTFOLIO_EVENT_TYPE_DEPOSIT_CASH,
+			Price: portfoliov1.Value(200000),
+			Time:  timestamppb.New(time.Date(2019, 12, 1, 0, 0, 0, 0, time.UTC)),
+		},
+		{
+			Id:         "buy1",
+			Type:       portfoliov1.PortfolioEventType_PORTFOLIO_EVENT_TYPE_BUY,
+			SecurityId: "US0378331005",
+			Amount:     5,
+			Price:      portfoliov1.Value(10000),
+			Fees:       portfoliov1.Value(500),
+			Time:       timestamppb.New(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)),
+		},
+		{
+			Id:         "buy2",
+			Type:       portfoliov1.PortfolioEventType_PORTFOLIO_EVENT_TYPE_BUY,
+			SecurityId: "US0378331005",
+			Amount:     5,
+			Price:      portfoliov1.Value(20000),
+			Fees:       portfoliov1.Value(500),
+			Time:       timestamppb.New(time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC)),
+		},
+	} {
+		tx.PortfolioId = "mybank-source"
+		assert.NoError(t, events.Replace(money, tx))
+	}
+
+	transfer := func(ctx context.Context, tr *portfoliov1.PortfolioTransfer) (*portfoliov1.PortfolioTransfer, error) {
+		res, err := svc.CreatePortfolioTransfer(ctx, connect.NewRequest(&portfoliov1.CreatePortfolioTransferRequest{
+			Transfer: tr,
+		}))
+		if err != nil {
+			return nil, err
+		}
+
+		return res.Msg, nil
+	}
+
+	snapshot := func(id string) *portfoliov1.PortfolioSnapshot {
+		res, err := svc.GetPortfolioSnapshot(money, connect.NewRequest(&portfoliov1.GetPortfolioSnapshotRequest{
+			PortfolioId: id,
+		}))
+		assert.NoError(t, err)
+		return res.Msg
+	}
+
+	// Invalid transfers
+	_, err = transfer(money, &portfoliov1.PortfolioTransfer{
+		SourcePortfolioId: "mybank-source", TargetPortfolioId: "mybank-source", SecurityId: "US0378331005", Amount: 1,
+	})
+	assert.Equals(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	_, err = transfer(money, &portfoliov1.PortfolioTransfer{
+		SourcePortfolioId: "mybank-source", TargetPortfolioId: "mybank-target", SecurityId: "US0378331005", Amount: 11, Time: when,
+	})
+	assert.Equals(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+
+	_, err = transfer(gopher, &portfoliov1.PortfolioTransfer{
+		SourcePortfolioId: "mybank-source", TargetPortfolioId: "mybank-target", SecurityId: "US0378331005", Amount: 1, Time: when,
+	})
+	assert.Equals(t, connect.CodeNotFound, connect.CodeOf(err))
+
+	// Shares are transferred with their FIFO lots
+	tr, err := transfer(money, &portfoliov1.PortfolioTransfer{
+		SourcePortfolioId: "mybank-source",
+		TargetPortfolioId: "mybank-target",
+		SecurityId:        "US0378331005",
+		Amount:            7,
+		Fees:              portfoliov1.Value(100),
+		Time:              when,
+	})
+	assert.NoError(t, err)
+	assert.Equals(t, 3, len(tr.Transactions))
+	assert.Equals(t, tr.Id, tr.Transactions[2].TransferId)
+	assert.Equals(t, 2, tr.Transactions[2].Amount)
+	assert.Equals(t, int32(20000), tr.Transactions[2].Price.Value)
+	assert.Equals(t, int32(200), tr.Transactions[2].Fees.Value)
+	assert.Equals(t, time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC), tr.Transactions[2].PurchaseTime.AsTime())
+
+	source := snapshot("mybank-source")
+	assert.Equals(t, 3, source.Positions["US0378331005"].Amount)
+	assert.Equals(t, int32(60000), source.TotalPurchaseValue.Value)
+	assert.Equals(t, int32(48900), source.Cash.Value)
+
+	target := snapshot("mybank-target")
+	assert.Equals(t, 7, target.Positions["US0378331005"].Amount)
+	assert.Equals(t, int32(90000), target.TotalPurchaseValue.Value)
+	assert.Equals(t, int32(0), target.Cash.Value)
+
+	// A backdated transfer must leave enough shares for the later transfer
+	_, err = transfer(money, &portfoliov1.PortfolioTransfer{
+		SourcePortfolioId: "mybank-source",
+		TargetPortfolioId: "mybank-target",
+		SecurityId:        "US0378331005",
+		Amount:            5,
+		Time:              timestamppb.New(time.Date(2020, 7, 1, 0, 0, 0, 0, time.UTC)),
+	})
+	assert.Equals(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+	assert.ErrorIs(t, ErrInsufficientShares, err)
+
+	// Cash moves from one portfolio to the other
+	_, err = transfer(money, &portfoliov1.PortfolioTransfer{
+		SourcePortfolioId: "mybank-source",
+		TargetPortfolioId: "mybank-target",
+		Cash:              portfoliov1.Value(10000),
+		Time:              when,
+	})
+	assert.NoError(t, err)
+	assert.Equals(t, int32(38900), snapshot("mybank-source").Cash.Value)
+	assert.Equals(t, int32(10000), snapshot("mybank-target").Cash.Value)
+
+	// An identical transfer at the same time is a transfer of its own
+	_, err = transfer(money, &portfoliov1.PortfolioTransfer{
+		SourcePortfolioId: "mybank-source",
+		TargetPortfolioId: "mybank-target",
+		Cash:              portfoliov1.Value(10000),
+		Time:              when,
+	})
+	assert.NoError(t, err)
+	assert.Equals(t, int32(28900), snapshot("mybank-source").Cash.Value)
+	assert.Equals(t, int32(20000), snapshot("mybank-target").Cash.Value)
+
+	// Only the metadata of one side of a transfer can be updated on its own
+	_, err = svc.UpdatePortfolioTransaction(money, connect.NewRequest(&portfoliov1.UpdatePortfolioTransactionRequest{
+		Transaction: &portfoliov1.PortfolioEvent{Id: tr.Transactions[1].Id, Amount: 1},
+		UpdateMask:  &fieldmaskpb.FieldMask{Paths: []string{"amount"}},
+	}))
+	assert.ErrorIs(t, ErrTransferUpdate, err)
+
+	_, err = svc.UpdatePortfolioTransaction(money, connect.NewRequest(&portfoliov1.UpdatePortfolioTransactionRequest{
+		Transaction: &portfoliov1.PortfolioEvent{Id: tr.Transactions[1].Id, Note: "Moved to the new broker"},
+		UpdateMask:  &fieldmaskpb.FieldMask{Paths: []string{"note"}},
+	}))
+	assert.NoError(t, err)
+
+	// Deleting one side deletes the whole transfer
+	_, err = svc.DeletePortfolioTransaction(money, connect.NewRequest(&portfoliov1.DeletePortfolioTransactionRequest{
+		Id: tr.Transactions[1].Id,
+	}))
+	assert.NoError(t, err)
+
+	source = snapshot("mybank-source")
+	assert.Equals(t, 10, source.Positions["US0378331005"].Amount)
+	assert.Equals(t, int32(29000), source.Cash.Value)
+	assert.Equals(t, 0, len(snapshot("mybank-target").Positions))
+
+	// Restoring one side restores the whole transfer
+	_, err = svc.RestoreFromTrash(money, connect.NewRequest(&portfoliov1.RestoreFromTrashRequest{
+		ResourceType: TrashTypePortfolioEvent,
+		Id:           tr.Transactions[2].Id,
+	}))
+	assert.NoError(t, err)
+	assert.Equals(t, 3, snapshot("mybank-source").Positions["US0378331005"].Amount)
+	assert.Equals(t, 7, snapshot("mybank-target").Positions["US0378331005"].Amount)
+}
@@ -0,0 +1,263 @@
+// Copyright 2023 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package portfolio
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/oxisto/money-gopher/auth"
+	portfoliov1 "github.com/oxisto/money-gopher/gen"
+	"github.com/oxisto/money-gopher/persistence"
+	"github.com/oxisto/money-gopher/service/audit"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// The resource types of the items in the trash.
+const (
+	TrashTypePortfolio      = "Portfolio"
+	TrashTypePortfolioEvent = "PortfolioEvent"
+	TrashTypeSecurity       = "Security"
+)
+
+var (
+	ErrNotInTrash          = errors.New("item not found in trash")
+	ErrInvalidResourceType = errors.New("invalid resource type")
+)
+
+func (svc *service) ListTrash(ctx context.Context, req *connect.Request[portfoliov1.ListTrashRequest]) (res *connect.Response[portfoliov1.ListTrashResponse], err error) {
+	u, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res = connect.NewResponse(&portfoliov1.ListTrashResponse{})
+
+	portfolios, err := svc.q.ListTrashedPortfolios(ctx, u.Subject)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	for _, p := range portfolios {
+		res.Msg.Items = append(res.Msg.Items, svc.trashItem(TrashTypePortfolio, p.ID, p.DisplayName, "", p.DeleteTime))
+	}
+
+	// Only transactions that the user could have deleted are listed
+	txs, err := svc.q.ListTrashedPortfolioEvents(ctx, persistence.ListTrashedPortfolioEventsParams{
+		Subject: u.Subject,
+		Access:  int64(portfoliov1.PortfolioAccess_PORTFOLIO_ACCESS_WRITE),
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	for _, tx := range txs {
+		name := fmt.Sprintf("%s %s", eventTypeName(portfoliov1.PortfolioEventType(tx.Type)), tx.SecurityID)
+		res.Msg.Items = append(res.Msg.Items, svc.trashItem(TrashTypePortfolioEvent, tx.ID, strings.TrimSpace(name), tx.PortfolioID, tx.DeleteTime))
+	}
+
+	// Securities are shared between all users, so only users that can change
+	// them see them in the trash
+	if u.HasPermission(auth.PermissionWriteSecurities) {
+		secs, err := svc.q.ListTrashedSecurities(ctx)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+
+		for _, sec := range secs {
+			res.Msg.Items = append(res.Msg.Items, svc.trashItem(TrashTypeSecurity, sec.ID, sec.DisplayName, "", sec.DeleteTime))
+		}
+	}
+
+	return res, nil
+}
+
+func (svc *service) RestoreFromTrash(ctx context.Context, req *connect.Request[portfoliov1.RestoreFromTrashRequest]) (res *connect.Response[emptypb.Empty], err error) {
+	var after proto.Message
+
+	switch req.Msg.ResourceType {
+	case TrashTypePortfolio:
+		after, err = svc.restorePortfolio(ctx, req.Msg.Id)
+	case TrashTypePortfolioEvent:
+		after, err = svc.restorePortfolioEvent(ctx, req.Msg.Id)
+	case TrashTypeSecurity:
+		after, err = svc.restoreSecurity(ctx, req.Msg.Id)
+	default:
+		err = connect.NewError(connect.CodeInvalidArgument, ErrInvalidResourceType)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	audit.Record(ctx, audit.Event{
+		Action:       portfoliov1.AuditAction_AUDIT_ACTION_RESTORE,
+		ResourceType: req.Msg.ResourceType,
+		ResourceID:   req.Msg.Id,
+		After:        after,
+	})
+
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+// restorePortfolio restores a portfolio of the authenticated user together
+// with the transactions that were deleted with it.
+func (svc *service) restorePortfolio(ctx context.Context, id string) (p *portfoliov1.Portfolio, err error) {
+	u, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	trashed, err := svc.q.GetTrashedPortfolio(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && trashed.Owner != u.Subject) {
+		return nil, connect.NewError(connect.CodeNotFound, ErrNotInTrash)
+	} else if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	_, err = svc.q.RestorePortfolio(ctx, id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	svc.publish(id)
+
+	p, err = svc.portfolios.Get(ctx, id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return p, nil
+}
+
+// restorePortfolioEvent restores a single transaction. Its portfolio must not
+// be in the trash. All sides of a transfer are restored together, since they
+// were also deleted together.
+func (svc *service) restorePortfolioEvent(ctx context.Context, id string) (tx *portfoliov1.PortfolioEvent, err error) {
+	trashed, err := svc.q.GetTrashedPortfolioEvent(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeNotFound, ErrNotInTrash)
+	} else if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	var (
+		ids        = []string{trashed.ID}
+		portfolios = []string{trashed.PortfolioID}
+	)
+
+	if trashed.TransferID != "" {
+		sides, err := svc.q.ListTrashedTransferEvents(ctx, trashed.TransferID)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+
+		ids, portfolios = nil, nil
+		for _, side := range sides {
+			ids = append(ids, side.ID)
+			portfolios = append(portfolios, side.PortfolioID)
+		}
+	}
+
+	for _, portfolioID := range portfolios {
+		err = svc.requirePortfolioAccess(ctx, portfolioID, portfoliov1.PortfolioAccess_PORTFOLIO_ACCESS_WRITE)
+		if connect.CodeOf(err) == connect.CodeNotFound {
+			// Do not reveal that the transaction exists
+			return nil, connect.NewError(connect.CodeNotFound, ErrNotInTrash)
+		} else if err != nil {
+			return nil, err
+		}
+	}
+
+	err = svc.inTx(ctx, func(q *persistence.Queries) error {
+		for _, id := range ids {
+			_, err := q.RestorePortfolioEvent(ctx, id)
+			if err != nil {
+				return connect.NewError(connect.CodeInternal, err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	svc.publish(sorted(portfolios)...)
+
+	tx, err = svc.events.Get(ctx, id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return tx, nil
+}
+
+// restoreSecurity restores a security, if the authenticated user is allowed to
+// change securities.
+func (svc *service) restoreSecurity(ctx context.Context, id string) (sec *portfoliov1.Security, err error) {
+	u, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !u.HasPermission(auth.PermissionWriteSecurities) {
+		return nil, connect.NewError(connect.CodePermissionDenied, ErrInsufficientAccess)
+	}
+
+	n, err := svc.q.RestoreSecurity(ctx, id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	} else if n == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, ErrNotInTrash)
+	}
+
+	res, err := svc.securities.GetSecurity(ctx, connect.NewRequest(&portfoliov1.GetSecurityRequest{Id: id}))
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Msg, nil
+}
+
+// trashItem creates a [portfoliov1.TrashItem] and calculates its purge time.
+func (svc *service) trashItem(typ string, id string, name string, portfolioID string, deleteTime sql.NullTime) (item *portfoliov1.TrashItem) {
+	item = &portfoliov1.TrashItem{
+		ResourceType: typ,
+		Id:           id,
+		DisplayName:  name,
+		PortfolioId:  portfolioID,
+		DeleteTime:   timestamppb.New(deleteTime.Time),
+	}
+
+	if svc.retentionDays > 0 {
+		item.PurgeTime = timestamppb.New(deleteTime.Time.AddDate(0, 0, svc.retentionDays))
+	}
+
+	return
+}
+
+// eventTypeName returns a short name of the event type, e.g., "buy".
+func eventTypeName(typ portfoliov1.PortfolioEventType) string {
+	return strings.ToLower(strings.TrimPrefix(typ.String(), "PORTFOLIO_EVENT_TYPE_"))
+}
@@ -0,0 +1,151 @@
+// Copyright 2023 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package portfolio
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/oxisto/money-gopher/auth"
+	portfoliov1 "github.com/oxisto/money-gopher/gen"
+	"github.com/oxisto/money-gopher/internal"
+	"github.com/oxisto/money-gopher/persistence"
+
+	"connectrpc.com/connect"
+	"github.com/oxisto/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestTrash(t *testing.T) {
+	var (
+		db     = internal.NewTestDB(t)
+		svc    = NewService(Options{DB: db, TrashRetentionDays: 30})
+		events = persistence.Ops[*portfoliov1.PortfolioEvent](db)
+		money  = internal.WithTestUser(context.Background())
+		gopher = auth.NewContext(context.Background(), &auth.User{Subject: "gopher", Roles: []auth.Role{auth.RoleEditor}})
+		err    error
+	)
+
+	_, err = svc.CreatePortfolio(money, connect.NewRequest(&portfoliov1.CreatePortfolioRequest{
+		Portfolio: &portfoliov1.Portfolio{Id: "mybank-myportfolio", DisplayName: "My Portfolio"},
+	}))
+	assert.NoError(t, err)
+
+	for id, typ := range map[string]portfoliov1.PortfolioEventType{
+		"buy":  portfoliov1.PortfolioEventType_PORTFOLIO_EVENT_TYPE_BUY,
+		"sell": portfoliov1.PortfolioEventType_PORTFOLIO_EVENT_TYPE_SELL,
+	} {
+		err = events.Replace(money, &portfoliov1.PortfolioEvent{
+			Id:          id,
+			Type:        typ,
+			PortfolioId: "mybank-myportfolio",
+			SecurityId:  "US0378331005",
+			Amount:      1,
+			Price:       portfoliov1.Value(100),
+			Time:        timestamppb.New(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)),
+		})
+		assert.NoError(t, err)
+	}
+
+	listTrash := func(ctx context.Context) []*portfoliov1.TrashItem {
+		res, err := svc.ListTrash(ctx, connect.NewRequest(&portfoliov1.ListTrashRequest{}))
+		assert.NoError(t, err)
+		return res.Msg.Items
+	}
+
+	restore := func(ctx context.Context, typ string, id string) error {
+		_, err := svc.RestoreFromTrash(ctx, connect.NewRequest(&portfoliov1.RestoreFromTrashRequest{
+			ResourceType: typ,
+			Id:           id,
+		}))
+		return err
+	}
+
+	listTransactions := func() int {
+		res, err := svc.ListPortfolioTransactions(money, connect.NewRequest(&portfoliov1.ListPortfolioTransactionsRequest{
+			PortfolioId: "mybank-myportfolio",
+		}))
+		assert.NoError(t, err)
+		return len(res.Msg.Transactions)
+	}
+
+	// Delete a single transaction first
+	err = events.Delete(money, "sell")
+	assert.NoError(t, err)
+	assert.Equals(t, 1, listTransactions())
+
+	items := listTrash(money)
+	assert.Equals(t, 1, len(items))
+	assert.Equals(t, TrashTypePortfolioEvent, items[0].ResourceType)
+	assert.Equals(t, "sell US0378331005", items[0].DisplayName)
+	assert.Equals(t, 30*24*time.Hour, items[0].PurgeTime.AsTime().Sub(items[0].DeleteTime.AsTime()))
+
+	// Deleting the portfolio also moves the remaining transaction to the trash
+	_, err = svc.DeletePortfolio(money, connect.NewRequest(&portfoliov1.DeletePortfolioRequest{Id: "mybank-myportfolio"}))
+	assert.NoError(t, err)
+
+	items = listTrash(money)
+	assert.Equals(t, 1, len(items))
+	assert.Equals(t, TrashTypePortfolio, items[0].ResourceType)
+	assert.Equals(t, "My Portfolio", items[0].DisplayName)
+
+	// The ID cannot be used by a new portfolio, while it is in the trash
+	_, err = svc.CreatePortfolio(money, connect.NewRequest(&portfoliov1.CreatePortfolioRequest{
+		Portfolio: &portfoliov1.Portfolio{Id: "mybank-myportfolio", DisplayName: "New Portfolio"},
+	}))
+	assert.Equals(t, connect.CodeAlreadyExists, connect.CodeOf(err))
+
+	// Other users cannot see or restore it
+	assert.Equals(t, 0, len(listTrash(gopher)))
+	err = restore(gopher, TrashTypePortfolio, "mybank-myportfolio")
+	assert.Equals(t, connect.CodeNotFound, connect.CodeOf(err))
+
+	// The individually deleted transaction stays in the trash
+	err = restore(money, TrashTypePortfolio, "mybank-myportfolio")
+	assert.NoError(t, err)
+	assert.Equals(t, 1, listTransactions())
+
+	err = restore(money, TrashTypePortfolio, "mybank-myportfolio")
+	assert.Equals(t, connect.CodeNotFound, connect.CodeOf(err))
+
+	err = restore(money, TrashTypePortfolioEvent, "sell")
+	assert.NoError(t, err)
+	assert.Equals(t, 2, listTransactions())
+	assert.Equals(t, 0, len(listTrash(money)))
+
+	err = restore(money, "Unknown", "sell")
+	assert.Equals(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	// Securities can only be restored by users that are allowed to change them
+	secs := persistence.Ops[*portfoliov1.Security](db)
+	assert.NoError(t, secs.Replace(money, &portfoliov1.Security{Id: "US0378331005", DisplayName: "Apple Inc."}))
+	assert.NoError(t, secs.Delete(money, "US0378331005"))
+
+	viewer := auth.NewContext(context.Background(), &auth.User{Subject: "viewer", Roles: []auth.Role{auth.RoleViewer}})
+	assert.Equals(t, 0, len(listTrash(viewer)))
+	err = restore(viewer, TrashTypeSecurity, "US0378331005")
+	assert.Equals(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+	items = listTrash(money)
+	assert.Equals(t, 1, len(items))
+	assert.Equals(t, "Apple Inc.", items[0].DisplayName)
+
+	err = restore(money, TrashTypeSecurity, "US0378331005")
+	assert.NoError(t, err)
+	assert.Equals(t, 0, len(listTrash(money)))
+}
@@ -0,0 +1,104 @@
+// Copyright 2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package portfolio
+
+import (
+	"context"
+	"slices"
+
+	portfoliov1 "github.com/oxisto/money-gopher/gen"
+	"github.com/oxisto/money-gopher/service/events"
+
+	"connectrpc.com/connect"
+)
+
+func (svc *service) WatchPortfolioSnapshot(ctx context.Context, req *connect.Request[portfoliov1.WatchPortfolioSnapshotRequest], stream *connect.ServerStream[portfoliov1.PortfolioSnapshot]) (err error) {
+	var (
+		snap   *portfoliov1.PortfolioSnapshot
+		ch     <-chan events.Event
+		cancel func()
+	)
+
+	// Subscribe before taking the first snapshot, so that we do not miss any
+	// change in between
+	ch, cancel = svc.broker.Subscribe()
+	defer cancel()
+
+	for {
+		// Access is checked for every snapshot, so that the stream ends once
+		// the portfolio is no longer shared with the user
+		snap, err = svc.snapshot(ctx, req.Msg.PortfolioId)
+		if err != nil {
+			return err
+		}
+
+		err = stream.Send(snap)
+		if err != nil {
+			return err
+		}
+
+	wait:
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case e, ok := <-ch:
+				if !ok {
+					return nil
+				} else if affects(e, req.Msg.PortfolioId, snap) {
+					break wait
+				}
+			}
+		}
+	}
+}
+
+// snapshot returns the current snapshot of the portfolio with the given ID.
+func (svc *service) snapshot(ctx context.Context, id string) (snap *portfoliov1.PortfolioSnapshot, err error) {
+	res, err := svc.GetPortfolioSnapshot(ctx, connect.NewRequest(&portfoliov1.GetPortfolioSnapshotRequest{
+		PortfolioId: id,
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Msg, nil
+}
+
+// affects checks, whether the event affects the snapshot of the portfolio with
+// the given ID.
+func affects(e events.Event, id string, snap *portfoliov1.PortfolioSnapshot) bool {
+	if e.PortfolioID == id {
+		return true
+	}
+
+	for _, sid := range e.SecurityIDs {
+		if _, ok := snap.Positions[sid]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// publish notifies watchers that the portfolios with the given IDs have
+// changed.
+func (svc *service) publish(ids ...string) {
+	for _, id := range slices.Compact(ids) {
+		svc.broker.Publish(events.Event{PortfolioID: id})
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright 2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package portfolio
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	portfoliov1 "github.com/oxisto/money-gopher/gen"
+	"github.com/oxisto/money-gopher/gen/portfoliov1connect"
+	"github.com/oxisto/money-gopher/internal"
+	"github.com/oxisto/money-gopher/persistence"
+	"github.com/oxisto/money-gopher/service/events"
+
+	"connectrpc.com/connect"
+	"github.com/oxisto/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func Test_service_WatchPortfolioSnapshot(t *testing.T) {
+	portfolios := myPortfolio(t)
+	svc := &service{
+		portfolios: portfolios,
+		events:     persistence.Relationship[*portfoliov1.PortfolioEvent](portfolios),
+		securities: mockSecuritiesClientWithData,
+		broker:     events.NewBroker(),
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(portfoliov1connect.NewPortfolioServiceHandler(svc))
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mux.ServeHTTP(w, r.WithContext(internal.WithTestUser(r.Context())))
+	}))
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	client := portfoliov1connect.NewPortfolioServiceClient(srv.Client(), srv.URL)
+	stream, err := client.WatchPortfolioSnapshot(ctx, connect.NewRequest(&portfoliov1.WatchPortfolioSnapshotRequest{
+		PortfolioId: "mybank-myportfolio",
+	}))
+	assert.NoError(t, err)
+	defer func() {
+		// Closing drains the stream, so we need to end it first
+		cancel()
+		stream.Close()
+	}()
+
+	// The initial snapshot
+	assert.Equals(t, true, stream.Receive())
+	assert.Equals(t, 10, stream.Msg().Positions["US0378331005"].Amount)
+
+	// A new transaction results in a new snapshot
+	_, err = svc.CreatePortfolioTransaction(internal.WithTestUser(ctx), connect.NewRequest(&portfoliov1.CreatePortfolioTransactionRequest{
+		Transaction: &portfoliov1.PortfolioEvent{
+			Type:        portfoliov1.PortfolioEventType_PORTFOLIO_EVENT_TYPE_BUY,
+			PortfolioId: "mybank-myportfolio",
+			SecurityId:  "US0378331005",
+			Amount:      5,
+			Price:       portfoliov1.Value(10000),
+			Time:        timestamppb.New(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)),
+		},
+	}))
+	assert.NoError(t, err)
+
+	assert.Equals(t, true, stream.Receive())
+	assert.Equals(t, 15, stream.Msg().Positions["US0378331005"].Amount)
+
+	// So does a new quote of a security in the portfolio
+	svc.broker.Publish(events.Event{SecurityIDs: []string{"US0378331005"}})
+
+	assert.Equals(t, true, stream.Receive())
+	assert.Equals(t, 15, stream.Msg().Positions["US0378331005"].Amount)
+}
+
+func Test_affects(t *testing.T) {
+	snap := &portfoliov1.PortfolioSnapshot{
+		Positions: map[string]*portfoliov1.PortfolioPosition{
+			"US0378331005": {},
+		},
+	}
+
+	tests := []struct {
+		name string
+		e    events.Event
+		want bool
+	}{
+		{
+			name: "same portfolio",
+			e:    events.Event{PortfolioID: "mybank-myportfolio"},
+			want: true,
+		},
+		{
+			name: "other portfolio",
+			e:    events.Event{PortfolioID: "mybank-otherportfolio"},
+			want: false,
+		},
+		{
+			name: "security in portfolio",
+			e:    events.Event{SecurityIDs: []string{"DE0005190003", "US0378331005"}},
+			want: true,
+		},
+		{
+			name: "security not in portfolio",
+			e:    events.Event{SecurityIDs: []string{"DE0005190003"}},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equals(t, tt.want, affects(tt.e, "mybank-myportfolio", snap))
+		})
+	}
+}
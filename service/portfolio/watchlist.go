@@ -0,0 +1,410 @@
+// Copyright 2023 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package portfolio
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"slices"
+	"time"
+
+	moneygopher "github.com/oxisto/money-gopher"
+	portfoliov1 "github.com/oxisto/money-gopher/gen"
+	"github.com/oxisto/money-gopher/persistence"
+	"github.com/oxisto/money-gopher/service/audit"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	ErrMissingWatchlist  = errors.New("a watchlist requires an ID")
+	ErrWatchlistNotFound = errors.New("watchlist not found")
+	ErrUnknownSecurity   = errors.New("unknown security")
+)
+
+func (svc *service) CreateWatchlist(ctx context.Context, req *connect.Request[portfoliov1.CreateWatchlistRequest]) (res *connect.Response[portfoliov1.Watchlist], err error) {
+	var (
+		w   = req.Msg.Watchlist
+		dbw *persistence.Watchlist
+	)
+
+	u, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if w.GetId() == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, ErrMissingWatchlist)
+	}
+
+	secs, err := svc.requireSecurities(ctx, w.SecurityIds)
+	if err != nil {
+		return nil, err
+	}
+
+	err = svc.inTx(ctx, func(q *persistence.Queries) (err error) {
+		dbw, err = q.CreateWatchlist(ctx, persistence.CreateWatchlistParams{
+			ID:          w.Id,
+			DisplayName: w.DisplayName,
+			Owner:       u.Subject,
+		})
+		if errors.Is(err, sql.ErrNoRows) {
+			return connect.NewError(connect.CodeAlreadyExists, ErrAlreadyExists)
+		} else if err != nil {
+			return connect.NewError(connect.CodeInternal, err)
+		}
+
+		return addWatchlistEntries(ctx, q, w.Id, secs)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	w, err = svc.watchlistToProto(ctx, dbw)
+	if err != nil {
+		return nil, err
+	}
+
+	audit.Record(ctx, audit.Event{
+		Action: portfoliov1.AuditAction_AUDIT_ACTION_CREATE,
+		After:  w,
+	})
+
+	return connect.NewResponse(w), nil
+}
+
+func (svc *service) ListWatchlists(ctx context.Context, req *connect.Request[portfoliov1.ListWatchlistsRequest]) (res *connect.Response[portfoliov1.ListWatchlistsResponse], err error) {
+	var list []*persistence.Watchlist
+
+	u, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	list, err = svc.q.ListWatchlistsByOwner(ctx, u.Subject)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	res = connect.NewResponse(&portfoliov1.ListWatchlistsResponse{
+		Watchlists: make([]*portfoliov1.Watchlist, 0, len(list)),
+	})
+
+	for _, dbw := range list {
+		w, err := svc.watchlistToProto(ctx, dbw)
+		if err != nil {
+			return nil, err
+		}
+
+		res.Msg.Watchlists = append(res.Msg.Watchlists, w)
+	}
+
+	return
+}
+
+func (svc *service) GetWatchlist(ctx context.Context, req *connect.Request[portfoliov1.GetWatchlistRequest]) (res *connect.Response[portfoliov1.Watchlist], err error) {
+	dbw, err := svc.requireWatchlistOwner(ctx, req.Msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	w, err := svc.watchlistToProto(ctx, dbw)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(w), nil
+}
+
+func (svc *service) UpdateWatchlist(ctx context.Context, req *connect.Request[portfoliov1.UpdateWatchlistRequest]) (res *connect.Response[portfoliov1.Watchlist], err error) {
+	var (
+		w      = req.Msg.Watchlist
+		paths  = req.Msg.GetUpdateMask().GetPaths()
+		before *portfoliov1.Watchlist
+		after  *portfoliov1.Watchlist
+		secs   map[string]*portfoliov1.Security
+	)
+
+	dbw, err := svc.requireWatchlistOwner(ctx, w.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	before, err = svc.watchlistToProto(ctx, dbw)
+	if err != nil {
+		return nil, err
+	}
+
+	if slices.Contains(paths, "security_ids") {
+		secs, err = svc.requireSecurities(ctx, w.SecurityIds)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = svc.inTx(ctx, func(q *persistence.Queries) (err error) {
+		for _, path := range paths {
+			switch path {
+			case "display_name":
+				dbw.DisplayName = w.DisplayName
+				err = q.UpdateWatchlistDisplayName(ctx, persistence.UpdateWatchlistDisplayNameParams{
+					ID:          dbw.ID,
+					DisplayName: w.DisplayName,
+				})
+			case "security_ids":
+				// Securities that stay on the watchlist keep the time they were
+				// added
+				for _, id := range before.SecurityIds {
+					if !slices.Contains(w.SecurityIds, id) {
+						err = q.RemoveWatchlistEntry(ctx, persistence.RemoveWatchlistEntryParams{
+							WatchlistID: dbw.ID,
+							SecurityID:  id,
+						})
+						if err != nil {
+							break
+						}
+					}
+				}
+
+				if err == nil {
+					err = addWatchlistEntries(ctx, q, dbw.ID, secs)
+				}
+			}
+			if err != nil {
+				return connect.NewError(connect.CodeInternal, err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	after, err = svc.watchlistToProto(ctx, dbw)
+	if err != nil {
+		return nil, err
+	}
+
+	audit.Record(ctx, audit.Event{
+		Action: portfoliov1.AuditAction_AUDIT_ACTION_UPDATE,
+		Paths:  paths,
+		Before: before,
+		After:  after,
+	})
+
+	return connect.NewResponse(after), nil
+}
+
+func (svc *service) DeleteWatchlist(ctx context.Context, req *connect.Request[portfoliov1.DeleteWatchlistRequest]) (res *connect.Response[emptypb.Empty], err error) {
+	dbw, err := svc.requireWatchlistOwner(ctx, req.Msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	before, err := svc.watchlistToProto(ctx, dbw)
+	if err != nil {
+		return nil, err
+	}
+
+	err = svc.inTx(ctx, func(q *persistence.Queries) (err error) {
+		err = q.DeleteWatchlistEntries(ctx, dbw.ID)
+		if err == nil {
+			_, err = q.DeleteWatchlist(ctx, persistence.DeleteWatchlistParams{
+				ID:    dbw.ID,
+				Owner: dbw.Owner,
+			})
+		}
+		if err != nil {
+			return connect.NewError(connect.CodeInternal, err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	audit.Record(ctx, audit.Event{
+		Action: portfoliov1.AuditAction_AUDIT_ACTION_DELETE,
+		Before: before,
+	})
+
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+// requireWatchlistOwner returns the watchlist with the given ID, if it is owned
+// by the authenticated user. Watchlists of other users are reported as not
+// found.
+func (svc *service) requireWatchlistOwner(ctx context.Context, id string) (w *persistence.Watchlist, err error) {
+	u, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	w, err = svc.q.GetWatchlist(ctx, persistence.GetWatchlistParams{ID: id, Owner: u.Subject})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeNotFound, ErrWatchlistNotFound)
+	} else if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return w, nil
+}
+
+// requireSecurities makes sure that all securities exist and returns them,
+// indexed by their ID.
+func (svc *service) requireSecurities(ctx context.Context, ids []string) (secs map[string]*portfoliov1.Security, err error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	secs, err = svc.listSecurities(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range ids {
+		if _, ok := secs[id]; !ok {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.Join(ErrUnknownSecurity, errors.New(id)))
+		}
+	}
+
+	return secs, nil
+}
+
+// listSecurities retrieves the securities with the given IDs from the
+// securities service, indexed by their ID.
+func (svc *service) listSecurities(ctx context.Context, ids []string) (secs map[string]*portfoliov1.Security, err error) {
+	res, err := svc.securities.ListSecurities(ctx, connect.NewRequest(&portfoliov1.ListSecuritiesRequest{
+		Filter: &portfoliov1.ListSecuritiesRequest_Filter{SecurityIds: ids},
+	}))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return moneygopher.Map(res.Msg.Securities, func(s *portfoliov1.Security) string {
+		return s.Id
+	}), nil
+}
+
+// addWatchlistEntries adds the securities to the watchlist. Their latest
+// quote is the reference for their change since they were added. Securities
+// that are already on it are ignored and keep their reference.
+func addWatchlistEntries(ctx context.Context, q *persistence.Queries, id string, secs map[string]*portfoliov1.Security) error {
+	now := time.Now()
+
+	for sid, sec := range secs {
+		var added sql.NullInt64
+
+		if ls := sec.GetListedOn(); len(ls) > 0 && ls[0].LatestQuote != nil {
+			added = sql.NullInt64{Int64: int64(ls[0].LatestQuote.Value), Valid: true}
+		}
+
+		err := q.AddWatchlistEntry(ctx, persistence.AddWatchlistEntryParams{
+			WatchlistID: id,
+			SecurityID:  sid,
+			AddTime:     now,
+			AddedQuote:  added,
+		})
+		if err != nil {
+			return connect.NewError(connect.CodeInternal, err)
+		}
+	}
+
+	return nil
+}
+
+// watchlistToProto converts a watchlist of the database into its API
+// representation, including the current quotes and changes of its entries.
+func (svc *service) watchlistToProto(ctx context.Context, dbw *persistence.Watchlist) (w *portfoliov1.Watchlist, err error) {
+	var (
+		entries []*persistence.WatchlistEntry
+		secs    map[string]*portfoliov1.Security
+	)
+
+	w = &portfoliov1.Watchlist{
+		Id:          dbw.ID,
+		DisplayName: dbw.DisplayName,
+		Owner:       dbw.Owner,
+		SecurityIds: []string{},
+		Entries:     []*portfoliov1.WatchlistEntry{},
+	}
+
+	entries, err = svc.q.ListWatchlistEntries(ctx, dbw.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	for _, e := range entries {
+		w.SecurityIds = append(w.SecurityIds, e.SecurityID)
+	}
+
+	if len(w.SecurityIds) == 0 {
+		return w, nil
+	}
+
+	secs, err = svc.listSecurities(ctx, w.SecurityIds)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, e := range entries {
+		entry := &portfoliov1.WatchlistEntry{
+			Security: secs[e.SecurityID],
+			AddTime:  timestamppb.New(e.AddTime),
+		}
+
+		// Securities in the trash have no quotes
+		if entry.Security == nil {
+			entry.Security = &portfoliov1.Security{Id: e.SecurityID}
+		}
+
+		if ls := entry.Security.GetListedOn(); len(ls) > 0 && ls[0].LatestQuote != nil {
+			entry.LatestQuote = ls[0].LatestQuote
+			entry.LatestQuoteTimestamp = ls[0].LatestQuoteTimestamp
+
+			if ls[0].PreviousQuote != nil {
+				entry.DailyChange = portfoliov1.Minus(entry.LatestQuote, ls[0].PreviousQuote)
+				if ls[0].PreviousQuote.Value != 0 {
+					entry.DailyGains = float64(entry.DailyChange.Value) / float64(ls[0].PreviousQuote.Value)
+				}
+			}
+		}
+
+		if e.AddedQuote.Valid {
+			entry.AddedQuote = portfoliov1.Value(int32(e.AddedQuote.Int64))
+			if entry.LatestQuote != nil {
+				entry.AddedQuote.Symbol = entry.LatestQuote.Symbol
+			}
+		}
+
+		if entry.AddedQuote != nil && entry.LatestQuote != nil {
+			entry.ChangeSinceAdded = portfoliov1.Minus(entry.LatestQuote, entry.AddedQuote)
+			if entry.AddedQuote.Value != 0 {
+				entry.GainsSinceAdded = float64(entry.ChangeSinceAdded.Value) / float64(entry.AddedQuote.Value)
+			}
+		}
+
+		w.Entries = append(w.Entries, entry)
+	}
+
+	return w, nil
+}
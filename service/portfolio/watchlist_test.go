@@ -0,0 +1,141 @@
+// Copyright 2023 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package portfolio
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/oxisto/money-gopher/auth"
+	portfoliov1 "github.com/oxisto/money-gopher/gen"
+	"github.com/oxisto/money-gopher/internal"
+	"github.com/oxisto/money-gopher/persistence"
+
+	"connectrpc.com/connect"
+	"github.com/oxisto/assert"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestWatchlist(t *testing.T) {
+	var (
+		db     = internal.NewTestDB(t)
+		svc    = NewService(Options{DB: db})
+		listed = persistence.Relationship[*portfoliov1.ListedSecurity](persistence.Ops[*portfoliov1.Security](db))
+		money  = internal.WithTestUser(context.Background())
+		gopher = auth.NewContext(context.Background(), &auth.User{Subject: "gopher", Roles: []auth.Role{auth.RoleEditor}})
+		err    error
+	)
+
+	create := func(ctx context.Context, w *portfoliov1.Watchlist) (*portfoliov1.Watchlist, error) {
+		res, err := svc.CreateWatchlist(ctx, connect.NewRequest(&portfoliov1.CreateWatchlistRequest{Watchlist: w}))
+		if err != nil {
+			return nil, err
+		}
+
+		return res.Msg, nil
+	}
+
+	get := func(ctx context.Context) (*portfoliov1.Watchlist, error) {
+		res, err := svc.GetWatchlist(ctx, connect.NewRequest(&portfoliov1.GetWatchlistRequest{Id: "tech"}))
+		if err != nil {
+			return nil, err
+		}
+
+		return res.Msg, nil
+	}
+
+	_, err = create(money, &portfoliov1.Watchlist{Id: "tech", SecurityIds: []string{"DE0000000000"}})
+	assert.Equals(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	// The latest quote is the reference for the change since it was added
+	w, err := create(money, &portfoliov1.Watchlist{Id: "tech", DisplayName: "Tech", SecurityIds: []string{"US0378331005"}})
+	assert.NoError(t, err)
+	assert.Equals(t, internal.TestUser, w.Owner)
+	assert.Equals(t, 1, len(w.Entries))
+	assert.Equals(t, "Apple Inc.", w.Entries[0].Security.DisplayName)
+	assert.Equals(t, int32(16502), w.Entries[0].AddedQuote.Value)
+	assert.Equals(t, int32(0), w.Entries[0].ChangeSinceAdded.Value)
+
+	_, err = create(gopher, &portfoliov1.Watchlist{Id: "tech"})
+	assert.Equals(t, connect.CodeAlreadyExists, connect.CodeOf(err))
+
+	// A new quote on the next day
+	err = listed.Replace(money, &portfoliov1.ListedSecurity{
+		SecurityId:           "US0378331005",
+		Ticker:               "AAPL",
+		Currency:             "USD",
+		LatestQuote:          portfoliov1.Value(17000),
+		LatestQuoteTimestamp: timestamppb.New(time.Date(2023, 4, 22, 0, 0, 0, 0, time.UTC)),
+		PreviousQuote:        portfoliov1.Value(16000),
+	})
+	assert.NoError(t, err)
+
+	w, err = get(money)
+	assert.NoError(t, err)
+	assert.Equals(t, int32(1000), w.Entries[0].DailyChange.Value)
+	assert.Equals(t, 1000.0/16000.0, w.Entries[0].DailyGains)
+	assert.Equals(t, int32(16502), w.Entries[0].AddedQuote.Value)
+	assert.Equals(t, int32(498), w.Entries[0].ChangeSinceAdded.Value)
+
+	// A quote of zero has no relative change
+	err = listed.Replace(money, &portfoliov1.ListedSecurity{
+		SecurityId:           "US0378331005",
+		Ticker:               "AAPL",
+		Currency:             "USD",
+		LatestQuote:          portfoliov1.Value(17000),
+		LatestQuoteTimestamp: timestamppb.New(time.Date(2023, 4, 23, 0, 0, 0, 0, time.UTC)),
+		PreviousQuote:        portfoliov1.Value(0),
+	})
+	assert.NoError(t, err)
+
+	w, err = get(money)
+	assert.NoError(t, err)
+	assert.Equals(t, int32(17000), w.Entries[0].DailyChange.Value)
+	assert.Equals(t, 0.0, w.Entries[0].DailyGains)
+
+	// Other users cannot see it
+	_, err = get(gopher)
+	assert.Equals(t, connect.CodeNotFound, connect.CodeOf(err))
+
+	_, err = svc.UpdateWatchlist(money, connect.NewRequest(&portfoliov1.UpdateWatchlistRequest{
+		Watchlist:  &portfoliov1.Watchlist{Id: "tech", DisplayName: "Empty", SecurityIds: []string{}},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"display_name", "security_ids"}},
+	}))
+	assert.NoError(t, err)
+
+	res, err := svc.ListWatchlists(money, connect.NewRequest(&portfoliov1.ListWatchlistsRequest{}))
+	assert.NoError(t, err)
+	assert.Equals(t, 1, len(res.Msg.Watchlists))
+	assert.Equals(t, "Empty", res.Msg.Watchlists[0].DisplayName)
+	assert.Equals(t, 0, len(res.Msg.Watchlists[0].Entries))
+
+	_, err = svc.DeleteWatchlist(gopher, connect.NewRequest(&portfoliov1.DeleteWatchlistRequest{Id: "tech"}))
+	assert.Equals(t, connect.CodeNotFound, connect.CodeOf(err))
+
+	_, err = svc.DeleteWatchlist(money, connect.NewRequest(&portfoliov1.DeleteWatchlistRequest{Id: "tech"}))
+	assert.NoError(t, err)
+
+	_, err = get(money)
+	assert.Equals(t, connect.CodeNotFound, connect.CodeOf(err))
+
+	// The securities of a deleted watchlist are not watched anymore
+	ids, err := persistence.New(db).ListWatchedSecurityIDs(context.Background())
+	assert.NoError(t, err)
+	assert.Equals(t, 0, len(ids))
+}
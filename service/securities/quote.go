@@ -22,23 +22,24 @@ import (
 	"time"
 
 	portfoliov1 "github.com/oxisto/money-gopher/gen"
+	"github.com/oxisto/money-gopher/metrics"
+	"github.com/oxisto/money-gopher/service/events"
 
 	"connectrpc.com/connect"
 	"github.com/lmittmann/tint"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func (svc *service) TriggerSecurityQuoteUpdate(ctx context.Context, req *connect.Request[portfoliov1.TriggerQuoteUpdateRequest]) (res *connect.Response[portfoliov1.TriggerQuoteUpdateResponse], err error) {
-	var (
-		sec *portfoliov1.Security
-		qp  QuoteProvider
-		ok  bool
-	)
-
 	// TODO(oxisto): Support a "list" with filtered values instead
 	for _, name := range req.Msg.SecurityIds {
-		// Fetch security
-		sec, err = svc.fetchSecurity(name)
+		// Fetch security. Both the security and its quote provider are
+		// declared per iteration, since the updates below outlive it.
+		var sec *portfoliov1.Security
+		sec, err = svc.fetchSecurity(ctx, name)
 		if err != nil {
 			return nil, connect.NewError(connect.CodeInternal, err)
 		}
@@ -47,24 +48,24 @@ func (svc *service) TriggerSecurityQuoteUpdate(ctx context.Context, req *connect
 
 		if sec.QuoteProvider == nil {
 			slog.Warn("No quote provider configured for security", "security", sec.Id)
-			return
+			continue
 		}
 
-		qp, ok = providers[*sec.QuoteProvider]
+		qp, ok := providers[*sec.QuoteProvider]
 		if !ok {
-			return
+			continue
 		}
 
 		// Trigger update from quote provider in separate go-routine
 		// TODO(oxisto): Use sync/errgroup instead
-		for idx := range sec.ListedOn {
-			idx := idx
+		for _, ls := range sec.ListedOn {
+			svc.jobs.Add(1)
 			go func() {
-				ls := sec.ListedOn[idx]
+				defer svc.jobs.Done()
 
 				slog.Debug("Triggering quote update", "security", ls, "provider", *sec.QuoteProvider)
 
-				err = svc.updateQuote(qp, ls)
+				err := svc.updateQuote(ctx, *sec.QuoteProvider, qp, ls)
 				if err != nil {
 					slog.Error("An error occurred during quote update", tint.Err(err), "ls", ls)
 				}
@@ -75,32 +76,71 @@ func (svc *service) TriggerSecurityQuoteUpdate(ctx context.Context, req *connect
 	return
 }
 
-func (svc *service) updateQuote(qp QuoteProvider, ls *portfoliov1.ListedSecurity) (err error) {
+// updateQuote retrieves the latest quote of the listed security from the quote
+// provider and stores it. The update usually outlives the request that
+// triggered it, so it is not canceled together with ctx, but it is still part
+// of its trace.
+func (svc *service) updateQuote(ctx context.Context, provider string, qp QuoteProvider, ls *portfoliov1.ListedSecurity) (err error) {
 	var (
 		quote  *portfoliov1.Currency
 		t      time.Time
-		ctx    context.Context
+		start  time.Time
 		cancel context.CancelFunc
 	)
 
-	ctx, cancel = context.WithTimeout(context.Background(), time.Second*60)
+	ctx, cancel = context.WithTimeout(context.WithoutCancel(ctx), time.Second*60)
 	defer cancel()
 
-	quote, t, err = qp.LatestQuote(ctx, ls)
+	start = time.Now()
+	quote, t, err = svc.latestQuote(ctx, provider, qp, ls)
+	metrics.ObserveDuration(metrics.QuoteUpdateDuration.WithLabelValues(provider), start)
 	if err != nil {
+		metrics.QuoteUpdates.WithLabelValues(provider, "failure").Inc()
 		return err
 	}
 
+	metrics.QuoteUpdates.WithLabelValues(provider, "success").Inc()
+
+	// Keep the last quote of the previous day, so that the daily change can be
+	// calculated
+	if ls.LatestQuote != nil && ls.LatestQuoteTimestamp != nil &&
+		ls.LatestQuoteTimestamp.AsTime().Truncate(24*time.Hour).Before(t.Truncate(24*time.Hour)) {
+		ls.PreviousQuote = ls.LatestQuote
+	}
+
 	ls.LatestQuote = quote
 	ls.LatestQuoteTimestamp = timestamppb.New(t)
 
 	_, err = svc.listedSecurities.Update(
+		ctx,
 		[]any{ls.SecurityId, ls.Ticker},
-		ls, []string{"latest_quote", "latest_quote_timestamp"},
+		ls, []string{"latest_quote", "latest_quote_timestamp", "previous_quote"},
 	)
 	if err != nil {
 		return err
 	}
 
+	metrics.SetQuoteTime(ls.SecurityId, ls.Ticker, t)
+	svc.broker.Publish(events.Event{SecurityIDs: []string{ls.SecurityId}})
+
+	return
+}
+
+// latestQuote retrieves the latest quote of the listed security from the quote
+// provider within its own span.
+func (svc *service) latestQuote(ctx context.Context, provider string, qp QuoteProvider, ls *portfoliov1.ListedSecurity) (quote *portfoliov1.Currency, t time.Time, err error) {
+	ctx, span := tracer.Start(ctx, "LatestQuote", trace.WithAttributes(
+		attribute.String("mgo.quote_provider", provider),
+		attribute.String("mgo.security_id", ls.SecurityId),
+		attribute.String("mgo.ticker", ls.Ticker),
+	))
+	defer span.End()
+
+	quote, t, err = qp.LatestQuote(ctx, ls)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
 	return
 }
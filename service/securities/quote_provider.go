@@ -18,17 +18,26 @@ package securities
 
 import (
 	"context"
+	"net/http"
 	"time"
 
 	portfoliov1 "github.com/oxisto/money-gopher/gen"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 )
 
 // providers contains a map of all quote providers
 var providers map[string]QuoteProvider = make(map[string]QuoteProvider)
 
 func init() {
-	RegisterQuoteProvider(QuoteProviderYF, &yf{})
-	RegisterQuoteProvider(QuoteProviderING, &ing{})
+	RegisterQuoteProvider(QuoteProviderYF, &yf{Client: newHTTPClient()})
+	RegisterQuoteProvider(QuoteProviderING, &ing{Client: newHTTPClient()})
+}
+
+// newHTTPClient returns a new HTTP client for quote providers, which creates a
+// span for every request.
+func newHTTPClient() http.Client {
+	return http.Client{Transport: otelhttp.NewTransport(http.DefaultTransport)}
 }
 
 // AddCommand adds a command using the specific symbol.
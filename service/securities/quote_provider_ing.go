@@ -47,11 +47,17 @@ type header struct {
 
 func (ing *ing) LatestQuote(ctx context.Context, ls *portfoliov1.ListedSecurity) (quote *portfoliov1.Currency, t time.Time, err error) {
 	var (
+		req *http.Request
 		res *http.Response
 		h   header
 	)
 
-	res, err = ing.Get(fmt.Sprintf("https://component-api.wertpapiere.ing.de/api/v1/components/instrumentheader/%s", ls.SecurityId))
+	req, err = http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("https://component-api.wertpapiere.ing.de/api/v1/components/instrumentheader/%s", ls.SecurityId), nil)
+	if err != nil {
+		return nil, t, fmt.Errorf("could not create request: %w", err)
+	}
+
+	res, err = ing.Do(req)
 	if err != nil {
 		return nil, t, fmt.Errorf("could not fetch quote: %w", err)
 	}
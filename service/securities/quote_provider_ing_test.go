@@ -76,6 +76,7 @@ func Test_ing_LatestQuote(t *testing.T) {
 				}),
 			},
 			args: args{
+				ctx: context.TODO(),
 				ls: &portfoliov1.ListedSecurity{
 					SecurityId: "My Security",
 					Ticker:     "TICK",
@@ -96,6 +97,7 @@ func Test_ing_LatestQuote(t *testing.T) {
 				}),
 			},
 			args: args{
+				ctx: context.TODO(),
 				ls: &portfoliov1.ListedSecurity{
 					SecurityId: "DE0000000001",
 					Ticker:     "",
@@ -48,11 +48,17 @@ type chart struct {
 
 func (yf *yf) LatestQuote(ctx context.Context, ls *portfoliov1.ListedSecurity) (quote *portfoliov1.Currency, t time.Time, err error) {
 	var (
+		req *http.Request
 		res *http.Response
 		ch  chart
 	)
 
-	res, err = yf.Get(fmt.Sprintf("https://query1.finance.yahoo.com/v8/finance/chart/%s?interval=1d&range=1mo", ls.Ticker))
+	req, err = http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("https://query1.finance.yahoo.com/v8/finance/chart/%s?interval=1d&range=1mo", ls.Ticker), nil)
+	if err != nil {
+		return nil, t, fmt.Errorf("could not create request: %w", err)
+	}
+
+	res, err = yf.Do(req)
 	if err != nil {
 		return nil, t, fmt.Errorf("could not fetch quote: %w", err)
 	}
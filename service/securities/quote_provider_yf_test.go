@@ -90,6 +90,7 @@ func Test_yf_LatestQuote(t *testing.T) {
 				}),
 			},
 			args: args{
+				ctx: context.TODO(),
 				ls: &portfoliov1.ListedSecurity{
 					SecurityId: "My Security",
 					Ticker:     "TICK",
@@ -110,6 +111,7 @@ func Test_yf_LatestQuote(t *testing.T) {
 				}),
 			},
 			args: args{
+				ctx: context.TODO(),
 				ls: &portfoliov1.ListedSecurity{
 					SecurityId: "My Security",
 					Ticker:     "TICK",
@@ -130,6 +132,7 @@ func Test_yf_LatestQuote(t *testing.T) {
 				}),
 			},
 			args: args{
+				ctx: context.TODO(),
 				ls: &portfoliov1.ListedSecurity{
 					SecurityId: "My Security",
 					Ticker:     "TICK",
@@ -24,11 +24,14 @@ import (
 	moneygopher "github.com/oxisto/money-gopher"
 	portfoliov1 "github.com/oxisto/money-gopher/gen"
 	"github.com/oxisto/money-gopher/internal"
+	"github.com/oxisto/money-gopher/metrics"
 	"github.com/oxisto/money-gopher/persistence"
 
 	"connectrpc.com/connect"
 	"github.com/oxisto/assert"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"golang.org/x/text/currency"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const QuoteProviderMock = "mock"
@@ -61,12 +64,12 @@ func Test_service_TriggerSecurityQuoteUpdate(t *testing.T) {
 			name: "happy path",
 			fields: fields{
 				securities: internal.NewTestDBOps(t, func(ops persistence.StorageOperations[*portfoliov1.Security]) {
-					ops.Replace(&portfoliov1.Security{
+					ops.Replace(context.Background(), &portfoliov1.Security{
 						Id:            "My Security",
 						QuoteProvider: moneygopher.Ref("mock"),
 					})
 					rel := persistence.Relationship[*portfoliov1.ListedSecurity](ops)
-					assert.NoError(t, rel.Replace(&portfoliov1.ListedSecurity{
+					assert.NoError(t, rel.Replace(context.Background(), &portfoliov1.ListedSecurity{
 						SecurityId: "My Security",
 						Ticker:     "SEC",
 						Currency:   currency.EUR.String(),
@@ -74,6 +77,7 @@ func Test_service_TriggerSecurityQuoteUpdate(t *testing.T) {
 				}),
 			},
 			args: args{
+				ctx: context.Background(),
 				req: connect.NewRequest(&portfoliov1.TriggerQuoteUpdateRequest{
 					SecurityIds: []string{"My Security"},
 				}),
@@ -110,8 +114,9 @@ func Test_service_updateQuote(t *testing.T) {
 		securities persistence.StorageOperations[*portfoliov1.Security]
 	}
 	type args struct {
-		qp QuoteProvider
-		ls *portfoliov1.ListedSecurity
+		provider string
+		qp       QuoteProvider
+		ls       *portfoliov1.ListedSecurity
 	}
 	tests := []struct {
 		name    string
@@ -124,17 +129,44 @@ func Test_service_updateQuote(t *testing.T) {
 			name: "happy path",
 			fields: fields{
 				securities: internal.NewTestDBOps(t, func(ops persistence.StorageOperations[*portfoliov1.Security]) {
-					ops.Replace(&portfoliov1.Security{Id: "My Security"})
+					ops.Replace(context.Background(), &portfoliov1.Security{Id: "My Security"})
 					rel := persistence.Relationship[*portfoliov1.ListedSecurity](ops)
-					assert.NoError(t, rel.Replace(&portfoliov1.ListedSecurity{SecurityId: "My Security", Ticker: "SEC", Currency: currency.EUR.String()}))
+					assert.NoError(t, rel.Replace(context.Background(), &portfoliov1.ListedSecurity{SecurityId: "My Security", Ticker: "SEC", Currency: currency.EUR.String()}))
 				}),
 			},
 			args: args{
-				qp: &mockQuoteProvider{},
-				ls: &portfoliov1.ListedSecurity{SecurityId: "My Security", Ticker: "SEC", Currency: currency.EUR.String()},
+				provider: "test",
+				qp:       &mockQuoteProvider{},
+				ls:       &portfoliov1.ListedSecurity{SecurityId: "My Security", Ticker: "SEC", Currency: currency.EUR.String()},
 			},
 			want: func(t *testing.T, ls *portfoliov1.ListedSecurity) bool {
-				return assert.Equals(t, 100, int(ls.LatestQuote.Value))
+				return assert.Equals(t, 100, int(ls.LatestQuote.Value)) &&
+					assert.Equals(t, 1.0, testutil.ToFloat64(metrics.QuoteUpdates.WithLabelValues("test", "success")))
+			},
+		},
+		{
+			name: "new day",
+			fields: fields{
+				securities: internal.NewTestDBOps(t, func(ops persistence.StorageOperations[*portfoliov1.Security]) {
+					ops.Replace(context.Background(), &portfoliov1.Security{Id: "My Security"})
+					rel := persistence.Relationship[*portfoliov1.ListedSecurity](ops)
+					assert.NoError(t, rel.Replace(context.Background(), &portfoliov1.ListedSecurity{SecurityId: "My Security", Ticker: "SEC", Currency: currency.EUR.String()}))
+				}),
+			},
+			args: args{
+				provider: "test-new-day",
+				qp:       &mockQP{},
+				ls: &portfoliov1.ListedSecurity{
+					SecurityId:           "My Security",
+					Ticker:               "SEC",
+					Currency:             currency.EUR.String(),
+					LatestQuote:          portfoliov1.Value(90),
+					LatestQuoteTimestamp: timestamppb.New(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)),
+				},
+			},
+			want: func(t *testing.T, ls *portfoliov1.ListedSecurity) bool {
+				return assert.Equals(t, 100, int(ls.LatestQuote.Value)) &&
+					assert.Equals(t, 90, int(ls.PreviousQuote.Value))
 			},
 		},
 	}
@@ -144,7 +176,7 @@ func Test_service_updateQuote(t *testing.T) {
 				securities:       tt.fields.securities,
 				listedSecurities: persistence.Relationship[*portfoliov1.ListedSecurity](tt.fields.securities),
 			}
-			if err := svc.updateQuote(tt.args.qp, tt.args.ls); (err != nil) != tt.wantErr {
+			if err := svc.updateQuote(context.Background(), tt.args.provider, tt.args.qp, tt.args.ls); (err != nil) != tt.wantErr {
 				t.Errorf("updateQuote() error = %v, wantErr %v", err, tt.wantErr)
 			}
 
@@ -152,3 +184,48 @@ func Test_service_updateQuote(t *testing.T) {
 		})
 	}
 }
+
+type blockingQuoteProvider struct {
+	release chan struct{}
+}
+
+func (qp blockingQuoteProvider) LatestQuote(_ context.Context, _ *portfoliov1.ListedSecurity) (quote *portfoliov1.Currency, t time.Time, err error) {
+	<-qp.release
+	return portfoliov1.Value(100), time.Now(), nil
+}
+
+func Test_service_Wait(t *testing.T) {
+	qp := blockingQuoteProvider{release: make(chan struct{})}
+	RegisterQuoteProvider("blocking", qp)
+
+	securities := internal.NewTestDBOps(t, func(ops persistence.StorageOperations[*portfoliov1.Security]) {
+		assert.NoError(t, ops.Replace(context.Background(), &portfoliov1.Security{
+			Id:            "My Security",
+			QuoteProvider: moneygopher.Ref("blocking"),
+		}))
+		rel := persistence.Relationship[*portfoliov1.ListedSecurity](ops)
+		assert.NoError(t, rel.Replace(context.Background(), &portfoliov1.ListedSecurity{
+			SecurityId: "My Security",
+			Ticker:     "SEC",
+			Currency:   currency.EUR.String(),
+		}))
+	})
+
+	svc := &service{
+		securities:       securities,
+		listedSecurities: persistence.Relationship[*portfoliov1.ListedSecurity](securities),
+	}
+
+	_, err := svc.TriggerSecurityQuoteUpdate(context.Background(), connect.NewRequest(&portfoliov1.TriggerQuoteUpdateRequest{
+		SecurityIds: []string{"My Security"},
+	}))
+	assert.NoError(t, err)
+
+	// The update is still running
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, context.DeadlineExceeded, svc.Wait(ctx))
+
+	close(qp.release)
+	assert.NoError(t, svc.Wait(context.Background()))
+}
@@ -21,7 +21,10 @@ import (
 	"slices"
 
 	portfoliov1 "github.com/oxisto/money-gopher/gen"
+	"github.com/oxisto/money-gopher/metrics"
+	"github.com/oxisto/money-gopher/persistence"
 	"github.com/oxisto/money-gopher/service/internal/crud"
+	"github.com/oxisto/money-gopher/service/internal/pagination"
 
 	"connectrpc.com/connect"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -29,11 +32,12 @@ import (
 
 func (svc *service) CreateSecurity(ctx context.Context, req *connect.Request[portfoliov1.CreateSecurityRequest]) (res *connect.Response[portfoliov1.Security], err error) {
 	return crud.Create(
+		ctx,
 		req.Msg.Security,
 		svc.securities,
 		func(obj *portfoliov1.Security) *portfoliov1.Security {
 			for _, ls := range obj.ListedOn {
-				svc.listedSecurities.Replace(ls)
+				svc.listedSecurities.Replace(ctx, ls)
 			}
 
 			return obj
@@ -43,10 +47,11 @@ func (svc *service) CreateSecurity(ctx context.Context, req *connect.Request[por
 
 func (svc *service) GetSecurity(ctx context.Context, req *connect.Request[portfoliov1.GetSecurityRequest]) (res *connect.Response[portfoliov1.Security], err error) {
 	return crud.Get(
+		ctx,
 		req.Msg.Id,
 		svc.securities,
 		func(obj *portfoliov1.Security) *portfoliov1.Security {
-			obj.ListedOn, _ = svc.listedSecurities.List(obj.Id)
+			obj.ListedOn, _ = svc.listedSecurities.List(ctx, obj.Id)
 
 			return obj
 		},
@@ -54,13 +59,34 @@ func (svc *service) GetSecurity(ctx context.Context, req *connect.Request[portfo
 }
 
 func (svc *service) ListSecurities(ctx context.Context, req *connect.Request[portfoliov1.ListSecuritiesRequest]) (res *connect.Response[portfoliov1.ListSecuritiesResponse], err error) {
-	return crud.List(
+	opts, err := pagination.Options(req.Msg.PageSize, req.Msg.PageToken, req.Msg.OrderBy, "id", "display_name")
+	if err != nil {
+		return nil, err
+	}
+
+	if ids := req.Msg.Filter.GetSecurityIds(); len(ids) > 0 {
+		values := make([]any, 0, len(ids))
+		for _, id := range ids {
+			values = append(values, id)
+		}
+
+		opts.Filters = append(opts.Filters, persistence.Filter{Column: "id", Operator: persistence.OperatorIn, Value: values})
+	}
+
+	if tag := req.Msg.Filter.GetTag(); tag != "" {
+		opts.Filters = append(opts.Filters, persistence.Filter{Column: "tags", Operator: persistence.OperatorContains, Value: tag})
+	}
+
+	return crud.ListWithOptions(
+		ctx,
 		svc.securities,
-		func(res *connect.Response[portfoliov1.ListSecuritiesResponse], list []*portfoliov1.Security) error {
+		opts,
+		func(res *connect.Response[portfoliov1.ListSecuritiesResponse], list []*portfoliov1.Security, nextPageToken string) error {
 			res.Msg.Securities = list
+			res.Msg.NextPageToken = nextPageToken
 
 			for _, sec := range res.Msg.Securities {
-				sec.ListedOn, err = svc.listedSecurities.List(sec.Id)
+				sec.ListedOn, err = svc.listedSecurities.List(ctx, sec.Id)
 				if err != nil {
 					return err
 				}
@@ -73,6 +99,7 @@ func (svc *service) ListSecurities(ctx context.Context, req *connect.Request[por
 
 func (svc *service) UpdateSecurity(ctx context.Context, req *connect.Request[portfoliov1.UpdateSecurityRequest]) (res *connect.Response[portfoliov1.Security], err error) {
 	return crud.Update(
+		ctx,
 		req.Msg.Security.Id,
 		req.Msg.Security,
 		req.Msg.UpdateMask.Paths,
@@ -80,7 +107,7 @@ func (svc *service) UpdateSecurity(ctx context.Context, req *connect.Request[por
 		func(obj *portfoliov1.Security) *portfoliov1.Security {
 			if slices.Contains(req.Msg.UpdateMask.Paths, "listed_on") {
 				for _, ls := range req.Msg.Security.ListedOn {
-					svc.listedSecurities.Replace(ls)
+					svc.listedSecurities.Replace(ctx, ls)
 				}
 			}
 
@@ -90,18 +117,29 @@ func (svc *service) UpdateSecurity(ctx context.Context, req *connect.Request[por
 }
 
 func (svc *service) DeleteSecurity(ctx context.Context, req *connect.Request[portfoliov1.DeleteSecurityRequest]) (res *connect.Response[emptypb.Empty], err error) {
-	return crud.Delete(
+	res, err = crud.Delete(
+		ctx,
 		req.Msg.Id,
 		svc.securities,
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Trashed securities are no longer updated, so the age of their quotes
+	// would grow forever
+	metrics.DeleteQuoteTimes(req.Msg.Id)
+
+	return res, nil
 }
 
-func (svc *service) fetchSecurity(name string) (sec *portfoliov1.Security, err error) {
+func (svc *service) fetchSecurity(ctx context.Context, name string) (sec *portfoliov1.Security, err error) {
 	res, err := crud.Get(
+		ctx,
 		name,
 		svc.securities,
 		func(obj *portfoliov1.Security) *portfoliov1.Security {
-			obj.ListedOn, _ = svc.listedSecurities.List(obj.Id)
+			obj.ListedOn, _ = svc.listedSecurities.List(ctx, obj.Id)
 
 			return obj
 		},
@@ -53,11 +53,15 @@ func Test_service_ListSecurities(t *testing.T) {
 			name: "happy path",
 			fields: fields{
 				securities: internal.NewTestDBOps(t, func(ops persistence.StorageOperations[*portfoliov1.Security]) {
-					assert.NoError(t, ops.Replace(&portfoliov1.Security{Id: "My Security"}))
+					assert.NoError(t, ops.Replace(context.Background(), &portfoliov1.Security{Id: "My Security"}))
 					rel := persistence.Relationship[*portfoliov1.ListedSecurity](ops)
-					assert.NoError(t, rel.Replace(&portfoliov1.ListedSecurity{SecurityId: "My Security", Ticker: "SEC", Currency: currency.EUR.String()}))
+					assert.NoError(t, rel.Replace(context.Background(), &portfoliov1.ListedSecurity{SecurityId: "My Security", Ticker: "SEC", Currency: currency.EUR.String()}))
 				}),
 			},
+			args: args{
+				ctx: context.Background(),
+				req: connect.NewRequest(&portfoliov1.ListSecuritiesRequest{}),
+			},
 			wantRes: func(t *testing.T, r *connect.Response[portfoliov1.ListSecuritiesResponse]) bool {
 				return true &&
 					assert.Equals(t, "My Security", r.Msg.Securities[0].Id) &&
@@ -65,6 +69,69 @@ func Test_service_ListSecurities(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "filtered, sorted and paginated",
+			fields: fields{
+				securities: internal.NewTestDBOps(t, func(ops persistence.StorageOperations[*portfoliov1.Security]) {
+					assert.NoError(t, ops.Replace(context.Background(), &portfoliov1.Security{Id: "A", DisplayName: "Zeta"}))
+					assert.NoError(t, ops.Replace(context.Background(), &portfoliov1.Security{Id: "B", DisplayName: "Beta"}))
+					assert.NoError(t, ops.Replace(context.Background(), &portfoliov1.Security{Id: "C", DisplayName: "Alpha"}))
+				}),
+			},
+			args: args{
+				ctx: context.Background(),
+				req: connect.NewRequest(&portfoliov1.ListSecuritiesRequest{
+					PageSize: 1,
+					OrderBy:  "display_name desc",
+					Filter:   &portfoliov1.ListSecuritiesRequest_Filter{SecurityIds: []string{"B", "C"}},
+				}),
+			},
+			wantRes: func(t *testing.T, r *connect.Response[portfoliov1.ListSecuritiesResponse]) bool {
+				return true &&
+					assert.Equals(t, 1, len(r.Msg.Securities)) &&
+					assert.Equals(t, "B", r.Msg.Securities[0].Id) &&
+					assert.Equals(t, true, r.Msg.NextPageToken != "")
+			},
+			wantErr: false,
+		},
+		{
+			name: "filtered by tag",
+			fields: fields{
+				securities: internal.NewTestDBOps(t, func(ops persistence.StorageOperations[*portfoliov1.Security]) {
+					assert.NoError(t, ops.Replace(context.Background(), &portfoliov1.Security{Id: "A", Tags: []string{"dividend", "tech"}, Note: "Pays quarterly"}))
+					assert.NoError(t, ops.Replace(context.Background(), &portfoliov1.Security{Id: "B", Tags: []string{"tech"}}))
+					assert.NoError(t, ops.Replace(context.Background(), &portfoliov1.Security{Id: "C"}))
+				}),
+			},
+			args: args{
+				ctx: context.Background(),
+				req: connect.NewRequest(&portfoliov1.ListSecuritiesRequest{
+					Filter: &portfoliov1.ListSecuritiesRequest_Filter{Tag: "dividend"},
+				}),
+			},
+			wantRes: func(t *testing.T, r *connect.Response[portfoliov1.ListSecuritiesResponse]) bool {
+				return true &&
+					assert.Equals(t, 1, len(r.Msg.Securities)) &&
+					assert.Equals(t, "A", r.Msg.Securities[0].Id) &&
+					assert.Equals(t, "Pays quarterly", r.Msg.Securities[0].Note) &&
+					assert.Equals(t, []string{"dividend", "tech"}, r.Msg.Securities[0].Tags)
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid order",
+			fields: fields{
+				securities: internal.NewTestDBOps[*portfoliov1.Security](t),
+			},
+			args: args{
+				ctx: context.Background(),
+				req: connect.NewRequest(&portfoliov1.ListSecuritiesRequest{OrderBy: "quote_provider"}),
+			},
+			wantRes: func(t *testing.T, r *connect.Response[portfoliov1.ListSecuritiesResponse]) bool {
+				return assert.Equals(t, nil, r)
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -101,12 +168,13 @@ func Test_service_GetSecurity(t *testing.T) {
 			name: "happy path",
 			fields: fields{
 				securities: internal.NewTestDBOps(t, func(ops persistence.StorageOperations[*portfoliov1.Security]) {
-					ops.Replace(&portfoliov1.Security{Id: "My Security"})
+					ops.Replace(context.Background(), &portfoliov1.Security{Id: "My Security"})
 					rel := persistence.Relationship[*portfoliov1.ListedSecurity](ops)
-					assert.NoError(t, rel.Replace(&portfoliov1.ListedSecurity{SecurityId: "My Security", Ticker: "SEC", Currency: currency.EUR.String()}))
+					assert.NoError(t, rel.Replace(context.Background(), &portfoliov1.ListedSecurity{SecurityId: "My Security", Ticker: "SEC", Currency: currency.EUR.String()}))
 				}),
 			},
 			args: args{
+				ctx: context.Background(),
 				req: connect.NewRequest(&portfoliov1.GetSecurityRequest{Id: "My Security"}),
 			},
 			wantRes: func(t *testing.T, s *portfoliov1.Security) bool {
@@ -153,13 +221,15 @@ func Test_service_UpdateSecurity(t *testing.T) {
 			name: "change display_name",
 			fields: fields{
 				securities: internal.NewTestDBOps(t, func(ops persistence.StorageOperations[*portfoliov1.Security]) {
-					ops.Replace(&portfoliov1.Security{Id: "My Stock"})
+					ops.Replace(context.Background(), &portfoliov1.Security{Id: "My Stock"})
 				}),
 			},
-			args: args{req: connect.NewRequest(&portfoliov1.UpdateSecurityRequest{
-				Security:   &portfoliov1.Security{Id: "My Stock", DisplayName: "Test"},
-				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"display_name"}},
-			})},
+			args: args{
+				ctx: context.Background(),
+				req: connect.NewRequest(&portfoliov1.UpdateSecurityRequest{
+					Security:   &portfoliov1.Security{Id: "My Stock", DisplayName: "Test"},
+					UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"display_name"}},
+				})},
 			wantRes: connect.NewResponse(&portfoliov1.Security{Id: "My Stock", DisplayName: "Test"}),
 			wantErr: false,
 		},
@@ -201,12 +271,14 @@ func Test_service_DeleteSecurity(t *testing.T) {
 			name: "happy path",
 			fields: fields{
 				securities: internal.NewTestDBOps(t, func(ops persistence.StorageOperations[*portfoliov1.Security]) {
-					ops.Replace(&portfoliov1.Security{Id: "My Stock"})
+					ops.Replace(context.Background(), &portfoliov1.Security{Id: "My Stock"})
 				}),
 			},
-			args: args{req: connect.NewRequest(&portfoliov1.DeleteSecurityRequest{
-				Id: "My Stock",
-			})},
+			args: args{
+				ctx: context.Background(),
+				req: connect.NewRequest(&portfoliov1.DeleteSecurityRequest{
+					Id: "My Stock",
+				})},
 			wantRes: func(t *testing.T, e *emptypb.Empty) bool {
 				return assert.Equals(t, &emptypb.Empty{}, e, protocmp.Transform())
 			},
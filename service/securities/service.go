@@ -18,25 +18,38 @@
 package securities
 
 import (
+	"context"
+	"sync"
 	"time"
 
 	moneygopher "github.com/oxisto/money-gopher"
 	portfoliov1 "github.com/oxisto/money-gopher/gen"
 	"github.com/oxisto/money-gopher/gen/portfoliov1connect"
 	"github.com/oxisto/money-gopher/persistence"
+	"github.com/oxisto/money-gopher/service/events"
 
+	"go.opentelemetry.io/otel"
 	"golang.org/x/text/currency"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// tracer creates the spans of quote updates.
+var tracer = otel.Tracer("github.com/oxisto/money-gopher/service/securities")
+
 type service struct {
 	securities       persistence.StorageOperations[*portfoliov1.Security]
 	listedSecurities persistence.StorageOperations[*portfoliov1.ListedSecurity]
+	broker           *events.Broker
+
+	// jobs tracks the quote updates running in the background.
+	jobs sync.WaitGroup
 
 	portfoliov1connect.UnimplementedSecuritiesServiceHandler
 }
 
-func NewService(db *persistence.DB) portfoliov1connect.SecuritiesServiceHandler {
+// NewService creates a new securities service. Quote changes are published to
+// broker, which can be nil.
+func NewService(db *persistence.DB, broker *events.Broker) portfoliov1connect.SecuritiesServiceHandler {
 	securities := persistence.Ops[*portfoliov1.Security](db)
 	listedSecurities := persistence.Relationship[*portfoliov1.ListedSecurity](securities)
 	secs := []*portfoliov1.Security{
@@ -63,16 +76,33 @@ func NewService(db *persistence.DB) portfoliov1connect.SecuritiesServiceHandler
 		},
 	}
 	for _, sec := range secs {
-		securities.Replace(sec)
+		securities.Replace(context.Background(), sec)
 
 		// TODO: in the future, we might do this automatically
 		for _, ls := range sec.ListedOn {
-			listedSecurities.Replace(ls)
+			listedSecurities.Replace(context.Background(), ls)
 		}
 	}
 
 	return &service{
 		securities:       securities,
 		listedSecurities: listedSecurities,
+		broker:           broker,
+	}
+}
+
+// Wait waits until all background quote updates are finished or ctx is done.
+func (svc *service) Wait(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		svc.jobs.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
 	}
 }
@@ -38,7 +38,7 @@ func TestNewService(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := NewService(internal.NewTestDB(t))
+			got := NewService(internal.NewTestDB(t), nil)
 			tt.want(t, assert.Is[*service](t, got))
 		})
 	}
@@ -0,0 +1,251 @@
+// Copyright 2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+// package tokens contains the code for the TokenService implementation, which
+// manages personal access tokens.
+package tokens
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/oxisto/money-gopher/auth"
+	portfoliov1 "github.com/oxisto/money-gopher/gen"
+	"github.com/oxisto/money-gopher/gen/portfoliov1connect"
+	"github.com/oxisto/money-gopher/persistence"
+	"github.com/oxisto/money-gopher/service/audit"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	ErrUnauthenticated     = errors.New("no authenticated user")
+	ErrMissingDisplayName  = errors.New("an access token requires a display name")
+	ErrMissingScopes       = errors.New("an access token requires at least one scope")
+	ErrUnknownScope        = errors.New("unknown scope")
+	ErrScopeNotGranted     = errors.New("scope exceeds the permissions of the user")
+	ErrExpireTimeInPast    = errors.New("expire time is in the past")
+	ErrAccessTokenNotFound = errors.New("access token not found")
+)
+
+// service is the main struct for the [TokenService] implementation.
+type service struct {
+	q *persistence.Queries
+
+	portfoliov1connect.UnimplementedTokenServiceHandler
+}
+
+func NewService(q *persistence.Queries) portfoliov1connect.TokenServiceHandler {
+	return &service{q: q}
+}
+
+func (svc *service) CreateAccessToken(ctx context.Context, req *connect.Request[portfoliov1.CreateAccessTokenRequest]) (res *connect.Response[portfoliov1.CreateAccessTokenResponse], err error) {
+	var (
+		at     = req.Msg.AccessToken
+		u      *auth.User
+		token  string
+		hash   string
+		id     string
+		expire sql.NullTime
+		dbat   *persistence.AccessToken
+	)
+
+	u, err = currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = validateScopes(u, at.Scopes)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if at.DisplayName == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, ErrMissingDisplayName)
+	}
+
+	if at.ExpireTime != nil {
+		if at.ExpireTime.AsTime().Before(time.Now()) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, ErrExpireTimeInPast)
+		}
+
+		expire = sql.NullTime{Time: at.ExpireTime.AsTime(), Valid: true}
+	}
+
+	id, err = newID()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	token, hash, err = auth.NewAccessToken()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	dbat, err = svc.q.CreateAccessToken(ctx, persistence.CreateAccessTokenParams{
+		ID:          id,
+		DisplayName: at.DisplayName,
+		Owner:       u.Subject,
+		Scopes:      strings.Join(at.Scopes, " "),
+		Hash:        hash,
+		CreateTime:  time.Now(),
+		ExpireTime:  expire,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	res = connect.NewResponse(&portfoliov1.CreateAccessTokenResponse{
+		AccessToken: toProto(dbat),
+		Token:       token,
+	})
+
+	// The token itself is never recorded
+	audit.Record(ctx, audit.Event{
+		Action: portfoliov1.AuditAction_AUDIT_ACTION_CREATE,
+		After:  res.Msg.AccessToken,
+	})
+
+	return res, nil
+}
+
+func (svc *service) ListAccessTokens(ctx context.Context, req *connect.Request[portfoliov1.ListAccessTokensRequest]) (res *connect.Response[portfoliov1.ListAccessTokensResponse], err error) {
+	var (
+		u    *auth.User
+		list []*persistence.AccessToken
+	)
+
+	u, err = currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	list, err = svc.q.ListAccessTokensByOwner(ctx, u.Subject)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	res = connect.NewResponse(&portfoliov1.ListAccessTokensResponse{
+		AccessTokens: make([]*portfoliov1.AccessToken, 0, len(list)),
+	})
+
+	for _, at := range list {
+		res.Msg.AccessTokens = append(res.Msg.AccessTokens, toProto(at))
+	}
+
+	return
+}
+
+func (svc *service) DeleteAccessToken(ctx context.Context, req *connect.Request[portfoliov1.DeleteAccessTokenRequest]) (res *connect.Response[emptypb.Empty], err error) {
+	var (
+		u *auth.User
+		n int64
+	)
+
+	u, err = currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Users can only revoke their own tokens
+	n, err = svc.q.DeleteAccessToken(ctx, persistence.DeleteAccessTokenParams{
+		ID:    req.Msg.Id,
+		Owner: u.Subject,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	} else if n == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, ErrAccessTokenNotFound)
+	}
+
+	audit.Record(ctx, audit.Event{
+		Action:       portfoliov1.AuditAction_AUDIT_ACTION_DELETE,
+		ResourceType: "AccessToken",
+		ResourceID:   req.Msg.Id,
+	})
+
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+// currentUser returns the authenticated user of the request.
+func currentUser(ctx context.Context) (u *auth.User, err error) {
+	u, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, ErrUnauthenticated)
+	}
+
+	return u, nil
+}
+
+// validateScopes checks, whether all scopes are known and granted to the user,
+// so that users cannot escalate their privileges using an access token.
+func validateScopes(u *auth.User, scopes []string) error {
+	if len(scopes) == 0 {
+		return ErrMissingScopes
+	}
+
+	for _, s := range scopes {
+		if !slices.Contains(auth.AllPermissions, auth.Permission(s)) {
+			return errors.Join(ErrUnknownScope, errors.New(s))
+		} else if !u.HasPermission(auth.Permission(s)) {
+			return errors.Join(ErrScopeNotGranted, errors.New(s))
+		}
+	}
+
+	return nil
+}
+
+// newID generates a new random, public identifier of an access token.
+func newID() (string, error) {
+	var b = make([]byte, 8)
+
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
+
+// toProto converts an access token of the database into its API
+// representation. The hash is never returned.
+func toProto(at *persistence.AccessToken) *portfoliov1.AccessToken {
+	pat := &portfoliov1.AccessToken{
+		Id:          at.ID,
+		DisplayName: at.DisplayName,
+		Scopes:      strings.Fields(at.Scopes),
+		Owner:       at.Owner,
+		CreateTime:  timestamppb.New(at.CreateTime),
+	}
+
+	if at.ExpireTime.Valid {
+		pat.ExpireTime = timestamppb.New(at.ExpireTime.Time)
+	}
+
+	if at.LastUsedTime.Valid {
+		pat.LastUsedTime = timestamppb.New(at.LastUsedTime.Time)
+	}
+
+	return pat
+}
@@ -0,0 +1,238 @@
+// Copyright 2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package tokens
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/oxisto/money-gopher/auth"
+	portfoliov1 "github.com/oxisto/money-gopher/gen"
+	"github.com/oxisto/money-gopher/internal"
+	"github.com/oxisto/money-gopher/persistence"
+	"github.com/oxisto/money-gopher/service/audit"
+
+	"connectrpc.com/connect"
+	"github.com/oxisto/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func Test_service_CreateAccessToken(t *testing.T) {
+	var viewer = auth.NewContext(context.Background(), &auth.User{
+		Subject: "viewer",
+		Roles:   []auth.Role{auth.RoleViewer},
+	})
+
+	type args struct {
+		ctx context.Context
+		req *connect.Request[portfoliov1.CreateAccessTokenRequest]
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantRes  assert.Want[*connect.Response[portfoliov1.CreateAccessTokenResponse]]
+		wantCode connect.Code
+	}{
+		{
+			name: "happy path",
+			args: args{
+				ctx: internal.WithTestUser(context.Background()),
+				req: connect.NewRequest(&portfoliov1.CreateAccessTokenRequest{
+					AccessToken: &portfoliov1.AccessToken{
+						DisplayName: "cron",
+						Scopes:      []string{"portfolios:read", "securities:update-quotes"},
+						ExpireTime:  timestamppb.New(time.Now().Add(time.Hour)),
+					},
+				}),
+			},
+			wantRes: func(t *testing.T, r *connect.Response[portfoliov1.CreateAccessTokenResponse]) bool {
+				return assert.Equals(t, true, auth.IsAccessToken(r.Msg.Token)) &&
+					assert.Equals(t, "cron", r.Msg.AccessToken.DisplayName) &&
+					assert.Equals(t, internal.TestUser, r.Msg.AccessToken.Owner) &&
+					assert.Equals(t, []string{"portfolios:read", "securities:update-quotes"}, r.Msg.AccessToken.Scopes) &&
+					assert.NotNil(t, r.Msg.AccessToken.ExpireTime)
+			},
+		},
+		{
+			name: "scope not granted",
+			args: args{
+				ctx: viewer,
+				req: connect.NewRequest(&portfoliov1.CreateAccessTokenRequest{
+					AccessToken: &portfoliov1.AccessToken{
+						DisplayName: "escalate",
+						Scopes:      []string{"portfolios:write"},
+					},
+				}),
+			},
+			wantCode: connect.CodeInvalidArgument,
+		},
+		{
+			name: "unknown scope",
+			args: args{
+				ctx: internal.WithTestUser(context.Background()),
+				req: connect.NewRequest(&portfoliov1.CreateAccessTokenRequest{
+					AccessToken: &portfoliov1.AccessToken{
+						DisplayName: "cron",
+						Scopes:      []string{"everything"},
+					},
+				}),
+			},
+			wantCode: connect.CodeInvalidArgument,
+		},
+		{
+			name: "missing scopes",
+			args: args{
+				ctx: internal.WithTestUser(context.Background()),
+				req: connect.NewRequest(&portfoliov1.CreateAccessTokenRequest{
+					AccessToken: &portfoliov1.AccessToken{DisplayName: "cron"},
+				}),
+			},
+			wantCode: connect.CodeInvalidArgument,
+		},
+		{
+			name: "expired",
+			args: args{
+				ctx: internal.WithTestUser(context.Background()),
+				req: connect.NewRequest(&portfoliov1.CreateAccessTokenRequest{
+					AccessToken: &portfoliov1.AccessToken{
+						DisplayName: "cron",
+						Scopes:      []string{"portfolios:read"},
+						ExpireTime:  timestamppb.New(time.Now().Add(-time.Hour)),
+					},
+				}),
+			},
+			wantCode: connect.CodeInvalidArgument,
+		},
+		{
+			name: "unauthenticated",
+			args: args{
+				ctx: context.Background(),
+				req: connect.NewRequest(&portfoliov1.CreateAccessTokenRequest{}),
+			},
+			wantCode: connect.CodeUnauthenticated,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := NewService(persistence.New(internal.NewTestDB(t)))
+			gotRes, err := svc.CreateAccessToken(tt.args.ctx, tt.args.req)
+			if tt.wantCode != 0 {
+				assert.Equals(t, tt.wantCode, connect.CodeOf(err))
+				return
+			}
+
+			assert.NoError(t, err)
+			tt.wantRes(t, gotRes)
+		})
+	}
+}
+
+func Test_service_accessTokens(t *testing.T) {
+	var (
+		db     = internal.NewTestDB(t)
+		svc    = NewService(persistence.New(db))
+		v      = NewVerifier(persistence.New(db))
+		money  = internal.WithTestUser(context.Background())
+		gopher = auth.NewContext(context.Background(), &auth.User{Subject: "gopher", Roles: []auth.Role{auth.RoleViewer}})
+	)
+
+	created, err := svc.CreateAccessToken(money, connect.NewRequest(&portfoliov1.CreateAccessTokenRequest{
+		AccessToken: &portfoliov1.AccessToken{
+			DisplayName: "cron",
+			Scopes:      []string{"securities:update-quotes"},
+		},
+	}))
+	assert.NoError(t, err)
+
+	// The token authenticates the owner, restricted to its scopes and the
+	// roles the owner had when it last authenticated with the identity
+	// provider
+	owner, _ := auth.FromContext(money)
+	assert.NoError(t, v.ObserveUser(context.Background(), owner))
+
+	u, err := v.VerifyAccessToken(context.Background(), created.Msg.Token)
+	assert.NoError(t, err)
+	assert.Equals(t, internal.TestUser, u.Subject)
+	assert.Equals(t, true, u.HasPermission(auth.PermissionUpdateQuotes))
+	assert.Equals(t, false, u.HasPermission(auth.PermissionReadPortfolios))
+
+	// Once the owner is demoted, the token loses the permissions that its
+	// roles no longer grant
+	assert.NoError(t, v.ObserveUser(context.Background(), &auth.User{Subject: internal.TestUser, Roles: []auth.Role{auth.RoleViewer}}))
+
+	u, err = v.VerifyAccessToken(context.Background(), created.Msg.Token)
+	assert.NoError(t, err)
+	assert.Equals(t, false, u.HasPermission(auth.PermissionUpdateQuotes))
+
+	list, err := svc.ListAccessTokens(money, connect.NewRequest(&portfoliov1.ListAccessTokensRequest{}))
+	assert.NoError(t, err)
+	assert.Equals(t, 1, len(list.Msg.AccessTokens))
+	assert.NotNil(t, list.Msg.AccessTokens[0].LastUsedTime)
+
+	list, err = svc.ListAccessTokens(gopher, connect.NewRequest(&portfoliov1.ListAccessTokensRequest{}))
+	assert.NoError(t, err)
+	assert.Equals(t, 0, len(list.Msg.AccessTokens))
+
+	// Other users cannot revoke the token
+	_, err = svc.DeleteAccessToken(gopher, connect.NewRequest(&portfoliov1.DeleteAccessTokenRequest{
+		Id: created.Msg.AccessToken.Id,
+	}))
+	assert.Equals(t, connect.CodeNotFound, connect.CodeOf(err))
+
+	_, err = svc.DeleteAccessToken(money, connect.NewRequest(&portfoliov1.DeleteAccessTokenRequest{
+		Id: created.Msg.AccessToken.Id,
+	}))
+	assert.NoError(t, err)
+
+	_, err = v.VerifyAccessToken(context.Background(), created.Msg.Token)
+	assert.ErrorIs(t, ErrInvalidAccessToken, err)
+}
+
+func Test_service_accessTokens_audit(t *testing.T) {
+	var (
+		db  = internal.NewTestDB(t)
+		q   = persistence.New(db)
+		svc = NewService(q)
+		ctx = audit.NewContext(internal.WithTestUser(context.Background()), audit.NewRecorder(q), "/test/AccessTokens")
+	)
+
+	created, err := svc.CreateAccessToken(ctx, connect.NewRequest(&portfoliov1.CreateAccessTokenRequest{
+		AccessToken: &portfoliov1.AccessToken{
+			DisplayName: "cron",
+			Scopes:      []string{"securities:update-quotes"},
+		},
+	}))
+	assert.NoError(t, err)
+
+	_, err = svc.DeleteAccessToken(ctx, connect.NewRequest(&portfoliov1.DeleteAccessTokenRequest{
+		Id: created.Msg.AccessToken.Id,
+	}))
+	assert.NoError(t, err)
+
+	list, err := q.ListAuditEvents(context.Background(), persistence.ListAuditEventsParams{PageSize: 10})
+	assert.NoError(t, err)
+	assert.Equals(t, 2, len(list))
+
+	// The secret token must never end up in the audit log
+	for _, e := range list {
+		assert.Equals(t, "AccessToken", e.ResourceType)
+		assert.Equals(t, created.Msg.AccessToken.Id, e.ResourceID)
+		assert.Equals(t, false, strings.Contains(e.After.String, created.Msg.Token))
+	}
+}
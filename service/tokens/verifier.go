@@ -0,0 +1,141 @@
+// Copyright 2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package tokens
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/oxisto/money-gopher/auth"
+	"github.com/oxisto/money-gopher/persistence"
+
+	"github.com/lmittmann/tint"
+)
+
+var (
+	ErrInvalidAccessToken = errors.New("invalid access token")
+	ErrAccessTokenExpired = errors.New("access token expired")
+)
+
+// Verifier verifies access tokens that were created by the [TokenService].
+type Verifier struct {
+	q *persistence.Queries
+
+	// roles caches the roles of every owner that were last written to the
+	// database, so that we only write them if they change.
+	roles sync.Map
+}
+
+// NewVerifier creates a new [Verifier] that looks up access tokens in the
+// database.
+func NewVerifier(q *persistence.Queries) *Verifier {
+	return &Verifier{q: q}
+}
+
+// VerifyAccessToken verifies the access token and returns the user it belongs
+// to. The permissions of the user are restricted to the scopes of the token
+// and the roles the user had when it last authenticated with the identity
+// provider, so that a demoted user cannot keep its permissions through an
+// access token.
+func (v *Verifier) VerifyAccessToken(ctx context.Context, token string) (u *auth.User, err error) {
+	var (
+		at  *persistence.AccessToken
+		now = time.Now()
+	)
+
+	at, err = v.q.GetAccessTokenByHash(ctx, auth.HashAccessToken(token))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrInvalidAccessToken
+	} else if err != nil {
+		return nil, err
+	}
+
+	if at.ExpireTime.Valid && at.ExpireTime.Time.Before(now) {
+		return nil, ErrAccessTokenExpired
+	}
+
+	// The last usage is only informational, so we do not fail the request
+	err = v.q.UpdateAccessTokenLastUsed(ctx, persistence.UpdateAccessTokenLastUsedParams{
+		LastUsedTime: sql.NullTime{Time: now, Valid: true},
+		ID:           at.ID,
+	})
+	if err != nil {
+		slog.Warn("Could not update last usage of access token", tint.Err(err), "id", at.ID)
+	}
+
+	// If we do not know the roles of the owner, the token does not grant any
+	// permission until the owner authenticated with the identity provider
+	owner, err := v.q.GetTokenOwner(ctx, at.Owner)
+	if errors.Is(err, sql.ErrNoRows) {
+		owner = &persistence.TokenOwner{Owner: at.Owner}
+	} else if err != nil {
+		return nil, err
+	}
+
+	u = &auth.User{
+		Subject: at.Owner,
+		Name:    at.Owner,
+		Roles:   []auth.Role{},
+		Scopes:  []auth.Permission{},
+	}
+
+	for _, r := range strings.Fields(owner.Roles) {
+		u.Roles = append(u.Roles, auth.Role(r))
+	}
+
+	for _, s := range strings.Fields(at.Scopes) {
+		u.Scopes = append(u.Scopes, auth.Permission(s))
+	}
+
+	return u, nil
+}
+
+// ObserveUser records the current roles of a user that authenticated with the
+// identity provider. They restrict the permissions of all access tokens of the
+// user.
+func (v *Verifier) ObserveUser(ctx context.Context, u *auth.User) (err error) {
+	var roles []string
+
+	for _, r := range u.Roles {
+		roles = append(roles, string(r))
+	}
+	slices.Sort(roles)
+
+	joined := strings.Join(roles, " ")
+	if prev, ok := v.roles.Load(u.Subject); ok && prev.(string) == joined {
+		return nil
+	}
+
+	err = v.q.SetTokenOwnerRoles(ctx, persistence.SetTokenOwnerRolesParams{
+		Owner:      u.Subject,
+		Roles:      joined,
+		UpdateTime: time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+
+	v.roles.Store(u.Subject, joined)
+
+	return nil
+}
@@ -0,0 +1,93 @@
+// Copyright 2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package tokens
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/oxisto/money-gopher/auth"
+	"github.com/oxisto/money-gopher/internal"
+	"github.com/oxisto/money-gopher/persistence"
+
+	"github.com/oxisto/assert"
+)
+
+func TestVerifier_VerifyAccessToken(t *testing.T) {
+	var (
+		q   = persistence.New(internal.NewTestDB(t))
+		now = time.Now()
+	)
+
+	create := func(id string, expire sql.NullTime) string {
+		token, hash, err := auth.NewAccessToken()
+		assert.NoError(t, err)
+
+		_, err = q.CreateAccessToken(context.Background(), persistence.CreateAccessTokenParams{
+			ID:          id,
+			DisplayName: id,
+			Owner:       internal.TestUser,
+			Scopes:      "portfolios:read securities:read",
+			Hash:        hash,
+			CreateTime:  now,
+			ExpireTime:  expire,
+		})
+		assert.NoError(t, err)
+
+		return token
+	}
+
+	err := NewVerifier(q).ObserveUser(context.Background(), &auth.User{Subject: internal.TestUser, Roles: []auth.Role{auth.RoleViewer}})
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		token   string
+		want    *auth.User
+		wantErr error
+	}{
+		{
+			name:  "valid",
+			token: create("valid", sql.NullTime{}),
+			want: &auth.User{
+				Subject: internal.TestUser,
+				Name:    internal.TestUser,
+				Roles:   []auth.Role{auth.RoleViewer},
+				Scopes:  []auth.Permission{auth.PermissionReadPortfolios, auth.PermissionReadSecurities},
+			},
+		},
+		{
+			name:    "expired",
+			token:   create("expired", sql.NullTime{Time: now.Add(-time.Minute), Valid: true}),
+			wantErr: ErrAccessTokenExpired,
+		},
+		{
+			name:    "unknown",
+			token:   auth.AccessTokenPrefix + "unknown",
+			wantErr: ErrInvalidAccessToken,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewVerifier(q).VerifyAccessToken(context.Background(), tt.token)
+			assert.ErrorIs(t, tt.wantErr, err)
+			assert.Equals(t, tt.want, got)
+		})
+	}
+}
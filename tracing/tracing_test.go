@@ -0,0 +1,51 @@
+// Copyright 2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package tracing
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/oxisto/assert"
+	"go.opentelemetry.io/otel"
+)
+
+func TestSetup(t *testing.T) {
+	tests := []struct {
+		name     string
+		endpoint string
+	}{
+		{
+			name: "disabled",
+		},
+		{
+			name:     "OTLP endpoint",
+			endpoint: "http://localhost:4318",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			shutdown, err := Setup(context.Background(), tt.endpoint)
+			assert.NoError(t, err)
+
+			// The trace context is always propagated
+			assert.Equals(t, true, slices.Contains(otel.GetTextMapPropagator().Fields(), "traceparent"))
+			assert.NoError(t, shutdown(context.Background()))
+		})
+	}
+}